@@ -1,9 +1,44 @@
 package main
 
 import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
 	"github.com/jokosaputro95/cms-news-api/configs/app"
 )
 
+const usage = `usage: cms-news-api <command> [args]
+
+commands:
+  serve      menjalankan HTTP server (default)
+  migrate    menjalankan migrasi database (up, down, status, create, baseline)
+  user       mengelola user (create, reset-password, list, delete)`
+
 func main() {
-	app.Run()
-}
\ No newline at end of file
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+
+	var err error
+	switch command {
+	case "serve":
+		app.Run()
+	case "migrate":
+		err = app.Migrate(args)
+	case "user":
+		err = app.User(args)
+	case "help", "-h", "--help":
+		fmt.Println(usage)
+	default:
+		log.Fatalf("Unknown command %q\n%s", command, usage)
+	}
+
+	// -h pada subcommand sudah mencetak bantuan dari FlagSet
+	if err != nil && !errors.Is(err, flag.ErrHelp) {
+		log.Fatalf("Command %s failed: %v", command, err)
+	}
+}
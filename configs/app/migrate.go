@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/jokosaputro95/cms-news-api/configs"
+	"github.com/jokosaputro95/cms-news-api/internal/modules"
+	"github.com/jokosaputro95/cms-news-api/internal/shared/migrate"
+)
+
+const migrateUsage = `usage:
+  migrate up                      menerapkan semua migrasi yang pending
+  migrate down [N]                membatalkan N migrasi terakhir (default 1)
+  migrate status                  menampilkan status setiap migrasi
+  migrate create -module M name   membuat file migrasi baru di modul M
+  migrate baseline VERSION        mencatat migrasi <= VERSION tanpa menjalankannya`
+
+// Migrate menjalankan subcommand "migrate" dengan migrasi dari seluruh modul.
+func Migrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	migrations, err := migrate.Load(modules.Migrations)
+	if err != nil {
+		return err
+	}
+
+	// create hanya menulis file, tidak butuh database
+	if args[0] == "create" {
+		return createMigration(args[1:], migrations)
+	}
+
+	server := &Server{config: configs.LoadConfig(true, ".env")}
+	if err := server.setupDatabase(); err != nil {
+		return err
+	}
+	defer server.db.Close()
+
+	migrator := migrate.NewMigrator(server.db, migrations)
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			log.Printf("✅ Applied %06d_%s (%s)", migration.Version, migration.Name, migration.Module)
+		}
+		if err == nil && len(applied) == 0 {
+			log.Println("✅ Database is up to date")
+		}
+		return err
+
+	case "down":
+		n := 1
+		if len(args) > 1 {
+			if n, err = strconv.Atoi(args[1]); err != nil || n < 1 {
+				return fmt.Errorf("N must be a positive number, got %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, n)
+		for _, migration := range reverted {
+			log.Printf("✅ Reverted %06d_%s (%s)", migration.Version, migration.Name, migration.Module)
+		}
+		return err
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "VERSION\tMODULE\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			fmt.Fprintf(writer, "%06d\t%s\t%s\t%s\n", status.Migration.Version, status.Migration.Module, status.Migration.Name, appliedAt)
+		}
+		return writer.Flush()
+
+	case "baseline":
+		if len(args) < 2 {
+			return errors.New(migrateUsage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		recorded, err := migrator.Baseline(ctx, version)
+		log.Printf("✅ Recorded %d migration(s) as applied", len(recorded))
+		return err
+
+	default:
+		return errors.New(migrateUsage)
+	}
+}
+
+// createMigration membuat pasangan file up/down di direktori migrasi modul.
+func createMigration(args []string, migrations []*migrate.Migration) error {
+	flags := flag.NewFlagSet("migrate create", flag.ContinueOnError)
+	module := flags.String("module", "", "nama modul, mis. auth atau articles")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *module == "" || flags.NArg() != 1 {
+		return errors.New(migrateUsage)
+	}
+
+	moduleDir := filepath.Join("internal", "modules", *module)
+	if _, err := os.Stat(moduleDir); err != nil {
+		return fmt.Errorf("unknown module %q: %w", *module, err)
+	}
+
+	upPath, downPath, err := migrate.Create(filepath.Join(moduleDir, "infrastructure", "persistence", "migrations"), flags.Arg(0), migrations)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("✅ Created %s", upPath)
+	log.Printf("✅ Created %s", downPath)
+	return nil
+}
@@ -1,28 +1,105 @@
 package app
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	_ "github.com/lib/pq"
 
 	"github.com/jokosaputro95/cms-news-api/configs"
+	articleusecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	articleevents "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/events"
+	articlerepos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/infrastructure/persistence/repositories"
+	articlehandlers "github.com/jokosaputro95/cms-news-api/internal/modules/articles/interface/rest/handlers"
+	articleroutes "github.com/jokosaputro95/cms-news-api/internal/modules/articles/interface/rest/routes"
+	articleworker "github.com/jokosaputro95/cms-news-api/internal/modules/articles/interface/worker"
 	"github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	authevents "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/events"
+	authrepos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	authmail "github.com/jokosaputro95/cms-news-api/internal/modules/auth/infrastructure/mail"
+	"github.com/jokosaputro95/cms-news-api/internal/modules/auth/infrastructure/persistence/memory"
 	"github.com/jokosaputro95/cms-news-api/internal/modules/auth/infrastructure/persistence/repositories"
 	"github.com/jokosaputro95/cms-news-api/internal/modules/auth/infrastructure/security"
+	authcli "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/cli"
 	"github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/handlers"
+	"github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/middleware"
 	"github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/routes"
+	categoryusecases "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/usecases"
+	categoryrepos "github.com/jokosaputro95/cms-news-api/internal/modules/categories/infrastructure/persistence/repositories"
+	categoryhandlers "github.com/jokosaputro95/cms-news-api/internal/modules/categories/interface/rest/handlers"
+	categoryroutes "github.com/jokosaputro95/cms-news-api/internal/modules/categories/interface/rest/routes"
+	tagusecases "github.com/jokosaputro95/cms-news-api/internal/modules/tags/application/usecases"
+	tagrepos "github.com/jokosaputro95/cms-news-api/internal/modules/tags/infrastructure/persistence/repositories"
+	taghandlers "github.com/jokosaputro95/cms-news-api/internal/modules/tags/interface/rest/handlers"
+	tagroutes "github.com/jokosaputro95/cms-news-api/internal/modules/tags/interface/rest/routes"
+	mediausecases "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/usecases"
+	mediaimaging "github.com/jokosaputro95/cms-news-api/internal/modules/media/infrastructure/imaging"
+	mediarepos "github.com/jokosaputro95/cms-news-api/internal/modules/media/infrastructure/persistence/repositories"
+	mediastorage "github.com/jokosaputro95/cms-news-api/internal/modules/media/infrastructure/storage"
+	mediahandlers "github.com/jokosaputro95/cms-news-api/internal/modules/media/interface/rest/handlers"
+	mediaroutes "github.com/jokosaputro95/cms-news-api/internal/modules/media/interface/rest/routes"
+	commentusecases "github.com/jokosaputro95/cms-news-api/internal/modules/comments/application/usecases"
+	commentvo "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/value_objects"
+	commentrepos "github.com/jokosaputro95/cms-news-api/internal/modules/comments/infrastructure/persistence/repositories"
+	commenthandlers "github.com/jokosaputro95/cms-news-api/internal/modules/comments/interface/rest/handlers"
+	commentroutes "github.com/jokosaputro95/cms-news-api/internal/modules/comments/interface/rest/routes"
+	feedusecases "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/application/usecases"
+	feedrepos "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/infrastructure/persistence/repositories"
+	feedhandlers "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/interface/rest/handlers"
+	feedroutes "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/interface/rest/routes"
+	sitemapusecases "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/usecases"
+	sitemaprepos "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/infrastructure/persistence/repositories"
+	sitemaphandlers "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/interface/rest/handlers"
+	sitemaproutes "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/interface/rest/routes"
+	outboxusecases "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/application/usecases"
+	outboxvo "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/value_objects"
+	outboxrepos "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/infrastructure/persistence/repositories"
+	outboxworker "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/interface/worker"
 	"github.com/jokosaputro95/cms-news-api/internal/shared"
 )
 
 type Server struct {
 	config      *configs.Configs
 	mux         *http.ServeMux
+	httpServer  *http.Server
 	db          *sql.DB
 	authHandler *handlers.AuthHandler
+	userHandler *handlers.UserHandler
+
+	articleHandler  *articlehandlers.ArticleHandler
+	categoryHandler *categoryhandlers.CategoryHandler
+	tagHandler      *taghandlers.TagHandler
+	mediaHandler    *mediahandlers.MediaHandler
+	commentHandler  *commenthandlers.CommentHandler
+	feedHandler     *feedhandlers.FeedHandler
+	sitemapHandler  *sitemaphandlers.SitemapHandler
+
+	// Middlewares
+	jwtMiddleware *middleware.JWTMiddleware
+
+	// CLI commands
+	userCommand *authcli.UserCommand
+
+	// Domain event bus (in-process)
+	eventBus *shared.InProcessEventBus
+
+	// Background workers (sweeper, scheduler, dsb.)
+	workers       []shared.Worker
+	cancelWorkers context.CancelFunc
+	workersDone   sync.WaitGroup
+
+	// Email yang dikirim di background (reset password, verifikasi ulang)
+	asyncMailer *authmail.AsyncMailer
 }
 
 func Run() {
@@ -64,6 +141,9 @@ func (s *Server) Initialize() error {
 	// 3. Setup routes (menggunakan routes package)
 	s.setupRoutes()
 
+	// 4. Start background workers
+	s.startWorkers()
+
 	log.Println("✅ Server initialized successfully")
 	return nil
 }
@@ -93,13 +173,57 @@ func (s *Server) setupDatabase() error {
 func (s *Server) setupDependencies() {
 	// === Infrastructure Layer ===
 	// Repositories
-	userRepository := repositories.NewUserRepositoryPostgres(s.db)
+	eventOutbox := outboxrepos.NewOutboxWriterPostgres()
+	userRepository := repositories.NewUserRepositoryPostgres(s.db, eventOutbox)
+	refreshTokenRepository := repositories.NewRefreshTokenRepositoryPostgres(s.db)
+	passwordResetTokenRepository := repositories.NewPasswordResetTokenRepositoryPostgres(s.db)
+	revocationStore := s.setupTokenRevocationStore()
+	articleRepository := articlerepos.NewArticleRepositoryPostgres(s.db, eventOutbox)
+	categoryRepository := categoryrepos.NewCategoryRepositoryPostgres(s.db)
+	tagRepository := tagrepos.NewTagRepositoryPostgres(s.db)
+	mediaRepository := mediarepos.NewMediaRepositoryPostgres(s.db)
+	commentRepository := commentrepos.NewCommentRepositoryPostgres(s.db)
+	feedRepository := feedrepos.NewFeedRepositoryPostgres(s.db)
+	sitemapRepository := sitemaprepos.NewSitemapRepositoryPostgres(s.db)
+	outboxRepository := outboxrepos.NewOutboxRepositoryPostgres(s.db)
+
+	// Media storage
+	blobStore := mediastorage.NewLocalBlobStore(s.config.MediaStorageDir, s.config.MediaBaseURL)
+	imageProcessor := mediaimaging.NewStdlibProcessor()
 
 	// Security services  
 	hasher := security.NewBcryptHasher(12)
 
+	// Mail
+	mailer := s.setupMailer()
+	// ✅ Endpoint publik yang tidak boleh membocorkan email terdaftar lewat latency
+	s.asyncMailer = authmail.NewAsyncMailer(mailer)
+
 	// Shared services
 	uuidGenerator := &shared.DefaultUUIDGenerator{}
+	txManager := shared.NewPostgresTxManager(s.db)
+	s.eventBus = shared.NewInProcessEventBus()
+	s.setupEventSubscribers()
+
+	tokenManager := security.NewJWTTokenManager(
+		s.config.JwtSecretKey,
+		s.config.JwtIssuer,
+		s.config.JwtAudience,
+		s.config.JwTExpiresIn,
+		s.config.JWTRefreshExpiresIn,
+		uuidGenerator,
+	)
+
+	emailVerificationSigner := security.NewJWTEmailVerificationSigner(
+		s.config.JwtSecretKey,
+		s.config.JwtIssuer,
+		s.config.JwtAudience,
+		s.config.EmailVerificationTTL,
+	)
+	emailVerificationSettings := usecases.EmailVerificationSettings{
+		VerifyURL:      s.config.EmailVerificationURL,
+		ResendInterval: s.config.EmailVerificationResendInterval,
+	}
 
 	// === Application Layer ===
 	// Use Cases
@@ -107,27 +231,398 @@ func (s *Server) setupDependencies() {
 		userRepository,
 		uuidGenerator,
 		hasher,
+		emailVerificationSigner,
+		mailer,
+		emailVerificationSettings,
+		s.eventBus,
+	)
+
+	loginUserUseCase := usecases.NewLoginUser(
+		userRepository,
+		refreshTokenRepository,
+		hasher,
+		tokenManager,
+	)
+
+	refreshTokenUseCase := usecases.NewRefreshToken(
+		userRepository,
+		refreshTokenRepository,
+		tokenManager,
+	)
+
+	logoutUserUseCase := usecases.NewLogoutUser(
+		refreshTokenRepository,
+		revocationStore,
+		tokenManager,
+	)
+
+	requestPasswordResetUseCase := usecases.NewRequestPasswordReset(
+		userRepository,
+		passwordResetTokenRepository,
+		tokenManager,
+		uuidGenerator,
+		s.asyncMailer,
+		usecases.PasswordResetSettings{ResetURL: s.config.PasswordResetURL, TTL: s.config.PasswordResetTTL},
+	)
+
+	resetPasswordUseCase := usecases.NewResetPassword(
+		userRepository,
+		passwordResetTokenRepository,
+		refreshTokenRepository,
+		tokenManager,
+		hasher,
+		txManager,
+	)
+
+	verifyEmailUseCase := usecases.NewVerifyEmail(userRepository, emailVerificationSigner)
+
+	resendEmailVerificationUseCase := usecases.NewResendEmailVerification(
+		userRepository,
+		emailVerificationSigner,
+		s.asyncMailer,
+		emailVerificationSettings,
+	)
+
+	assignUserRolesUseCase := usecases.NewAssignUserRoles(userRepository)
+
+	createArticleUseCase := articleusecases.NewCreateArticle(articleRepository, uuidGenerator)
+	getArticleUseCase := articleusecases.NewGetArticle(articleRepository)
+	listArticlesUseCase := articleusecases.NewListArticles(articleRepository)
+	updateArticleUseCase := articleusecases.NewUpdateArticle(articleRepository)
+	deleteArticleUseCase := articleusecases.NewDeleteArticle(articleRepository)
+	transitionArticleUseCase := articleusecases.NewTransitionArticle(articleRepository, uuidGenerator)
+	listArticleTransitionsUseCase := articleusecases.NewListArticleTransitions(articleRepository)
+	scheduleArticleUseCase := articleusecases.NewScheduleArticle(articleRepository)
+	listArticleRevisionsUseCase := articleusecases.NewListArticleRevisions(articleRepository)
+	getArticleRevisionUseCase := articleusecases.NewGetArticleRevision(articleRepository)
+	diffArticleRevisionsUseCase := articleusecases.NewDiffArticleRevisions(articleRepository)
+	restoreArticleRevisionUseCase := articleusecases.NewRestoreArticleRevision(articleRepository)
+	tagArticleUseCase := articleusecases.NewTagArticle(articleRepository, uuidGenerator)
+	publishScheduledArticlesUseCase := articleusecases.NewPublishScheduledArticles(articleRepository, uuidGenerator)
+	searchArticlesUseCase := articleusecases.NewSearchArticles(articleRepository)
+
+	createCategoryUseCase := categoryusecases.NewCreateCategory(categoryRepository, uuidGenerator)
+	getCategoryUseCase := categoryusecases.NewGetCategory(categoryRepository)
+	getCategoryTreeUseCase := categoryusecases.NewGetCategoryTree(categoryRepository)
+	updateCategoryUseCase := categoryusecases.NewUpdateCategory(categoryRepository)
+	moveCategoryUseCase := categoryusecases.NewMoveCategory(categoryRepository, txManager)
+	reorderCategoryUseCase := categoryusecases.NewReorderCategory(categoryRepository)
+	deleteCategoryUseCase := categoryusecases.NewDeleteCategory(categoryRepository)
+
+	autocompleteTagsUseCase := tagusecases.NewAutocompleteTags(tagRepository)
+	getTagUseCase := tagusecases.NewGetTag(tagRepository)
+	mergeTagsUseCase := tagusecases.NewMergeTags(tagRepository)
+
+	uploadMediaUseCase := mediausecases.NewUploadMedia(mediaRepository, blobStore, imageProcessor, uuidGenerator, s.config.MediaMaxUploadSize)
+	getMediaUseCase := mediausecases.NewGetMedia(mediaRepository, blobStore)
+	listMediaUseCase := mediausecases.NewListMedia(mediaRepository, blobStore)
+	updateMediaUseCase := mediausecases.NewUpdateMedia(mediaRepository, blobStore, imageProcessor)
+	deleteMediaUseCase := mediausecases.NewDeleteMedia(mediaRepository, blobStore)
+
+	createCommentUseCase := commentusecases.NewCreateComment(
+		commentRepository,
+		uuidGenerator,
+		commentvo.NewBlocklist(s.config.CommentBlocklist),
+		commentusecases.RateLimit{Max: s.config.CommentRateLimit, Window: s.config.CommentRateWindow},
+		txManager,
 	)
+	listArticleCommentsUseCase := commentusecases.NewListArticleComments(commentRepository)
+	listModerationQueueUseCase := commentusecases.NewListModerationQueue(commentRepository)
+	moderateCommentUseCase := commentusecases.NewModerateComment(commentRepository)
+
+	getFeedUseCase := feedusecases.NewGetFeed(feedRepository, feedusecases.FeedSettings{
+		Title:        s.config.SiteTitle,
+		Description:  s.config.SiteDescription,
+		SiteURL:      s.config.SiteURL,
+		MediaBaseURL: s.config.MediaBaseURL,
+		ItemLimit:    s.config.FeedItemLimit,
+	})
+
+	sitemapSettings := sitemapusecases.SitemapSettings{SiteURL: s.config.SiteURL, PublicationName: s.config.SiteTitle}
+	getSitemapIndexUseCase := sitemapusecases.NewGetSitemapIndex(sitemapRepository, sitemapSettings)
+	getSitemapUseCase := sitemapusecases.NewGetSitemap(sitemapRepository, sitemapSettings)
+	getNewsSitemapUseCase := sitemapusecases.NewGetNewsSitemap(sitemapRepository, sitemapSettings)
+
+	relayOutboxUseCase := outboxusecases.NewRelayOutbox(
+		outboxRepository,
+		s.eventBus,
+		outboxvo.RetryPolicy{
+			MaxAttempts: s.config.OutboxMaxAttempts,
+			BaseDelay:   s.config.OutboxRetryBaseDelay,
+			MaxDelay:    s.config.OutboxRetryMaxDelay,
+		},
+		s.config.OutboxRetention,
+	)
+	// ✅ Setiap event yang ditulis ke outbox harus punya decoder
+	relayOutboxUseCase.Register(authevents.UserRegisteredEvent, outboxusecases.DecodeAs[authevents.UserRegistered]())
+	relayOutboxUseCase.Register(articleevents.ArticlePublishedEvent, outboxusecases.DecodeAs[articleevents.ArticlePublished]())
+	relayOutboxUseCase.Register(articleevents.ArticleUnpublishedEvent, outboxusecases.DecodeAs[articleevents.ArticleUnpublished]())
 
 	// === Interface Layer ===
 	// Handlers
-	s.authHandler = handlers.NewAuthHandler(registerUserUseCase)
+	s.authHandler = handlers.NewAuthHandler(
+		registerUserUseCase,
+		loginUserUseCase,
+		refreshTokenUseCase,
+		logoutUserUseCase,
+		requestPasswordResetUseCase,
+		resetPasswordUseCase,
+		verifyEmailUseCase,
+		resendEmailVerificationUseCase,
+	)
+	s.userHandler = handlers.NewUserHandler(assignUserRolesUseCase)
+	s.articleHandler = articlehandlers.NewArticleHandler(
+		createArticleUseCase,
+		getArticleUseCase,
+		listArticlesUseCase,
+		updateArticleUseCase,
+		deleteArticleUseCase,
+		transitionArticleUseCase,
+		listArticleTransitionsUseCase,
+		scheduleArticleUseCase,
+		listArticleRevisionsUseCase,
+		getArticleRevisionUseCase,
+		diffArticleRevisionsUseCase,
+		restoreArticleRevisionUseCase,
+		tagArticleUseCase,
+		searchArticlesUseCase,
+	)
+	s.categoryHandler = categoryhandlers.NewCategoryHandler(
+		createCategoryUseCase,
+		getCategoryUseCase,
+		getCategoryTreeUseCase,
+		updateCategoryUseCase,
+		moveCategoryUseCase,
+		reorderCategoryUseCase,
+		deleteCategoryUseCase,
+	)
+	s.tagHandler = taghandlers.NewTagHandler(
+		autocompleteTagsUseCase,
+		getTagUseCase,
+		mergeTagsUseCase,
+	)
+	s.mediaHandler = mediahandlers.NewMediaHandler(
+		uploadMediaUseCase,
+		getMediaUseCase,
+		listMediaUseCase,
+		updateMediaUseCase,
+		deleteMediaUseCase,
+	)
+	s.commentHandler = commenthandlers.NewCommentHandler(
+		createCommentUseCase,
+		listArticleCommentsUseCase,
+		listModerationQueueUseCase,
+		moderateCommentUseCase,
+	)
+	s.feedHandler = feedhandlers.NewFeedHandler(getFeedUseCase)
+	s.sitemapHandler = sitemaphandlers.NewSitemapHandler(
+		getSitemapIndexUseCase,
+		getSitemapUseCase,
+		getNewsSitemapUseCase,
+	)
+
+	// Middlewares
+	s.jwtMiddleware = middleware.NewJWTMiddleware(tokenManager, revocationStore)
+
+	// CLI: akun dari CLI langsung terverifikasi tanpa email verifikasi
+	s.userCommand = authcli.NewUserCommand(
+		registerUserUseCase,
+		userRepository,
+		refreshTokenRepository,
+		hasher,
+		txManager,
+		os.Stdin,
+		os.Stdout,
+	)
+
+	// Workers
+	s.workers = append(s.workers, articleworker.NewScheduledPublisher(publishScheduledArticlesUseCase, s.config.ScheduledPublishInterval))
+	s.workers = append(s.workers, outboxworker.NewOutboxDispatcher(relayOutboxUseCase, s.config.OutboxPollInterval))
 
 	log.Println("✅ Dependencies wired successfully")
 }
 
+// setupTokenRevocationStore memilih implementasi revocation store sesuai config.
+// Store didaftarkan sebagai worker supaya sweeper-nya berjalan.
+func (s *Server) setupTokenRevocationStore() authrepos.TokenRevocationStore {
+	switch s.config.TokenRevocationStore {
+	case "memory":
+		store := memory.NewTokenRevocationStoreMemory(s.config.TokenRevocationSweepInterval)
+		s.workers = append(s.workers, store)
+		return store
+	default:
+		store := repositories.NewTokenRevocationStorePostgres(s.db, s.config.TokenRevocationSweepInterval)
+		s.workers = append(s.workers, store)
+		return store
+	}
+}
+
+// setupEventSubscribers mendaftarkan handler domain event. Efek samping
+// (cache, indexing, webhook) didaftarkan di sini, bukan di dalam use case.
+// Event yang ditulis ke outbox sampai ke handler lewat outbox dispatcher,
+// dengan retry jika handler mengembalikan error.
+func (s *Server) setupEventSubscribers() {
+	if s.config.AppDebug {
+		logEvent := func(ctx context.Context, event shared.DomainEvent) error {
+			log.Printf("📣 Event %s: %+v", event.EventName(), event)
+			return nil
+		}
+		for _, name := range []string{authevents.UserRegisteredEvent, articleevents.ArticlePublishedEvent, articleevents.ArticleUnpublishedEvent} {
+			s.eventBus.Subscribe(name, logEvent)
+		}
+	}
+}
+
+// setupMailer memilih implementasi Mailer sesuai MAIL_DRIVER.
+func (s *Server) setupMailer() authvo.Mailer {
+	switch s.config.MailDriver {
+	case "smtp":
+		return authmail.NewSMTPMailer(authmail.SMTPConfig{
+			Host:     s.config.SMTPHost,
+			Port:     s.config.SMTPPort,
+			Username: s.config.SMTPUsername,
+			Password: s.config.SMTPPassword,
+			From:     s.config.MailFrom,
+		})
+	case "file":
+		return authmail.NewFileMailer(s.config.MailFileDir, s.config.MailFrom)
+	default:
+		return authmail.NewLogMailer(s.config.MailFrom)
+	}
+}
+
+func (s *Server) startWorkers() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancelWorkers = cancel
+
+	for _, worker := range s.workers {
+		s.workersDone.Add(1)
+		go func(worker shared.Worker) {
+			defer s.workersDone.Done()
+			worker.Run(ctx)
+		}(worker)
+	}
+
+	log.Printf("✅ %d background worker(s) started", len(s.workers))
+}
+
 // ✅ Server sekarang clean - hanya delegate ke routes package
 func (s *Server) setupRoutes() {
-	routes.SetupRoutes(s.mux, s.config, s.db, s.authHandler)
+	routes.SetupRoutes(s.mux, s.config, s.db, s.authHandler, s.userHandler, s.jwtMiddleware)
+	articleroutes.SetupArticleRoutes(s.mux, s.articleHandler, s.jwtMiddleware)
+	categoryroutes.SetupCategoryRoutes(s.mux, s.categoryHandler, s.jwtMiddleware)
+	tagroutes.SetupTagRoutes(s.mux, s.tagHandler, s.jwtMiddleware)
+	commentroutes.SetupCommentRoutes(s.mux, s.commentHandler, s.jwtMiddleware)
+	mediaroutes.SetupMediaRoutes(s.mux, s.mediaHandler, mediastorage.FileServer(s.config.MediaStorageDir), s.jwtMiddleware)
+	feedroutes.SetupFeedRoutes(s.mux, s.feedHandler)
+	sitemaproutes.SetupSitemapRoutes(s.mux, s.sitemapHandler)
 }
 
+// Start menjalankan HTTP server sampai menerima SIGINT/SIGTERM, lalu
+// melakukan graceful shutdown.
 func (s *Server) Start() error {
 	address := fmt.Sprintf("%s:%s", s.config.ServerHost, s.config.ServerPort)
 
+	s.httpServer = &http.Server{
+		Addr:         address,
+		Handler:      s.mux,
+		ReadTimeout:  s.config.ServerReadTimeout,
+		WriteTimeout: s.config.ServerWriteTimeout,
+		IdleTimeout:  s.config.ServerIdleTimeout,
+	}
+
 	log.Printf("INFO: %-16s: %s", "APP_NAME", s.config.AppName)
 	log.Printf("INFO: %-16s: %s", "APP_VERSION", s.config.AppVersion)
 	log.Printf("INFO: %-16s: %s", "APP_ENV", s.config.AppEnv)
 	log.Printf("🚀 Server starting on http://%s", address)
 
-	return http.ListenAndServe(address, s.mux)
-}
\ No newline at end of file
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- s.httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		// ✅ Gagal listen (mis. port sudah dipakai): worker dan DB tetap dibereskan
+		if shutdownErr := s.Shutdown(); shutdownErr != nil {
+			log.Printf("❌ Shutdown failed: %v", shutdownErr)
+		}
+		return err
+	case <-ctx.Done():
+		log.Println("🛑 Shutdown signal received, draining in-flight requests")
+	}
+
+	// Sinyal kedua langsung menghentikan proses seperti biasa
+	stop()
+
+	return s.Shutdown()
+}
+
+// Shutdown berhenti menerima koneksi baru, menunggu request yang sedang
+// berjalan, menghentikan worker lalu menutup pool DB. Seluruh langkah
+// berbagi satu batas waktu ServerShutdownTimeout.
+func (s *Server) Shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.ServerShutdownTimeout)
+	defer cancel()
+
+	var errs []error
+
+	// 1. Drain request HTTP
+	if s.httpServer != nil {
+		if err := s.httpServer.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("http server: %w", err))
+			// Koneksi yang belum selesai sampai deadline diputus paksa
+			s.httpServer.Close()
+		}
+	}
+
+	// 2. Menghentikan background worker
+	if err := s.stopWorkers(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	// 3. Menunggu email yang masih dikirim di background
+	if s.asyncMailer != nil {
+		if err := s.asyncMailer.Wait(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("mailer: %w", err))
+		}
+	}
+
+	// 4. Menutup pool DB setelah tidak ada lagi yang memakainya
+	if s.db != nil {
+		if err := s.db.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("database: %w", err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	log.Println("✅ Server stopped gracefully")
+	return nil
+}
+
+// stopWorkers membatalkan ctx worker dan menunggu semuanya keluar dari Run.
+func (s *Server) stopWorkers(ctx context.Context) error {
+	if s.cancelWorkers == nil {
+		return nil
+	}
+	s.cancelWorkers()
+
+	done := make(chan struct{})
+	go func() {
+		s.workersDone.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("workers: %w", ctx.Err())
+	}
+}
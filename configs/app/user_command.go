@@ -0,0 +1,21 @@
+package app
+
+import (
+	"context"
+
+	"github.com/jokosaputro95/cms-news-api/configs"
+)
+
+// User menjalankan subcommand "user" (create, reset-password, list, delete)
+// memakai dependency yang sama dengan server HTTP.
+func User(args []string) error {
+	server := &Server{config: configs.LoadConfig(true, ".env")}
+	if err := server.setupDatabase(); err != nil {
+		return err
+	}
+	defer server.db.Close()
+
+	server.setupDependencies()
+
+	return server.userCommand.Run(context.Background(), args)
+}
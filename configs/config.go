@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -20,6 +22,10 @@ type Configs struct {
 	// Server
 	ServerHost string
 	ServerPort string
+	ServerReadTimeout time.Duration
+	ServerWriteTimeout time.Duration
+	ServerIdleTimeout time.Duration
+	ServerShutdownTimeout time.Duration // batas waktu drain request saat SIGINT/SIGTERM
 
 	// Database
 	DBHost string
@@ -31,8 +37,60 @@ type Configs struct {
 
 	// JWT
 	JwtSecretKey string
+	JwtIssuer string
+	JwtAudience string
 	JwTExpiresIn time.Duration
 	JWTRefreshExpiresIn time.Duration
+
+	// Token revocation
+	TokenRevocationStore string // "postgres" atau "memory"
+	TokenRevocationSweepInterval time.Duration
+
+	// Scheduled publishing
+	ScheduledPublishInterval time.Duration
+
+	// Site (URL publik untuk feed dan sitemap)
+	SiteURL string
+	SiteTitle string
+	SiteDescription string
+
+	// Media
+	MediaStorageDir string
+	MediaBaseURL string
+	MediaMaxUploadSize int64 // dalam byte
+
+	// Comments
+	CommentBlocklist []string
+	CommentRateLimit int // jumlah komentar per CommentRateWindow, 0 = tanpa batas
+	CommentRateWindow time.Duration
+
+	// Feeds
+	FeedItemLimit int
+
+	// Mail
+	MailDriver string // "smtp", "file" atau "log"
+	MailFrom string
+	MailFileDir string
+	SMTPHost string
+	SMTPPort string
+	SMTPUsername string
+	SMTPPassword string
+
+	// Password reset
+	PasswordResetURL string
+	PasswordResetTTL time.Duration
+
+	// Email verification
+	EmailVerificationURL string
+	EmailVerificationTTL time.Duration
+	EmailVerificationResendInterval time.Duration
+
+	// Outbox
+	OutboxPollInterval time.Duration
+	OutboxMaxAttempts int // 0 = retry tanpa batas
+	OutboxRetryBaseDelay time.Duration
+	OutboxRetryMaxDelay time.Duration
+	OutboxRetention time.Duration // 0 = pesan terkirim tidak dihapus
 }
 
 var (
@@ -62,6 +120,97 @@ func LoadConfig(isTest bool, envPath string) *Configs {
 		}
 
 
+		serverReadTimeout, err := getEnvDuration("HTTP_READ_TIMEOUT", 30*time.Second)
+		if err != nil {
+			log.Fatalf("Error parsing HTTP_READ_TIMEOUT: %v", err)
+		}
+
+		serverWriteTimeout, err := getEnvDuration("HTTP_WRITE_TIMEOUT", 30*time.Second)
+		if err != nil {
+			log.Fatalf("Error parsing HTTP_WRITE_TIMEOUT: %v", err)
+		}
+
+		serverIdleTimeout, err := getEnvDuration("HTTP_IDLE_TIMEOUT", 120*time.Second)
+		if err != nil {
+			log.Fatalf("Error parsing HTTP_IDLE_TIMEOUT: %v", err)
+		}
+
+		// Default di bawah terminationGracePeriodSeconds Kubernetes (30 detik)
+		serverShutdownTimeout, err := getEnvDuration("HTTP_SHUTDOWN_TIMEOUT", 25*time.Second)
+		if err != nil {
+			log.Fatalf("Error parsing HTTP_SHUTDOWN_TIMEOUT: %v", err)
+		}
+
+		revocationSweepInterval, err := getEnvPositiveDuration("TOKEN_REVOCATION_SWEEP_INTERVAL", time.Minute)
+		if err != nil {
+			log.Fatalf("Error parsing TOKEN_REVOCATION_SWEEP_INTERVAL: %v", err)
+		}
+
+		scheduledPublishInterval, err := getEnvPositiveDuration("SCHEDULED_PUBLISH_INTERVAL", 30*time.Second)
+		if err != nil {
+			log.Fatalf("Error parsing SCHEDULED_PUBLISH_INTERVAL: %v", err)
+		}
+
+		mediaMaxUploadSize, err := strconv.ParseInt(getEnv("MEDIA_MAX_UPLOAD_SIZE_MB", "10"), 10, 64)
+		if err != nil {
+			log.Fatalf("Error parsing MEDIA_MAX_UPLOAD_SIZE_MB: %v", err)
+		}
+
+		commentRateLimit, err := strconv.Atoi(getEnv("COMMENT_RATE_LIMIT", "5"))
+		if err != nil {
+			log.Fatalf("Error parsing COMMENT_RATE_LIMIT: %v", err)
+		}
+
+		commentRateWindow, err := getEnvDuration("COMMENT_RATE_WINDOW", 10*time.Minute)
+		if err != nil {
+			log.Fatalf("Error parsing COMMENT_RATE_WINDOW: %v", err)
+		}
+
+		feedItemLimit, err := strconv.Atoi(getEnv("FEED_ITEM_LIMIT", "20"))
+		if err != nil {
+			log.Fatalf("Error parsing FEED_ITEM_LIMIT: %v", err)
+		}
+
+		passwordResetTTL, err := getEnvDuration("PASSWORD_RESET_TTL", time.Hour)
+		if err != nil {
+			log.Fatalf("Error parsing PASSWORD_RESET_TTL: %v", err)
+		}
+
+		emailVerificationTTL, err := getEnvDuration("EMAIL_VERIFICATION_TTL", 24*time.Hour)
+		if err != nil {
+			log.Fatalf("Error parsing EMAIL_VERIFICATION_TTL: %v", err)
+		}
+
+		emailVerificationResendInterval, err := getEnvDuration("EMAIL_VERIFICATION_RESEND_INTERVAL", 5*time.Minute)
+		if err != nil {
+			log.Fatalf("Error parsing EMAIL_VERIFICATION_RESEND_INTERVAL: %v", err)
+		}
+
+		outboxPollInterval, err := getEnvPositiveDuration("OUTBOX_POLL_INTERVAL", time.Second)
+		if err != nil {
+			log.Fatalf("Error parsing OUTBOX_POLL_INTERVAL: %v", err)
+		}
+
+		outboxMaxAttempts, err := strconv.Atoi(getEnv("OUTBOX_MAX_ATTEMPTS", "10"))
+		if err != nil {
+			log.Fatalf("Error parsing OUTBOX_MAX_ATTEMPTS: %v", err)
+		}
+
+		outboxRetryBaseDelay, err := getEnvDuration("OUTBOX_RETRY_BASE_DELAY", 5*time.Second)
+		if err != nil {
+			log.Fatalf("Error parsing OUTBOX_RETRY_BASE_DELAY: %v", err)
+		}
+
+		outboxRetryMaxDelay, err := getEnvDuration("OUTBOX_RETRY_MAX_DELAY", time.Hour)
+		if err != nil {
+			log.Fatalf("Error parsing OUTBOX_RETRY_MAX_DELAY: %v", err)
+		}
+
+		outboxRetention, err := getEnvDuration("OUTBOX_RETENTION", 7*24*time.Hour)
+		if err != nil {
+			log.Fatalf("Error parsing OUTBOX_RETENTION: %v", err)
+		}
+
 		if isTest {
 			dbHost = os.Getenv("PG_HOST_TEST")
 			dbPort = os.Getenv("PG_PORT_TEST")
@@ -87,6 +236,10 @@ func LoadConfig(isTest bool, envPath string) *Configs {
 
 			ServerHost: os.Getenv("HTTP_HOST"),
 			ServerPort: os.Getenv("HTTP_PORT"),
+			ServerReadTimeout: serverReadTimeout,
+			ServerWriteTimeout: serverWriteTimeout,
+			ServerIdleTimeout: serverIdleTimeout,
+			ServerShutdownTimeout: serverShutdownTimeout,
 
 			DBHost: dbHost,
 			DBPort: dbPort,
@@ -96,8 +249,50 @@ func LoadConfig(isTest bool, envPath string) *Configs {
 			DBSSLMode: dbSSLMode,
 
 			JwtSecretKey: os.Getenv("JWT_SECRET_KEY"),
+			JwtIssuer: getEnv("JWT_ISSUER", "cms-news-api"),
+			JwtAudience: getEnv("JWT_AUDIENCE", "cms-news-api"),
 			JwTExpiresIn: jwtExpresIn,
 			JWTRefreshExpiresIn: jwtRefreshExpresIn,
+
+			TokenRevocationStore: getEnv("TOKEN_REVOCATION_STORE", "postgres"),
+			TokenRevocationSweepInterval: revocationSweepInterval,
+
+			ScheduledPublishInterval: scheduledPublishInterval,
+
+			SiteURL: getEnv("SITE_URL", "http://localhost:8080"),
+			SiteTitle: getEnv("SITE_TITLE", getEnv("APP_NAME", "CMS News")),
+			SiteDescription: getEnv("SITE_DESCRIPTION", "Berita terbaru"),
+
+			MediaStorageDir: getEnv("MEDIA_STORAGE_DIR", "./storage/media"),
+			MediaBaseURL: getEnv("MEDIA_BASE_URL", "/media"),
+			MediaMaxUploadSize: mediaMaxUploadSize << 20,
+
+			CommentBlocklist: getEnvList("COMMENT_BLOCKLIST"),
+			CommentRateLimit: commentRateLimit,
+			CommentRateWindow: commentRateWindow,
+
+			FeedItemLimit: feedItemLimit,
+
+			MailDriver: getEnv("MAIL_DRIVER", "log"),
+			MailFrom: getEnv("MAIL_FROM", "CMS News <no-reply@localhost>"),
+			MailFileDir: getEnv("MAIL_FILE_DIR", "./storage/mail"),
+			SMTPHost: os.Getenv("SMTP_HOST"),
+			SMTPPort: getEnv("SMTP_PORT", "587"),
+			SMTPUsername: os.Getenv("SMTP_USERNAME"),
+			SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+
+			PasswordResetURL: getEnv("PASSWORD_RESET_URL", strings.TrimRight(getEnv("SITE_URL", "http://localhost:8080"), "/")+"/reset-password"),
+			PasswordResetTTL: passwordResetTTL,
+
+			EmailVerificationURL: getEnv("EMAIL_VERIFICATION_URL", strings.TrimRight(getEnv("SITE_URL", "http://localhost:8080"), "/")+"/verify-email"),
+			EmailVerificationTTL: emailVerificationTTL,
+			EmailVerificationResendInterval: emailVerificationResendInterval,
+
+			OutboxPollInterval: outboxPollInterval,
+			OutboxMaxAttempts: outboxMaxAttempts,
+			OutboxRetryBaseDelay: outboxRetryBaseDelay,
+			OutboxRetryMaxDelay: outboxRetryMaxDelay,
+			OutboxRetention: outboxRetention,
 		}
 	})
 
@@ -110,4 +305,44 @@ func (c *Configs) GetDatabaseDSN() string {
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		c.DBHost, c.DBPort, c.DBUser, c.DBPass, c.DBName, c.DBSSLMode,
 	)
-}
\ No newline at end of file
+}
+
+// getEnv returns env value or fallback when it is not set
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+// getEnvList splits a comma separated env value, skipping empty items
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getEnvDuration parses env value as time.Duration or returns fallback when it is not set
+func getEnvDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		return fallback, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// getEnvPositiveDuration is getEnvDuration for ticker intervals, which must be greater than zero
+func getEnvPositiveDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value, err := getEnvDuration(key, fallback)
+	if err != nil {
+		return 0, err
+	}
+	if value <= 0 {
+		return 0, fmt.Errorf("must be greater than zero, got %s", value)
+	}
+	return value, nil
+}
@@ -3,6 +3,7 @@ module github.com/jokosaputro95/cms-news-api
 go 1.24.5
 
 require (
+	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/google/uuid v1.6.0
 	github.com/joho/godotenv v1.5.1
 	github.com/lib/pq v1.10.9
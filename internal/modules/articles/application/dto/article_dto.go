@@ -0,0 +1,165 @@
+package dto
+
+import (
+	"time"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type CreateArticleInput struct {
+	Title   string `json:"title" validate:"required,min=5,max=200"`
+	Slug    string `json:"slug,omitempty"`
+	Body    string `json:"body" validate:"required"`
+	Excerpt string `json:"excerpt,omitempty" validate:"max=500"`
+	// Language adalah kode bahasa artikel (id/en), default id.
+	Language string `json:"language,omitempty" validate:"omitempty,oneof=id en"`
+
+	CategoryID string `json:"category_id,omitempty"`
+	// FeaturedImageID adalah ID gambar dari media library.
+	FeaturedImageID string `json:"featured_image_id,omitempty"`
+	// Tags berisi nama tag bebas; butuh permission tag:manage.
+	Tags []string `json:"tags,omitempty"`
+
+	Actor *shared.Principal `json:"-"`
+}
+
+// UpdateArticleInput memakai pointer: field nil berarti tidak diubah.
+type UpdateArticleInput struct {
+	ID      string  `json:"-"`
+	Title   *string `json:"title,omitempty"`
+	Body    *string `json:"body,omitempty"`
+	Excerpt *string `json:"excerpt,omitempty"`
+
+	Language *string `json:"language,omitempty"`
+
+	// Slug berisi string kosong untuk membuat ulang slug dari judul.
+	// Slug lama artikel yang sudah tayang tetap diarahkan (301) ke slug baru.
+	Slug *string `json:"slug,omitempty"`
+
+	// CategoryID berisi string kosong untuk melepas artikel dari section-nya.
+	CategoryID *string `json:"category_id,omitempty"`
+
+	// FeaturedImageID berisi string kosong untuk melepas gambar utama.
+	FeaturedImageID *string `json:"featured_image_id,omitempty"`
+
+	ChangeNote string `json:"change_note,omitempty" validate:"max=500"`
+
+	Actor *shared.Principal `json:"-"`
+}
+
+type GetArticleInput struct {
+	ID   string
+	Slug string
+
+	// Actor boleh nil untuk pembaca anonim.
+	Actor *shared.Principal
+}
+
+type ListArticlesInput struct {
+	Status   string
+	AuthorID string
+	// CategoryID memfilter artikel di section ini beserta seluruh sub-section-nya.
+	CategoryID string
+	// Tag adalah slug tag (atau alias hasil merge).
+	Tag   string
+	Page  int
+	Limit int
+
+	Actor *shared.Principal
+}
+
+type DeleteArticleInput struct {
+	ID    string
+	Actor *shared.Principal
+}
+
+// ScheduleArticleInput: field nil berarti jadwal tersebut dihapus.
+type ScheduleArticleInput struct {
+	ArticleID   string     `json:"-"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+
+	Actor *shared.Principal `json:"-"`
+}
+
+// TagArticleInput mengganti seluruh tag artikel; slice kosong melepas semua tag.
+type TagArticleInput struct {
+	ArticleID string   `json:"-"`
+	Tags      []string `json:"tags"`
+
+	Actor *shared.Principal `json:"-"`
+}
+
+type ArticleTagOutput struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type ArticleOutput struct {
+	ID              string             `json:"id"`
+	Title           string             `json:"title"`
+	Slug            string             `json:"slug"`
+	Body            string             `json:"body"`
+	Excerpt         string             `json:"excerpt"`
+	Language        string             `json:"language"`
+	AuthorID        string             `json:"author_id"`
+	CategoryID      string             `json:"category_id,omitempty"`
+	FeaturedImageID string             `json:"featured_image_id,omitempty"`
+	Tags            []ArticleTagOutput `json:"tags"`
+	Status          string             `json:"status"`
+	PublishedAt     *time.Time         `json:"published_at,omitempty"`
+	PublishAt       *time.Time         `json:"publish_at,omitempty"`
+	UnpublishAt     *time.Time         `json:"unpublish_at,omitempty"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+type ArticleListOutput struct {
+	Items []*ArticleOutput `json:"items"`
+	Page  int              `json:"page"`
+	Limit int              `json:"limit"`
+	Total int              `json:"total"`
+}
+
+// SearchArticlesInput: From/To berformat YYYY-MM-DD (inklusif) atau RFC 3339.
+type SearchArticlesInput struct {
+	Query      string
+	Language   string
+	CategoryID string
+	Tag        string
+	AuthorID   string
+	From       string
+	To         string
+	Page       int
+	Limit      int
+}
+
+// SearchHighlightOutput berisi HTML yang sudah di-escape dengan kata yang
+// cocok diapit <mark>...</mark>.
+type SearchHighlightOutput struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type SearchHitOutput struct {
+	ID              string                `json:"id"`
+	Title           string                `json:"title"`
+	Slug            string                `json:"slug"`
+	Excerpt         string                `json:"excerpt"`
+	Language        string                `json:"language"`
+	AuthorID        string                `json:"author_id"`
+	CategoryID      string                `json:"category_id,omitempty"`
+	FeaturedImageID string                `json:"featured_image_id,omitempty"`
+	Tags            []ArticleTagOutput    `json:"tags"`
+	PublishedAt     *time.Time            `json:"published_at,omitempty"`
+	Rank            float64               `json:"rank"`
+	Highlight       SearchHighlightOutput `json:"highlight"`
+}
+
+type SearchOutput struct {
+	Query string             `json:"query"`
+	Items []*SearchHitOutput `json:"items"`
+	Page  int                `json:"page"`
+	Limit int                `json:"limit"`
+	Total int                `json:"total"`
+}
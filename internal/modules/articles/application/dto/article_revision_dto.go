@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"time"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type ListArticleRevisionsInput struct {
+	ArticleID string
+	Actor     *shared.Principal
+}
+
+type GetArticleRevisionInput struct {
+	ArticleID string
+	Number    int
+	Actor     *shared.Principal
+}
+
+type DiffArticleRevisionsInput struct {
+	ArticleID string
+	From      int
+	To        int
+	Actor     *shared.Principal
+}
+
+type RestoreArticleRevisionInput struct {
+	ArticleID  string `json:"-"`
+	Number     int    `json:"-"`
+	ChangeNote string `json:"change_note,omitempty" validate:"max=500"`
+
+	Actor *shared.Principal `json:"-"`
+}
+
+// ArticleRevisionOutput: Body kosong pada daftar revisi, diisi saat revisi diambil satu per satu.
+type ArticleRevisionOutput struct {
+	ArticleID  string    `json:"article_id"`
+	Number     int       `json:"number"`
+	Title      string    `json:"title"`
+	Body       string    `json:"body,omitempty"`
+	Excerpt    string    `json:"excerpt"`
+	EditorID   string    `json:"editor_id"`
+	ChangeNote string    `json:"change_note"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// DiffLineOutput adalah satu baris hasil diff. Op bernilai "equal", "insert" atau "delete";
+// OldLine/NewLine adalah nomor baris (mulai dari 1) di revisi asal/tujuan.
+type DiffLineOutput struct {
+	Op      string `json:"op"`
+	Text    string `json:"text"`
+	OldLine int    `json:"old_line,omitempty"`
+	NewLine int    `json:"new_line,omitempty"`
+}
+
+type ArticleRevisionDiffOutput struct {
+	ArticleID string            `json:"article_id"`
+	From      int               `json:"from"`
+	To        int               `json:"to"`
+	TitleFrom string            `json:"title_from"`
+	TitleTo   string            `json:"title_to"`
+	Lines     []*DiffLineOutput `json:"lines"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type TransitionArticleInput struct {
+	ArticleID string `json:"-"`
+	Action    string `json:"action" validate:"required,oneof=submit approve request_changes publish archive"`
+	Comment   string `json:"comment,omitempty" validate:"max=2000"`
+
+	Actor *shared.Principal `json:"-"`
+}
+
+type ListArticleTransitionsInput struct {
+	ArticleID string
+	Actor     *shared.Principal
+}
+
+type ArticleTransitionOutput struct {
+	ID         string    `json:"id"`
+	ArticleID  string    `json:"article_id"`
+	Action     string    `json:"action"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    string    `json:"actor_id"`
+	Comment    string    `json:"comment"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type TransitionArticleOutput struct {
+	Article    *ArticleOutput           `json:"article"`
+	Transition *ArticleTransitionOutput `json:"transition"`
+}
@@ -0,0 +1,179 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	tagvo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	// maxSlugSuffix membatasi percobaan akhiran angka untuk slug yang bentrok.
+	maxSlugSuffix       = 50
+	initialRevisionNote = "Initial version"
+)
+
+// resolveSlug memastikan slug belum dipakai artikel lain, termasuk slug lama
+// di tabel redirect. Slug yang dibuat dari judul diberi akhiran -2, -3, dst.;
+// slug yang diisi eksplisit oleh editor dilaporkan sebagai conflict.
+// articleID kosong untuk artikel baru; artikel lama boleh memakai kembali
+// slug lamanya sendiri.
+func resolveSlug(ctx context.Context, articleRepo repos.ArticleRepository, base vo.Slug, explicit bool, articleID string) (*vo.Slug, error) {
+	for n := 1; n <= maxSlugSuffix; n++ {
+		candidate := base
+		if n > 1 {
+			candidate = base.WithSuffix(n)
+		}
+
+		available, err := slugAvailable(ctx, articleRepo, candidate.String(), articleID)
+		if err != nil {
+			return nil, err
+		}
+		if available {
+			return &candidate, nil
+		}
+		if explicit {
+			return nil, shared.NewConflictError("Slug already used by another article")
+		}
+	}
+
+	return nil, shared.NewConflictError("Could not find an available slug, please choose one")
+}
+
+func slugAvailable(ctx context.Context, articleRepo repos.ArticleRepository, slug, articleID string) (bool, error) {
+	if articleID != "" {
+		owner, err := articleRepo.FindRedirect(ctx, slug)
+		if err != nil {
+			return false, shared.NewDatabaseError(err)
+		}
+		if owner == articleID {
+			return true, nil
+		}
+	}
+
+	exists, err := articleRepo.ExistsBySlug(ctx, slug)
+	if err != nil {
+		return false, shared.NewDatabaseError(err)
+	}
+	return !exists, nil
+}
+
+func toArticleOutput(article *entities.Article) *dto.ArticleOutput {
+	return &dto.ArticleOutput{
+		ID:              article.ID,
+		Title:           article.Title.String(),
+		Slug:            article.Slug,
+		Body:            article.Body,
+		Excerpt:         article.Excerpt,
+		Language:        article.Language.String(),
+		AuthorID:        article.AuthorID,
+		CategoryID:      article.CategoryID,
+		FeaturedImageID: article.FeaturedImageID,
+		Tags:            toArticleTagOutputs(article.Tags),
+		Status:          article.Status.String(),
+		PublishedAt:     article.PublishedAt,
+		PublishAt:       article.PublishAt,
+		UnpublishAt:     article.UnpublishAt,
+		CreatedAt:       article.CreatedAt,
+		UpdatedAt:       article.UpdatedAt,
+	}
+}
+
+func toArticleTagOutputs(articleTags []entities.ArticleTag) []dto.ArticleTagOutput {
+	tags := make([]dto.ArticleTagOutput, 0, len(articleTags))
+	for _, tag := range articleTags {
+		tags = append(tags, dto.ArticleTagOutput{Name: tag.Name, Slug: tag.Slug})
+	}
+	return tags
+}
+
+func hasPermission(actor *shared.Principal, permission authvo.Permission) bool {
+	return actor != nil && actor.HasPermission(string(permission))
+}
+
+// canView: artikel published terbuka untuk publik, selain itu hanya
+// penulisnya dan editor yang boleh melihat.
+func canView(article *entities.Article, actor *shared.Principal) bool {
+	if article.IsPublished() {
+		return true
+	}
+	if actor == nil {
+		return false
+	}
+	return article.IsOwnedBy(actor.UserID) || hasPermission(actor, authvo.PermissionArticleUpdateAny)
+}
+
+// canEdit: penulis boleh mengubah draft miliknya sendiri, artikel lain
+// (atau yang sudah keluar dari draft) butuh article:update_any.
+func canEdit(article *entities.Article, actor *shared.Principal) bool {
+	if hasPermission(actor, authvo.PermissionArticleUpdateAny) {
+		return true
+	}
+	return article.IsOwnedBy(actor.UserID) && article.IsDraft() && hasPermission(actor, authvo.PermissionArticleUpdate)
+}
+
+// canViewHistory: riwayat workflow dan revisi hanya untuk penulisnya dan
+// editor, termasuk untuk artikel yang sudah published.
+func canViewHistory(article *entities.Article, actor *shared.Principal) bool {
+	return actor != nil && (article.IsOwnedBy(actor.UserID) || hasPermission(actor, authvo.PermissionArticleUpdateAny))
+}
+
+// ensureCategoryExists memvalidasi category_id; string kosong berarti tanpa section.
+func ensureCategoryExists(ctx context.Context, articleRepo repos.ArticleRepository, categoryID string) error {
+	if categoryID == "" {
+		return nil
+	}
+
+	exists, err := articleRepo.CategoryExists(ctx, categoryID)
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if !exists {
+		return shared.NewValidationError("category does not exist")
+	}
+	return nil
+}
+
+// ensureImageExists memvalidasi featured_image_id; string kosong berarti tanpa gambar.
+func ensureImageExists(ctx context.Context, articleRepo repos.ArticleRepository, mediaID string) error {
+	if mediaID == "" {
+		return nil
+	}
+
+	exists, err := articleRepo.ImageExists(ctx, mediaID)
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if !exists {
+		return shared.NewValidationError("featured image does not exist or is not an image")
+	}
+	return nil
+}
+
+// buildArticleTags menormalisasi nama tag bebas dengan value object modul tags.
+// Setiap tag diberi ID baru yang hanya dipakai jika tag tersebut belum ada.
+func buildArticleTags(names []string, uuidGen shared.UUIDGenerator) ([]entities.ArticleTag, error) {
+	tags := make([]entities.ArticleTag, 0, len(names))
+	for _, name := range names {
+		nameVO, err := tagvo.NewTagName(name)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		slugVO, err := tagvo.NewTagSlug(name)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+
+		tags = append(tags, entities.ArticleTag{
+			ID:   uuidGen.NewUUID(),
+			Name: nameVO.String(),
+			Slug: slugVO.String(),
+		})
+	}
+	return tags, nil
+}
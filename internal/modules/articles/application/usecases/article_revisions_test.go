@@ -0,0 +1,164 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func newRevision(number int, title, body string) *entities.ArticleRevision {
+	return &entities.ArticleRevision{
+		ArticleID: "article-uuid",
+		Number:    number,
+		Title:     title,
+		Body:      body,
+		EditorID:  "author-uuid",
+	}
+}
+
+func TestListArticleRevisions(t *testing.T) {
+	t.Run("should list revisions without bodies for the author", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		listRevisionsUsecase := usecases.NewListArticleRevisions(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("FindRevisions", mock.Anything, article.ID).Return([]*entities.ArticleRevision{
+			newRevision(2, "Judul kedua", "Isi kedua."),
+			newRevision(1, "Judul pertama", "Isi pertama."),
+		}, nil).Once()
+
+		output, err := listRevisionsUsecase.Execute(context.Background(), &dto.ListArticleRevisionsInput{
+			ArticleID: article.ID, Actor: newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Nil(t, err)
+		assert.Len(t, output, 2)
+		assert.Equal(t, 2, output[0].Number)
+		assert.Empty(t, output[0].Body)
+	})
+
+	t.Run("should forbid readers from viewing the history of a published article", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		listRevisionsUsecase := usecases.NewListArticleRevisions(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		_, err := listRevisionsUsecase.Execute(context.Background(), &dto.ListArticleRevisionsInput{
+			ArticleID: article.ID, Actor: newPrincipal("reader-uuid", authvo.RoleReader),
+		})
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "FindRevisions", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetArticleRevision(t *testing.T) {
+	t.Run("should return not found for an unknown revision", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		getRevisionUsecase := usecases.NewGetArticleRevision(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("FindRevision", mock.Anything, article.ID, 9).Return(nil, nil).Once()
+
+		_, err := getRevisionUsecase.Execute(context.Background(), &dto.GetArticleRevisionInput{
+			ArticleID: article.ID, Number: 9, Actor: newPrincipal("editor-uuid", authvo.RoleEditor),
+		})
+
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+	})
+}
+
+func TestDiffArticleRevisions(t *testing.T) {
+	t.Run("should produce a line level diff between two revisions", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		diffRevisionsUsecase := usecases.NewDiffArticleRevisions(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("FindRevision", mock.Anything, article.ID, 1).
+			Return(newRevision(1, "Judul lama", "Paragraf satu.\nKorban 3 orang.\nParagraf tiga."), nil).Once()
+		articleRepoMock.On("FindRevision", mock.Anything, article.ID, 2).
+			Return(newRevision(2, "Judul baru", "Paragraf satu.\nKorban 5 orang.\nParagraf tiga.\nUpdate 14:02."), nil).Once()
+
+		output, err := diffRevisionsUsecase.Execute(context.Background(), &dto.DiffArticleRevisionsInput{
+			ArticleID: article.ID, From: 1, To: 2, Actor: newPrincipal("editor-uuid", authvo.RoleEditor),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Judul lama", output.TitleFrom)
+		assert.Equal(t, "Judul baru", output.TitleTo)
+
+		expected := []dto.DiffLineOutput{
+			{Op: "equal", Text: "Paragraf satu.", OldLine: 1, NewLine: 1},
+			{Op: "delete", Text: "Korban 3 orang.", OldLine: 2},
+			{Op: "insert", Text: "Korban 5 orang.", NewLine: 2},
+			{Op: "equal", Text: "Paragraf tiga.", OldLine: 3, NewLine: 3},
+			{Op: "insert", Text: "Update 14:02.", NewLine: 4},
+		}
+		if assert.Len(t, output.Lines, len(expected)) {
+			for i, line := range output.Lines {
+				assert.Equal(t, expected[i], *line)
+			}
+		}
+	})
+
+	t.Run("should validate revision numbers", func(t *testing.T) {
+		diffRevisionsUsecase := usecases.NewDiffArticleRevisions(new(MockArticleRepository))
+
+		_, err := diffRevisionsUsecase.Execute(context.Background(), &dto.DiffArticleRevisionsInput{
+			ArticleID: "article-uuid", From: 0, To: 2, Actor: newPrincipal("editor-uuid", authvo.RoleEditor),
+		})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+	})
+}
+
+func TestRestoreArticleRevision(t *testing.T) {
+	t.Run("should restore an old revision as a new revision", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		restoreRevisionUsecase := usecases.NewRestoreArticleRevision(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("FindRevision", mock.Anything, article.ID, 1).Return(newRevision(1, "Judul pertama", "Isi pertama."), nil).Once()
+		articleRepoMock.On("Revise", mock.Anything, article, mock.MatchedBy(func(r *entities.ArticleRevision) bool {
+			return r.Body == "Isi pertama." && r.EditorID == "author-uuid" && r.ChangeNote == "Restored from revision 1"
+		})).Return(article, nil).Once()
+
+		output, err := restoreRevisionUsecase.Execute(context.Background(), &dto.RestoreArticleRevisionInput{
+			ArticleID: article.ID, Number: 1, Actor: newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Judul pertama", output.Title)
+		assert.Equal(t, "Isi pertama.", output.Body)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should forbid restoring when the actor cannot edit the article", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		restoreRevisionUsecase := usecases.NewRestoreArticleRevision(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		_, err := restoreRevisionUsecase.Execute(context.Background(), &dto.RestoreArticleRevisionInput{
+			ArticleID: article.ID, Number: 1, Actor: newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "Revise", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
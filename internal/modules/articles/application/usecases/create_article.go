@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// CreateArticle adalah use case untuk membuat artikel baru dengan status draft.
+type CreateArticle struct {
+	articleRepository repos.ArticleRepository
+	uuidGenerator     shared.UUIDGenerator
+}
+
+// NewCreateArticle adalah konstruktor untuk use case ini.
+func NewCreateArticle(articleRepo repos.ArticleRepository, uuidGen shared.UUIDGenerator) *CreateArticle {
+	return &CreateArticle{
+		articleRepository: articleRepo,
+		uuidGenerator:     uuidGen,
+	}
+}
+
+// Execute menjalankan logika bisnis pembuatan artikel.
+func (c *CreateArticle) Execute(ctx context.Context, input *dto.CreateArticleInput) (*dto.ArticleOutput, error) {
+	// 1. Otorisasi
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+	if !hasPermission(input.Actor, authvo.PermissionArticleCreate) {
+		return nil, shared.NewForbiddenError("You are not allowed to create articles")
+	}
+	if len(input.Tags) > 0 && !hasPermission(input.Actor, authvo.PermissionTagManage) {
+		return nil, shared.NewForbiddenError("You are not allowed to tag articles")
+	}
+
+	// 2. Validasi Input
+	titleVO, err := vo.NewTitle(input.Title)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	explicitSlug := strings.TrimSpace(input.Slug) != ""
+	slugSource := input.Slug
+	if !explicitSlug {
+		slugSource = titleVO.String()
+	}
+	slugVO, err := vo.SlugFromText(slugSource)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	language := vo.DefaultLanguage()
+	if strings.TrimSpace(input.Language) != "" {
+		languageVO, err := vo.NewLanguage(input.Language)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		language = *languageVO
+	}
+
+	// 3. Memastikan slug unik, slug otomatis diberi akhiran angka jika bentrok
+	slug, err := resolveSlug(ctx, c.articleRepository, *slugVO, explicitSlug, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureCategoryExists(ctx, c.articleRepository, input.CategoryID); err != nil {
+		return nil, err
+	}
+
+	if err := ensureImageExists(ctx, c.articleRepository, input.FeaturedImageID); err != nil {
+		return nil, err
+	}
+
+	// 4. Membuat Entity Article baru
+	article, err := entities.NewArticle(
+		c.uuidGenerator.NewUUID(),
+		*titleVO,
+		slug.String(),
+		input.Body,
+		input.Excerpt,
+		input.Actor.UserID,
+	)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+	article.CategoryID = input.CategoryID
+	article.FeaturedImageID = input.FeaturedImageID
+	article.Language = language
+
+	tags, err := buildArticleTags(input.Tags, c.uuidGenerator)
+	if err != nil {
+		return nil, err
+	}
+	if err := article.SetTags(tags); err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 5. Menyimpan Article ke repository beserta revisi pertamanya
+	revision := entities.NewArticleRevision(article, input.Actor.UserID, initialRevisionNote)
+	savedArticle, err := c.articleRepository.Save(ctx, article, revision)
+	if err != nil {
+		// ✅ Slug yang lolos resolveSlug bisa keburu dipakai request paralel
+		if shared.IsUniqueViolation(err) {
+			return nil, shared.NewConflictError("Slug already used by another article, please try again")
+		}
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	return toArticleOutput(savedArticle), nil
+}
@@ -0,0 +1,319 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// --- Mock Implementations ---
+
+type MockArticleRepository struct {
+	mock.Mock
+}
+
+func (m *MockArticleRepository) Save(ctx context.Context, article *entities.Article, revision *entities.ArticleRevision) (*entities.Article, error) {
+	args := m.Called(ctx, article, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if fn, ok := args.Get(0).(func(*entities.Article) *entities.Article); ok {
+		return fn(article), args.Error(1)
+	}
+	return args.Get(0).(*entities.Article), args.Error(1)
+}
+
+func (m *MockArticleRepository) UpdateSchedule(ctx context.Context, article *entities.Article) (bool, error) {
+	args := m.Called(ctx, article)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockArticleRepository) Revise(ctx context.Context, article *entities.Article, revision *entities.ArticleRevision) (*entities.Article, error) {
+	args := m.Called(ctx, article, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Article), args.Error(1)
+}
+
+func (m *MockArticleRepository) FindByID(ctx context.Context, id string) (*entities.Article, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Article), args.Error(1)
+}
+
+func (m *MockArticleRepository) FindBySlug(ctx context.Context, slug string) (*entities.Article, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Article), args.Error(1)
+}
+
+func (m *MockArticleRepository) FindAll(ctx context.Context, filter repos.ArticleFilter) ([]*entities.Article, int, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*entities.Article), args.Int(1), args.Error(2)
+}
+
+func (m *MockArticleRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	args := m.Called(ctx, slug)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockArticleRepository) FindRedirect(ctx context.Context, oldSlug string) (string, error) {
+	args := m.Called(ctx, oldSlug)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockArticleRepository) CategoryExists(ctx context.Context, categoryID string) (bool, error) {
+	args := m.Called(ctx, categoryID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockArticleRepository) Search(ctx context.Context, filter repos.SearchFilter) ([]*repos.SearchResult, int, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*repos.SearchResult), args.Int(1), args.Error(2)
+}
+
+func (m *MockArticleRepository) ImageExists(ctx context.Context, mediaID string) (bool, error) {
+	args := m.Called(ctx, mediaID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockArticleRepository) SetTags(ctx context.Context, article *entities.Article) error {
+	args := m.Called(ctx, article)
+	return args.Error(0)
+}
+
+func (m *MockArticleRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockArticleRepository) SaveTransition(ctx context.Context, article *entities.Article, transition *entities.ArticleTransition) (bool, error) {
+	args := m.Called(ctx, article, transition)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockArticleRepository) FindTransitions(ctx context.Context, articleID string) ([]*entities.ArticleTransition, error) {
+	args := m.Called(ctx, articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ArticleTransition), args.Error(1)
+}
+
+func (m *MockArticleRepository) FindRevisions(ctx context.Context, articleID string) ([]*entities.ArticleRevision, error) {
+	args := m.Called(ctx, articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ArticleRevision), args.Error(1)
+}
+
+func (m *MockArticleRepository) FindRevision(ctx context.Context, articleID string, number int) (*entities.ArticleRevision, error) {
+	args := m.Called(ctx, articleID, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.ArticleRevision), args.Error(1)
+}
+
+// ApplyDueSchedules menjalankan apply pada artikel yang diberikan lewat Return(articles, error)
+// dan menghitung transisi yang dihasilkan, meniru perilaku repository asli.
+func (m *MockArticleRepository) ApplyDueSchedules(ctx context.Context, now time.Time, limit int, apply func(article *entities.Article) *entities.ArticleTransition) (int, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Error(1) != nil {
+		return 0, args.Error(1)
+	}
+
+	applied := 0
+	for _, article := range args.Get(0).([]*entities.Article) {
+		if transition := apply(article); transition != nil {
+			applied++
+		}
+	}
+	return applied, nil
+}
+
+type MockUUIDGenerator struct {
+	mock.Mock
+}
+
+func (m *MockUUIDGenerator) NewUUID() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+// --- Helpers ---
+
+func newPrincipal(userID string, roles ...string) *shared.Principal {
+	return &shared.Principal{
+		UserID:      userID,
+		Roles:       roles,
+		Permissions: authvo.PermissionsForRoles(roles),
+	}
+}
+
+func newStoredArticle(t *testing.T, id, authorID, status string) *entities.Article {
+	t.Helper()
+	titleVO, _ := vo.NewTitle("Pemilu 2029 dimulai")
+	article, err := entities.NewArticle(id, *titleVO, "pemilu-2029-dimulai", "Isi berita.", "", authorID)
+	if err != nil {
+		t.Fatalf("Error creating article: %v", err)
+	}
+	statusVO, _ := vo.NewArticleStatus(status)
+	article.ChangeStatus(*statusVO)
+	article.PullEvents() // artikel tersimpan tidak membawa event yang belum terkirim
+	return article
+}
+
+// --- Test Suite ---
+
+func TestCreateArticle(t *testing.T) {
+	t.Run("should create a draft article owned by the actor", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		createArticleUsecase := usecases.NewCreateArticle(articleRepoMock, uuidGenMock)
+
+		input := dto.CreateArticleInput{
+			Title: "Pemilu 2029:  Hasil Hitung Cepat!",
+			Body:  "Isi berita.",
+			Actor: newPrincipal("author-uuid", authvo.RoleAuthor),
+		}
+
+		articleRepoMock.On("ExistsBySlug", mock.Anything, "pemilu-2029-hasil-hitung-cepat").Return(false, nil).Once()
+		uuidGenMock.On("NewUUID").Return("article-uuid").Once()
+		articleRepoMock.On("Save", mock.Anything, mock.AnythingOfType("*entities.Article"), mock.MatchedBy(func(r *entities.ArticleRevision) bool {
+			return r.ArticleID == "article-uuid" && r.EditorID == "author-uuid" && r.Body == "Isi berita."
+		})).Return(func(a *entities.Article) *entities.Article { return a }, nil).Once()
+
+		output, err := createArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "article-uuid", output.ID)
+		assert.Equal(t, "Pemilu 2029: Hasil Hitung Cepat!", output.Title)
+		assert.Equal(t, "pemilu-2029-hasil-hitung-cepat", output.Slug)
+		assert.Equal(t, "author-uuid", output.AuthorID)
+		assert.Equal(t, vo.StatusDraft, output.Status)
+
+		articleRepoMock.AssertExpectations(t)
+		uuidGenMock.AssertExpectations(t)
+	})
+
+	t.Run("should append a numeric suffix when the generated slug is taken", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		createArticleUsecase := usecases.NewCreateArticle(articleRepoMock, uuidGenMock)
+
+		input := dto.CreateArticleInput{Title: "Banjir Jakarta", Body: "Isi", Actor: newPrincipal("author-uuid", authvo.RoleAuthor)}
+		articleRepoMock.On("ExistsBySlug", mock.Anything, "banjir-jakarta").Return(true, nil).Once()
+		articleRepoMock.On("ExistsBySlug", mock.Anything, "banjir-jakarta-2").Return(true, nil).Once()
+		articleRepoMock.On("ExistsBySlug", mock.Anything, "banjir-jakarta-3").Return(false, nil).Once()
+		uuidGenMock.On("NewUUID").Return("article-uuid").Once()
+		articleRepoMock.On("Save", mock.Anything, mock.AnythingOfType("*entities.Article"), mock.AnythingOfType("*entities.ArticleRevision")).
+			Return(func(a *entities.Article) *entities.Article { return a }, nil).Once()
+
+		output, err := createArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "banjir-jakarta-3", output.Slug)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should return a conflict error if an explicit slug is taken", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		createArticleUsecase := usecases.NewCreateArticle(articleRepoMock, new(MockUUIDGenerator))
+
+		input := dto.CreateArticleInput{Title: "Judul berita", Slug: "Judul Berita", Body: "Isi", Actor: newPrincipal("author-uuid", authvo.RoleAuthor)}
+		articleRepoMock.On("ExistsBySlug", mock.Anything, "judul-berita").Return(true, nil).Once()
+
+		_, err := createArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return a conflict error if the slug is taken before the save", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		createArticleUsecase := usecases.NewCreateArticle(articleRepoMock, uuidGenMock)
+
+		input := dto.CreateArticleInput{Title: "Banjir Jakarta", Body: "Isi", Actor: newPrincipal("author-uuid", authvo.RoleAuthor)}
+		articleRepoMock.On("ExistsBySlug", mock.Anything, "banjir-jakarta").Return(false, nil).Once()
+		uuidGenMock.On("NewUUID").Return("article-uuid").Once()
+		articleRepoMock.On("Save", mock.Anything, mock.Anything, mock.Anything).Return(nil, &pq.Error{Code: "23505"}).Once()
+
+		_, err := createArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
+	})
+
+	t.Run("should reject an unknown category", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		createArticleUsecase := usecases.NewCreateArticle(articleRepoMock, new(MockUUIDGenerator))
+
+		input := dto.CreateArticleInput{Title: "Judul berita", Body: "Isi", CategoryID: "missing", Actor: newPrincipal("author-uuid", authvo.RoleAuthor)}
+		articleRepoMock.On("ExistsBySlug", mock.Anything, "judul-berita").Return(false, nil).Once()
+		articleRepoMock.On("CategoryExists", mock.Anything, "missing").Return(false, nil).Once()
+
+		_, err := createArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject a featured image that is not an uploaded image", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		createArticleUsecase := usecases.NewCreateArticle(articleRepoMock, new(MockUUIDGenerator))
+
+		input := dto.CreateArticleInput{Title: "Judul berita", Body: "Isi", FeaturedImageID: "pdf-uuid", Actor: newPrincipal("author-uuid", authvo.RoleAuthor)}
+		articleRepoMock.On("ExistsBySlug", mock.Anything, "judul-berita").Return(false, nil).Once()
+		articleRepoMock.On("ImageExists", mock.Anything, "pdf-uuid").Return(false, nil).Once()
+
+		_, err := createArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should forbid readers from creating articles", func(t *testing.T) {
+		createArticleUsecase := usecases.NewCreateArticle(new(MockArticleRepository), new(MockUUIDGenerator))
+
+		input := dto.CreateArticleInput{Title: "Judul berita", Body: "Isi", Actor: newPrincipal("reader-uuid", authvo.RoleReader)}
+
+		_, err := createArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+	})
+
+	t.Run("should return a validation error for invalid title", func(t *testing.T) {
+		createArticleUsecase := usecases.NewCreateArticle(new(MockArticleRepository), new(MockUUIDGenerator))
+
+		input := dto.CreateArticleInput{Title: "abc", Body: "Isi", Actor: newPrincipal("author-uuid", authvo.RoleAuthor)}
+
+		_, err := createArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+	})
+}
@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// DeleteArticle adalah use case untuk menghapus artikel.
+type DeleteArticle struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewDeleteArticle adalah konstruktor untuk use case ini.
+func NewDeleteArticle(articleRepo repos.ArticleRepository) *DeleteArticle {
+	return &DeleteArticle{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute menghapus artikel. Penulis hanya boleh menghapus draft miliknya
+// sendiri, selain itu butuh article:delete.
+func (d *DeleteArticle) Execute(ctx context.Context, input *dto.DeleteArticleInput) error {
+	if input.Actor == nil {
+		return shared.NewUnauthorizedError("Authentication required")
+	}
+
+	article, err := d.articleRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if article == nil || !canView(article, input.Actor) {
+		return shared.NewNotFoundError("Article not found")
+	}
+
+	ownDraft := article.IsOwnedBy(input.Actor.UserID) && article.IsDraft()
+	if !ownDraft && !hasPermission(input.Actor, authvo.PermissionArticleDelete) {
+		return shared.NewForbiddenError("You are not allowed to delete this article")
+	}
+
+	if err := d.articleRepository.Delete(ctx, article.ID); err != nil {
+		return shared.NewDatabaseError(err)
+	}
+
+	return nil
+}
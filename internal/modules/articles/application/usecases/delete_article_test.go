@@ -0,0 +1,91 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestDeleteArticle(t *testing.T) {
+	t.Run("should let the author delete their own draft", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		deleteArticleUsecase := usecases.NewDeleteArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("Delete", mock.Anything, article.ID).Return(nil).Once()
+
+		err := deleteArticleUsecase.Execute(context.Background(), &dto.DeleteArticleInput{ID: article.ID, Actor: newPrincipal("author-uuid", authvo.RoleAuthor)})
+
+		assert.Nil(t, err)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should forbid the author from deleting a published article", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		deleteArticleUsecase := usecases.NewDeleteArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		err := deleteArticleUsecase.Execute(context.Background(), &dto.DeleteArticleInput{ID: article.ID, Actor: newPrincipal("author-uuid", authvo.RoleAuthor)})
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListArticles(t *testing.T) {
+	t.Run("should only list published articles for anonymous readers", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		listArticlesUsecase := usecases.NewListArticles(articleRepoMock)
+
+		expectedFilter := repos.ArticleFilter{Status: vo.StatusPublished, Limit: 20, Offset: 0}
+		articleRepoMock.On("FindAll", mock.Anything, expectedFilter).Return([]*entities.Article{}, 0, nil).Once()
+
+		output, err := listArticlesUsecase.Execute(context.Background(), &dto.ListArticlesInput{Status: vo.StatusDraft})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, output.Page)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should let authors list their own drafts", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		listArticlesUsecase := usecases.NewListArticles(articleRepoMock)
+
+		expectedFilter := repos.ArticleFilter{Status: vo.StatusDraft, AuthorID: "author-uuid", Limit: 10, Offset: 10}
+		articleRepoMock.On("FindAll", mock.Anything, expectedFilter).Return([]*entities.Article{}, 0, nil).Once()
+
+		_, err := listArticlesUsecase.Execute(context.Background(), &dto.ListArticlesInput{
+			Status: vo.StatusDraft, AuthorID: "author-uuid", Page: 2, Limit: 10,
+			Actor: newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Nil(t, err)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should pass the section filter to the repository", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		listArticlesUsecase := usecases.NewListArticles(articleRepoMock)
+
+		expectedFilter := repos.ArticleFilter{Status: vo.StatusPublished, CategoryID: "news-uuid", Limit: 20, Offset: 0}
+		articleRepoMock.On("FindAll", mock.Anything, expectedFilter).Return([]*entities.Article{}, 0, nil).Once()
+
+		_, err := listArticlesUsecase.Execute(context.Background(), &dto.ListArticlesInput{CategoryID: "news-uuid"})
+
+		assert.Nil(t, err)
+		articleRepoMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// DiffArticleRevisions adalah use case untuk membandingkan body dua revisi per baris.
+type DiffArticleRevisions struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewDiffArticleRevisions adalah konstruktor untuk use case ini.
+func NewDiffArticleRevisions(articleRepo repos.ArticleRepository) *DiffArticleRevisions {
+	return &DiffArticleRevisions{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute membandingkan revisi input.From dengan input.To.
+func (d *DiffArticleRevisions) Execute(ctx context.Context, input *dto.DiffArticleRevisionsInput) (*dto.ArticleRevisionDiffOutput, error) {
+	// 1. Validasi Input
+	if input.From <= 0 || input.To <= 0 {
+		return nil, shared.NewValidationError("from and to must be valid revision numbers")
+	}
+
+	// 2. Otorisasi
+	if _, err := findArticleForHistory(ctx, d.articleRepository, input.ArticleID, input.Actor); err != nil {
+		return nil, err
+	}
+
+	// 3. Mengambil kedua revisi
+	from, err := d.findRevision(ctx, input.ArticleID, input.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := d.findRevision(ctx, input.ArticleID, input.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ArticleRevisionDiffOutput{
+		ArticleID: input.ArticleID,
+		From:      from.Number,
+		To:        to.Number,
+		TitleFrom: from.Title,
+		TitleTo:   to.Title,
+		Lines:     diffLines(from.Body, to.Body),
+	}, nil
+}
+
+func (d *DiffArticleRevisions) findRevision(ctx context.Context, articleID string, number int) (*entities.ArticleRevision, error) {
+	revision, err := d.articleRepository.FindRevision(ctx, articleID, number)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if revision == nil {
+		return nil, shared.NewNotFoundError(fmt.Sprintf("Revision %d not found", number))
+	}
+	return revision, nil
+}
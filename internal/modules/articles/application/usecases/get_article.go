@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// GetArticle adalah use case untuk mengambil satu artikel berdasarkan ID atau slug.
+type GetArticle struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewGetArticle adalah konstruktor untuk use case ini.
+func NewGetArticle(articleRepo repos.ArticleRepository) *GetArticle {
+	return &GetArticle{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute mengembalikan artikel jika pemanggil boleh melihatnya.
+// Artikel yang tidak boleh dilihat dilaporkan sebagai not found.
+// Untuk slug lama, artikel dikembalikan dengan slug barunya; handler
+// membandingkan slug tersebut untuk menjawab 301.
+func (g *GetArticle) Execute(ctx context.Context, input *dto.GetArticleInput) (*dto.ArticleOutput, error) {
+	var article *entities.Article
+	var err error
+
+	if input.Slug != "" {
+		article, err = g.findBySlug(ctx, input.Slug)
+	} else {
+		article, err = g.articleRepository.FindByID(ctx, input.ID)
+	}
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	if article == nil || !canView(article, input.Actor) {
+		return nil, shared.NewNotFoundError("Article not found")
+	}
+
+	return toArticleOutput(article), nil
+}
+
+// findBySlug mencari slug aktif lalu slug lama di tabel redirect.
+func (g *GetArticle) findBySlug(ctx context.Context, slug string) (*entities.Article, error) {
+	article, err := g.articleRepository.FindBySlug(ctx, slug)
+	if err != nil || article != nil {
+		return article, err
+	}
+
+	articleID, err := g.articleRepository.FindRedirect(ctx, slug)
+	if err != nil || articleID == "" {
+		return nil, err
+	}
+	return g.articleRepository.FindByID(ctx, articleID)
+}
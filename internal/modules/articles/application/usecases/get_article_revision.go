@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// GetArticleRevision adalah use case untuk mengambil satu revisi lengkap dengan body-nya.
+type GetArticleRevision struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewGetArticleRevision adalah konstruktor untuk use case ini.
+func NewGetArticleRevision(articleRepo repos.ArticleRepository) *GetArticleRevision {
+	return &GetArticleRevision{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute mengembalikan revisi nomor input.Number milik artikel.
+func (g *GetArticleRevision) Execute(ctx context.Context, input *dto.GetArticleRevisionInput) (*dto.ArticleRevisionOutput, error) {
+	if _, err := findArticleForHistory(ctx, g.articleRepository, input.ArticleID, input.Actor); err != nil {
+		return nil, err
+	}
+
+	revision, err := g.articleRepository.FindRevision(ctx, input.ArticleID, input.Number)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if revision == nil {
+		return nil, shared.NewNotFoundError(fmt.Sprintf("Revision %d not found", input.Number))
+	}
+
+	return toArticleRevisionOutput(revision), nil
+}
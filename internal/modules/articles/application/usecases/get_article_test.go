@@ -0,0 +1,43 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestGetArticle(t *testing.T) {
+	t.Run("should resolve an old slug to the renamed article", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		getArticleUsecase := usecases.NewGetArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		articleRepoMock.On("FindBySlug", mock.Anything, "judul-lama").Return(nil, nil).Once()
+		articleRepoMock.On("FindRedirect", mock.Anything, "judul-lama").Return(article.ID, nil).Once()
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		output, err := getArticleUsecase.Execute(context.Background(), &dto.GetArticleInput{Slug: "judul-lama"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "pemilu-2029-dimulai", output.Slug)
+	})
+
+	t.Run("should return not found for an unknown slug", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		getArticleUsecase := usecases.NewGetArticle(articleRepoMock)
+
+		articleRepoMock.On("FindBySlug", mock.Anything, "tidak-ada").Return(nil, nil).Once()
+		articleRepoMock.On("FindRedirect", mock.Anything, "tidak-ada").Return("", nil).Once()
+
+		_, err := getArticleUsecase.Execute(context.Background(), &dto.GetArticleInput{Slug: "tidak-ada"})
+
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+	})
+}
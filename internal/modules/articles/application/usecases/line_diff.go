@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+)
+
+const (
+	diffEqual  = "equal"
+	diffInsert = "insert"
+	diffDelete = "delete"
+)
+
+// diffLines membuat diff per baris antara oldText dan newText berbasis
+// longest common subsequence. Prefix dan suffix yang sama dipangkas dulu
+// supaya tabel LCS hanya sebesar bagian yang benar-benar berubah.
+func diffLines(oldText, newText string) []*dto.DiffLineOutput {
+	oldLines := splitLines(oldText)
+	newLines := splitLines(newText)
+
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	a := oldLines[prefix : len(oldLines)-suffix]
+	b := newLines[prefix : len(newLines)-suffix]
+
+	// lcs[i][j] = panjang LCS dari a[i:] dan b[j:]
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]*dto.DiffLineOutput, 0, len(oldLines)+len(b))
+	for i := 0; i < prefix; i++ {
+		lines = append(lines, &dto.DiffLineOutput{Op: diffEqual, Text: oldLines[i], OldLine: i + 1, NewLine: i + 1})
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, &dto.DiffLineOutput{Op: diffEqual, Text: a[i], OldLine: prefix + i + 1, NewLine: prefix + j + 1})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, &dto.DiffLineOutput{Op: diffDelete, Text: a[i], OldLine: prefix + i + 1})
+			i++
+		default:
+			lines = append(lines, &dto.DiffLineOutput{Op: diffInsert, Text: b[j], NewLine: prefix + j + 1})
+			j++
+		}
+	}
+
+	for k := 0; k < suffix; k++ {
+		oldIndex := len(oldLines) - suffix + k
+		newIndex := len(newLines) - suffix + k
+		lines = append(lines, &dto.DiffLineOutput{Op: diffEqual, Text: oldLines[oldIndex], OldLine: oldIndex + 1, NewLine: newIndex + 1})
+	}
+
+	return lines
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
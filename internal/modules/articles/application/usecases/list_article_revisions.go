@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// ListArticleRevisions adalah use case untuk melihat daftar revisi artikel.
+type ListArticleRevisions struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewListArticleRevisions adalah konstruktor untuk use case ini.
+func NewListArticleRevisions(articleRepo repos.ArticleRepository) *ListArticleRevisions {
+	return &ListArticleRevisions{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute mengembalikan revisi artikel tanpa body, terbaru lebih dulu.
+func (l *ListArticleRevisions) Execute(ctx context.Context, input *dto.ListArticleRevisionsInput) ([]*dto.ArticleRevisionOutput, error) {
+	if _, err := findArticleForHistory(ctx, l.articleRepository, input.ArticleID, input.Actor); err != nil {
+		return nil, err
+	}
+
+	revisions, err := l.articleRepository.FindRevisions(ctx, input.ArticleID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output := make([]*dto.ArticleRevisionOutput, 0, len(revisions))
+	for _, revision := range revisions {
+		item := toArticleRevisionOutput(revision)
+		item.Body = ""
+		output = append(output, item)
+	}
+
+	return output, nil
+}
+
+// findArticleForHistory memuat artikel dan memastikan actor boleh melihat riwayatnya.
+func findArticleForHistory(ctx context.Context, articleRepo repos.ArticleRepository, articleID string, actor *shared.Principal) (*entities.Article, error) {
+	if actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+
+	article, err := articleRepo.FindByID(ctx, articleID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if article == nil || !canView(article, actor) {
+		return nil, shared.NewNotFoundError("Article not found")
+	}
+	if !canViewHistory(article, actor) {
+		return nil, shared.NewForbiddenError("You are not allowed to view this article's history")
+	}
+
+	return article, nil
+}
+
+func toArticleRevisionOutput(revision *entities.ArticleRevision) *dto.ArticleRevisionOutput {
+	return &dto.ArticleRevisionOutput{
+		ArticleID:  revision.ArticleID,
+		Number:     revision.Number,
+		Title:      revision.Title,
+		Body:       revision.Body,
+		Excerpt:    revision.Excerpt,
+		EditorID:   revision.EditorID,
+		ChangeNote: revision.ChangeNote,
+		CreatedAt:  revision.CreatedAt,
+	}
+}
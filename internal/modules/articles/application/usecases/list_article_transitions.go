@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// ListArticleTransitions adalah use case untuk melihat riwayat workflow artikel.
+type ListArticleTransitions struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewListArticleTransitions adalah konstruktor untuk use case ini.
+func NewListArticleTransitions(articleRepo repos.ArticleRepository) *ListArticleTransitions {
+	return &ListArticleTransitions{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute mengembalikan seluruh transisi artikel, urut dari yang paling lama.
+func (l *ListArticleTransitions) Execute(ctx context.Context, input *dto.ListArticleTransitionsInput) ([]*dto.ArticleTransitionOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+
+	article, err := l.articleRepository.FindByID(ctx, input.ArticleID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if article == nil || !canView(article, input.Actor) {
+		return nil, shared.NewNotFoundError("Article not found")
+	}
+	if !canViewHistory(article, input.Actor) {
+		return nil, shared.NewForbiddenError("You are not allowed to view this article's workflow history")
+	}
+
+	transitions, err := l.articleRepository.FindTransitions(ctx, article.ID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output := make([]*dto.ArticleTransitionOutput, 0, len(transitions))
+	for _, transition := range transitions {
+		output = append(output, toArticleTransitionOutput(transition))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	tagvo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// ListArticles adalah use case untuk menampilkan daftar artikel dengan paginasi.
+type ListArticles struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewListArticles adalah konstruktor untuk use case ini.
+func NewListArticles(articleRepo repos.ArticleRepository) *ListArticles {
+	return &ListArticles{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute menampilkan artikel published untuk publik. Penulis bisa melihat
+// semua artikel miliknya, editor bisa melihat semua artikel.
+func (l *ListArticles) Execute(ctx context.Context, input *dto.ListArticlesInput) (*dto.ArticleListOutput, error) {
+	// 1. Validasi Input
+	if input.Status != "" {
+		if _, err := vo.NewArticleStatus(input.Status); err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+	}
+
+	page, limit := normalizePage(input.Page, input.Limit)
+	filter := repos.ArticleFilter{
+		Status:     input.Status,
+		AuthorID:   input.AuthorID,
+		CategoryID: input.CategoryID,
+		TagSlug:    tagvo.NormalizeTagSlug(input.Tag),
+		Limit:      limit,
+		Offset:     (page - 1) * limit,
+	}
+
+	// 2. Batasi visibilitas sesuai pemanggil
+	ownList := input.Actor != nil && input.AuthorID == input.Actor.UserID
+	if !ownList && !hasPermission(input.Actor, authvo.PermissionArticleUpdateAny) {
+		filter.Status = vo.StatusPublished
+	}
+
+	// 3. Ambil data
+	articles, total, err := l.articleRepository.FindAll(ctx, filter)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output := &dto.ArticleListOutput{
+		Items: make([]*dto.ArticleOutput, 0, len(articles)),
+		Page:  page,
+		Limit: limit,
+		Total: total,
+	}
+	for _, article := range articles {
+		output.Items = append(output.Items, toArticleOutput(article))
+	}
+
+	return output, nil
+}
+
+func normalizePage(page, limit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	return page, limit
+}
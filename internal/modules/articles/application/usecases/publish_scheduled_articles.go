@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// scheduleBatchSize adalah jumlah artikel yang dikunci per transaksi.
+const scheduleBatchSize = 50
+
+// PublishScheduledArticles adalah use case yang dijalankan scheduler untuk
+// mempublish dan meng-unpublish artikel yang jadwalnya sudah jatuh tempo.
+type PublishScheduledArticles struct {
+	articleRepository repos.ArticleRepository
+	uuidGenerator     shared.UUIDGenerator
+}
+
+// NewPublishScheduledArticles adalah konstruktor untuk use case ini.
+func NewPublishScheduledArticles(articleRepo repos.ArticleRepository, uuidGen shared.UUIDGenerator) *PublishScheduledArticles {
+	return &PublishScheduledArticles{
+		articleRepository: articleRepo,
+		uuidGenerator:     uuidGen,
+	}
+}
+
+// Execute memproses semua jadwal yang jatuh tempo pada now, batch demi batch,
+// dan mengembalikan jumlah artikel yang statusnya berubah.
+func (p *PublishScheduledArticles) Execute(ctx context.Context, now time.Time) (int, error) {
+	total := 0
+
+	for {
+		// Event ArticlePublished/ArticleUnpublished ditulis repository ke outbox
+		// dalam transaksi batch yang sama, lalu dikirim oleh relay
+		applied, err := p.articleRepository.ApplyDueSchedules(ctx, now, scheduleBatchSize, func(article *entities.Article) *entities.ArticleTransition {
+			action, from, ok := article.ApplyDueSchedule(now)
+			if !ok {
+				return nil
+			}
+
+			// ✅ Transisi dicatat atas nama editor yang memasang jadwal
+			actorID := article.ScheduledBy
+			if actorID == "" {
+				actorID = article.AuthorID
+			}
+
+			return entities.NewArticleTransition(
+				p.uuidGenerator.NewUUID(),
+				article.ID,
+				action,
+				from.String(),
+				article.Status.String(),
+				actorID,
+				"scheduled "+action,
+			)
+		})
+		if err != nil {
+			return total, shared.NewDatabaseError(err)
+		}
+
+		total += applied
+		if applied < scheduleBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// ScheduleArticle adalah use case untuk memasang jadwal publish/unpublish artikel.
+type ScheduleArticle struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewScheduleArticle adalah konstruktor untuk use case ini.
+func NewScheduleArticle(articleRepo repos.ArticleRepository) *ScheduleArticle {
+	return &ScheduleArticle{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute menyimpan jadwal baru. Publish otomatis hanya berjalan untuk artikel
+// yang sudah approved ketika publish_at tiba.
+func (s *ScheduleArticle) Execute(ctx context.Context, input *dto.ScheduleArticleInput) (*dto.ArticleOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+
+	// 1. Otorisasi
+	if !hasPermission(input.Actor, authvo.PermissionArticlePublish) {
+		return nil, shared.NewForbiddenError("You are not allowed to schedule articles")
+	}
+
+	// 2. Mencari artikel
+	article, err := s.articleRepository.FindByID(ctx, input.ArticleID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if article == nil {
+		return nil, shared.NewNotFoundError("Article not found")
+	}
+
+	// 3. Memasang jadwal
+	if err := article.Schedule(input.PublishAt, input.UnpublishAt, input.Actor.UserID, time.Now()); err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 4. Menyimpan jadwal, hanya jika status belum diubah request lain
+	saved, err := s.articleRepository.UpdateSchedule(ctx, article)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if !saved {
+		return nil, shared.NewConflictError("Article status was changed by another request, please reload")
+	}
+
+	return toArticleOutput(article), nil
+}
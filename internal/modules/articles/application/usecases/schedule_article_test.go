@@ -0,0 +1,164 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	articleevents "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/events"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestScheduleArticle(t *testing.T) {
+	t.Run("should let an editor schedule an embargoed article", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		scheduleArticleUsecase := usecases.NewScheduleArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusApproved)
+		publishAt := time.Now().Add(6 * time.Hour)
+		input := dto.ScheduleArticleInput{ArticleID: article.ID, PublishAt: &publishAt, Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("UpdateSchedule", mock.Anything, mock.MatchedBy(func(a *entities.Article) bool {
+			return a.PublishAt != nil && a.ScheduledBy == "editor-uuid"
+		})).Return(true, nil).Once()
+
+		output, err := scheduleArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, publishAt, *output.PublishAt)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should forbid an author from scheduling", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		scheduleArticleUsecase := usecases.NewScheduleArticle(articleRepoMock)
+
+		publishAt := time.Now().Add(time.Hour)
+		input := dto.ScheduleArticleInput{ArticleID: "article-uuid", PublishAt: &publishAt, Actor: newPrincipal("author-uuid", authvo.RoleAuthor)}
+
+		_, err := scheduleArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject a publish_at in the past", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		scheduleArticleUsecase := usecases.NewScheduleArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusApproved)
+		publishAt := time.Now().Add(-time.Hour)
+		input := dto.ScheduleArticleInput{ArticleID: article.ID, PublishAt: &publishAt, Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		_, err := scheduleArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "UpdateSchedule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return a conflict when the status changed before the schedule was saved", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		scheduleArticleUsecase := usecases.NewScheduleArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusApproved)
+		publishAt := time.Now().Add(time.Hour)
+		input := dto.ScheduleArticleInput{ArticleID: article.ID, PublishAt: &publishAt, Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("UpdateSchedule", mock.Anything, article).Return(false, nil).Once()
+
+		output, err := scheduleArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, output)
+		assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
+		articleRepoMock.AssertExpectations(t)
+	})
+}
+
+func TestPublishScheduledArticles(t *testing.T) {
+	t.Run("should publish due articles and record the scheduling editor as actor", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		publishScheduledUsecase := usecases.NewPublishScheduledArticles(articleRepoMock, uuidGenMock)
+
+		now := time.Now()
+		publishAt := now.Add(-time.Minute)
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusApproved)
+		article.PublishAt = &publishAt
+		article.ScheduledBy = "editor-uuid"
+
+		articleRepoMock.On("ApplyDueSchedules", mock.Anything, now, 50).Return([]*entities.Article{article}, nil).Once()
+		uuidGenMock.On("NewUUID").Return("transition-uuid").Once()
+
+		applied, err := publishScheduledUsecase.Execute(context.Background(), now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, applied)
+		assert.True(t, article.IsPublished())
+		assert.Nil(t, article.PublishAt)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should leave ArticlePublished on scheduled articles for the outbox", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		publishScheduledUsecase := usecases.NewPublishScheduledArticles(articleRepoMock, uuidGenMock)
+
+		now := time.Now()
+		publishAt := now.Add(-time.Minute)
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusApproved)
+		article.PublishAt = &publishAt
+
+		articleRepoMock.On("ApplyDueSchedules", mock.Anything, now, 50).Return([]*entities.Article{article}, nil).Once()
+		uuidGenMock.On("NewUUID").Return("transition-uuid").Once()
+
+		_, err := publishScheduledUsecase.Execute(context.Background(), now)
+
+		assert.Nil(t, err)
+		events := article.PullEvents()
+		assert.Len(t, events, 1)
+		assert.Equal(t, articleevents.ArticlePublishedEvent, events[0].EventName())
+	})
+
+	t.Run("should skip locked articles whose schedule is no longer applicable", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		publishScheduledUsecase := usecases.NewPublishScheduledArticles(articleRepoMock, uuidGenMock)
+
+		now := time.Now()
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusInReview)
+
+		articleRepoMock.On("ApplyDueSchedules", mock.Anything, now, 50).Return([]*entities.Article{article}, nil).Once()
+
+		applied, err := publishScheduledUsecase.Execute(context.Background(), now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, applied)
+		uuidGenMock.AssertNotCalled(t, "NewUUID")
+	})
+
+	t.Run("should wrap repository errors as database errors", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		publishScheduledUsecase := usecases.NewPublishScheduledArticles(articleRepoMock, uuidGenMock)
+
+		now := time.Now()
+		articleRepoMock.On("ApplyDueSchedules", mock.Anything, now, 50).Return(nil, errors.New("connection refused")).Once()
+
+		_, err := publishScheduledUsecase.Execute(context.Background(), now)
+
+		assert.Equal(t, "DATABASE_ERROR", shared.GetErrorCode(err))
+	})
+}
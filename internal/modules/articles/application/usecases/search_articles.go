@@ -0,0 +1,144 @@
+package usecases
+
+import (
+	"context"
+	"html"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	tagvo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	minSearchQueryLength = 2
+	maxSearchQueryLength = 200
+	searchDateLayout     = "2006-01-02"
+)
+
+var highlightReplacer = strings.NewReplacer(repos.HighlightStart, "<mark>", repos.HighlightStop, "</mark>")
+
+// SearchArticles adalah use case untuk pencarian full-text artikel published.
+type SearchArticles struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewSearchArticles adalah konstruktor untuk use case ini.
+func NewSearchArticles(articleRepo repos.ArticleRepository) *SearchArticles {
+	return &SearchArticles{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute mencari artikel berdasarkan kata kunci dan filter, urut dari yang
+// paling relevan.
+func (s *SearchArticles) Execute(ctx context.Context, input *dto.SearchArticlesInput) (*dto.SearchOutput, error) {
+	// 1. Validasi Input
+	query := strings.TrimSpace(input.Query)
+	if length := utf8.RuneCountInString(query); length < minSearchQueryLength || length > maxSearchQueryLength {
+		return nil, shared.NewValidationError("Search query must be between 2 and 200 characters")
+	}
+
+	page, limit := normalizePage(input.Page, input.Limit)
+	filter := repos.SearchFilter{
+		Query:      query,
+		AuthorID:   input.AuthorID,
+		CategoryID: input.CategoryID,
+		TagSlug:    tagvo.NormalizeTagSlug(input.Tag),
+		Limit:      limit,
+		Offset:     (page - 1) * limit,
+	}
+
+	if strings.TrimSpace(input.Language) != "" {
+		language, err := vo.NewLanguage(input.Language)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		filter.Language = language.SearchConfig()
+	}
+
+	from, err := parseSearchDate(input.From, false)
+	if err != nil {
+		return nil, shared.NewValidationError("Invalid 'from' date, use YYYY-MM-DD or RFC 3339")
+	}
+	to, err := parseSearchDate(input.To, true)
+	if err != nil {
+		return nil, shared.NewValidationError("Invalid 'to' date, use YYYY-MM-DD or RFC 3339")
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, shared.NewValidationError("'from' date must be before 'to' date")
+	}
+	filter.PublishedFrom, filter.PublishedTo = from, to
+
+	// 2. Cari artikel
+	results, total, err := s.articleRepository.Search(ctx, filter)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output := &dto.SearchOutput{
+		Query: query,
+		Items: make([]*dto.SearchHitOutput, 0, len(results)),
+		Page:  page,
+		Limit: limit,
+		Total: total,
+	}
+	for _, result := range results {
+		output.Items = append(output.Items, toSearchHitOutput(result))
+	}
+
+	return output, nil
+}
+
+func toSearchHitOutput(result *repos.SearchResult) *dto.SearchHitOutput {
+	article := result.Article
+	return &dto.SearchHitOutput{
+		ID:              article.ID,
+		Title:           article.Title.String(),
+		Slug:            article.Slug,
+		Excerpt:         article.Excerpt,
+		Language:        article.Language.String(),
+		AuthorID:        article.AuthorID,
+		CategoryID:      article.CategoryID,
+		FeaturedImageID: article.FeaturedImageID,
+		Tags:            toArticleTagOutputs(article.Tags),
+		PublishedAt:     article.PublishedAt,
+		Rank:            result.Rank,
+		Highlight: dto.SearchHighlightOutput{
+			Title: renderHighlight(result.TitleHighlight),
+			Body:  renderHighlight(result.BodyHighlight),
+		},
+	}
+}
+
+// renderHighlight meng-escape cuplikan dari database lalu mengganti penanda
+// highlight dengan <mark>, sehingga isi artikel tidak pernah dirender sebagai HTML.
+func renderHighlight(fragment string) string {
+	return highlightReplacer.Replace(html.EscapeString(fragment))
+}
+
+// parseSearchDate menerima YYYY-MM-DD atau RFC 3339. Tanggal tanpa jam sebagai
+// batas akhir dibuat inklusif, yaitu sampai awal hari berikutnya.
+func parseSearchDate(value string, endOfRange bool) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	if date, err := time.Parse(searchDateLayout, value); err == nil {
+		if endOfRange {
+			date = date.AddDate(0, 0, 1)
+		}
+		return &date, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
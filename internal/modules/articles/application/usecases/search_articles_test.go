@@ -0,0 +1,89 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestSearchArticles(t *testing.T) {
+	t.Run("should map the filters and render escaped highlights", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		searchArticlesUsecase := usecases.NewSearchArticles(articleRepoMock)
+
+		from := time.Date(2029, 2, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2029, 2, 15, 0, 0, 0, 0, time.UTC)
+		expectedFilter := repos.SearchFilter{
+			Query:         "hasil pemilu",
+			Language:      "indonesian",
+			CategoryID:    "news-uuid",
+			TagSlug:       "pemilu-2029",
+			PublishedFrom: &from,
+			PublishedTo:   &to,
+			Limit:         10,
+			Offset:        10,
+		}
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		results := []*repos.SearchResult{{
+			Article:        article,
+			Rank:           0.5,
+			TitleHighlight: "\x01Pemilu\x02 <2029>",
+			BodyHighlight:  "hasil \x01pemilu\x02 & hitung",
+		}}
+		articleRepoMock.On("Search", mock.Anything, expectedFilter).Return(results, 11, nil).Once()
+
+		output, err := searchArticlesUsecase.Execute(context.Background(), &dto.SearchArticlesInput{
+			Query: "  hasil pemilu ", Language: "id", CategoryID: "news-uuid", Tag: "Pemilu 2029",
+			From: "2029-02-01", To: "2029-02-14", Page: 2, Limit: 10,
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 11, output.Total)
+		assert.Len(t, output.Items, 1)
+		assert.Equal(t, "<mark>Pemilu</mark> &lt;2029&gt;", output.Items[0].Highlight.Title)
+		assert.Equal(t, "hasil <mark>pemilu</mark> &amp; hitung", output.Items[0].Highlight.Body)
+		assert.Equal(t, "id", output.Items[0].Language)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should reject a query that is too short", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		searchArticlesUsecase := usecases.NewSearchArticles(articleRepoMock)
+
+		_, err := searchArticlesUsecase.Execute(context.Background(), &dto.SearchArticlesInput{Query: " a "})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject an unknown language", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		searchArticlesUsecase := usecases.NewSearchArticles(articleRepoMock)
+
+		_, err := searchArticlesUsecase.Execute(context.Background(), &dto.SearchArticlesInput{Query: "pemilu", Language: "fr"})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject an inverted date range", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		searchArticlesUsecase := usecases.NewSearchArticles(articleRepoMock)
+
+		_, err := searchArticlesUsecase.Execute(context.Background(), &dto.SearchArticlesInput{
+			Query: "pemilu", From: "2029-03-01", To: "2029-02-01",
+		})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// TagArticle adalah use case untuk mengganti tag artikel. Tag bukan bagian
+// dari isi artikel sehingga tidak membuat revisi baru.
+type TagArticle struct {
+	articleRepository repos.ArticleRepository
+	uuidGenerator     shared.UUIDGenerator
+}
+
+// NewTagArticle adalah konstruktor untuk use case ini.
+func NewTagArticle(articleRepo repos.ArticleRepository, uuidGen shared.UUIDGenerator) *TagArticle {
+	return &TagArticle{
+		articleRepository: articleRepo,
+		uuidGenerator:     uuidGen,
+	}
+}
+
+// Execute menormalisasi nama tag lalu menyimpan link-nya.
+func (t *TagArticle) Execute(ctx context.Context, input *dto.TagArticleInput) (*dto.ArticleOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+
+	// 1. Mencari artikel
+	article, err := t.articleRepository.FindByID(ctx, input.ArticleID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if article == nil || !canView(article, input.Actor) {
+		return nil, shared.NewNotFoundError("Article not found")
+	}
+
+	// 2. Otorisasi
+	if !canEdit(article, input.Actor) || !hasPermission(input.Actor, authvo.PermissionTagManage) {
+		return nil, shared.NewForbiddenError("You are not allowed to tag this article")
+	}
+
+	// 3. Normalisasi tag
+	tags, err := buildArticleTags(input.Tags, t.uuidGenerator)
+	if err != nil {
+		return nil, err
+	}
+	if err := article.SetTags(tags); err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 4. Menyimpan link tag
+	if err := t.articleRepository.SetTags(ctx, article); err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	return toArticleOutput(article), nil
+}
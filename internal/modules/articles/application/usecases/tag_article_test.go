@@ -0,0 +1,76 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestTagArticle(t *testing.T) {
+	t.Run("should normalize tag names and collapse duplicate spellings", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		tagArticleUsecase := usecases.NewTagArticle(articleRepoMock, uuidGenMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		uuidGenMock.On("NewUUID").Return("tag-uuid")
+		articleRepoMock.On("SetTags", mock.Anything, mock.MatchedBy(func(a *entities.Article) bool {
+			return len(a.Tags) == 2 && a.Tags[0].Slug == "covid-19" && a.Tags[0].Name == "COVID-19" && a.Tags[1].Slug == "pemilu-2029"
+		})).Return(nil).Once()
+
+		output, err := tagArticleUsecase.Execute(context.Background(), &dto.TagArticleInput{
+			ArticleID: article.ID,
+			Tags:      []string{"COVID-19", " covid 19 ", "Pemilu  2029"},
+			Actor:     newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []dto.ArticleTagOutput{{Name: "COVID-19", Slug: "covid-19"}, {Name: "Pemilu 2029", Slug: "pemilu-2029"}}, output.Tags)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should forbid contributors from tagging", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		tagArticleUsecase := usecases.NewTagArticle(articleRepoMock, new(MockUUIDGenerator))
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		_, err := tagArticleUsecase.Execute(context.Background(), &dto.TagArticleInput{
+			ArticleID: article.ID,
+			Tags:      []string{"pemilu"},
+			Actor:     newPrincipal("author-uuid", authvo.RoleContributor),
+		})
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "SetTags", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return a validation error for an invalid tag", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		tagArticleUsecase := usecases.NewTagArticle(articleRepoMock, uuidGenMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		uuidGenMock.On("NewUUID").Return("tag-uuid").Maybe()
+
+		_, err := tagArticleUsecase.Execute(context.Background(), &dto.TagArticleInput{
+			ArticleID: article.ID,
+			Tags:      []string{"x"},
+			Actor:     newPrincipal("editor-uuid", authvo.RoleEditor),
+		})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+	})
+}
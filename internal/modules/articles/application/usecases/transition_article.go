@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// actionPermissions adalah permission yang dibutuhkan untuk tiap aksi workflow.
+var actionPermissions = map[string]authvo.Permission{
+	vo.ActionSubmit:         authvo.PermissionArticleSubmit,
+	vo.ActionApprove:        authvo.PermissionArticleReview,
+	vo.ActionRequestChanges: authvo.PermissionArticleReview,
+	vo.ActionPublish:        authvo.PermissionArticlePublish,
+	vo.ActionArchive:        authvo.PermissionArticlePublish,
+}
+
+// TransitionArticle adalah use case untuk memindahkan artikel di workflow editorial.
+type TransitionArticle struct {
+	articleRepository repos.ArticleRepository
+	uuidGenerator     shared.UUIDGenerator
+}
+
+// NewTransitionArticle adalah konstruktor untuk use case ini.
+func NewTransitionArticle(articleRepo repos.ArticleRepository, uuidGen shared.UUIDGenerator) *TransitionArticle {
+	return &TransitionArticle{
+		articleRepository: articleRepo,
+		uuidGenerator:     uuidGen,
+	}
+}
+
+// Execute memeriksa permission, menjalankan state machine, lalu mencatat transisi.
+func (t *TransitionArticle) Execute(ctx context.Context, input *dto.TransitionArticleInput) (*dto.TransitionArticleOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+
+	// 1. Validasi Input
+	action, err := vo.NewWorkflowAction(input.Action)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	comment := strings.TrimSpace(input.Comment)
+	if action.String() == vo.ActionRequestChanges && comment == "" {
+		return nil, shared.NewValidationError("comment is required when requesting changes")
+	}
+
+	// 2. Mencari artikel
+	article, err := t.articleRepository.FindByID(ctx, input.ArticleID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if article == nil || !canView(article, input.Actor) {
+		return nil, shared.NewNotFoundError("Article not found")
+	}
+
+	// 3. Otorisasi per aksi
+	if !hasPermission(input.Actor, actionPermissions[action.String()]) {
+		return nil, shared.NewForbiddenError(fmt.Sprintf("You are not allowed to %s articles", strings.ReplaceAll(action.String(), "_", " ")))
+	}
+	if action.String() == vo.ActionSubmit && !article.IsOwnedBy(input.Actor.UserID) && !hasPermission(input.Actor, authvo.PermissionArticleUpdateAny) {
+		return nil, shared.NewForbiddenError("You can only submit your own articles")
+	}
+
+	// 4. Menjalankan state machine
+	from, err := article.ApplyAction(*action)
+	if errors.Is(err, vo.ErrInvalidStateTransition) {
+		return nil, invalidTransitionError(action.String(), from.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 5. Menyimpan status baru beserta catatan transisi. Domain event
+	// (ArticlePublished/ArticleUnpublished) ikut ditulis ke outbox oleh repository
+	transition := entities.NewArticleTransition(
+		t.uuidGenerator.NewUUID(),
+		article.ID,
+		action.String(),
+		from.String(),
+		article.Status.String(),
+		input.Actor.UserID,
+		comment,
+	)
+
+	applied, err := t.articleRepository.SaveTransition(ctx, article, transition)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if !applied {
+		return nil, shared.NewInvalidStateTransitionError("article status was changed by another request, please reload")
+	}
+
+	return &dto.TransitionArticleOutput{
+		Article:    toArticleOutput(article),
+		Transition: toArticleTransitionOutput(transition),
+	}, nil
+}
+
+func invalidTransitionError(action, status string) error {
+	return shared.NewInvalidStateTransitionError(
+		fmt.Sprintf("cannot %s an article with status %s", strings.ReplaceAll(action, "_", " "), status),
+	)
+}
+
+func toArticleTransitionOutput(transition *entities.ArticleTransition) *dto.ArticleTransitionOutput {
+	return &dto.ArticleTransitionOutput{
+		ID:         transition.ID,
+		ArticleID:  transition.ArticleID,
+		Action:     transition.Action,
+		FromStatus: transition.FromStatus,
+		ToStatus:   transition.ToStatus,
+		ActorID:    transition.ActorID,
+		Comment:    transition.Comment,
+		CreatedAt:  transition.CreatedAt,
+	}
+}
@@ -0,0 +1,170 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	articleevents "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/events"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestTransitionArticle(t *testing.T) {
+	t.Run("should let the author submit their own draft for review", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		transitionArticleUsecase := usecases.NewTransitionArticle(articleRepoMock, uuidGenMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		input := dto.TransitionArticleInput{ArticleID: article.ID, Action: vo.ActionSubmit, Actor: newPrincipal("author-uuid", authvo.RoleContributor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		uuidGenMock.On("NewUUID").Return("transition-uuid").Once()
+		articleRepoMock.On("SaveTransition", mock.Anything, article, mock.MatchedBy(func(tr *entities.ArticleTransition) bool {
+			return tr.FromStatus == vo.StatusDraft && tr.ToStatus == vo.StatusInReview && tr.ActorID == "author-uuid"
+		})).Return(true, nil).Once()
+
+		output, err := transitionArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, vo.StatusInReview, output.Article.Status)
+		assert.Equal(t, "transition-uuid", output.Transition.ID)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should forbid a contributor from submitting someone else's draft", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		transitionArticleUsecase := usecases.NewTransitionArticle(articleRepoMock, uuidGenMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		input := dto.TransitionArticleInput{ArticleID: article.ID, Action: vo.ActionSubmit, Actor: newPrincipal("other-uuid", authvo.RoleContributor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		_, err := transitionArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "SaveTransition", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should forbid an author from publishing", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		transitionArticleUsecase := usecases.NewTransitionArticle(articleRepoMock, uuidGenMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusApproved)
+		input := dto.TransitionArticleInput{ArticleID: article.ID, Action: vo.ActionPublish, Actor: newPrincipal("author-uuid", authvo.RoleAuthor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		_, err := transitionArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "SaveTransition", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject publishing an article that has not been approved", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		transitionArticleUsecase := usecases.NewTransitionArticle(articleRepoMock, uuidGenMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		input := dto.TransitionArticleInput{ArticleID: article.ID, Action: vo.ActionPublish, Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		_, err := transitionArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "INVALID_STATE_TRANSITION", shared.GetErrorCode(err))
+		assert.Equal(t, 409, shared.GetStatusCode(shared.GetErrorCode(err)))
+		assert.Equal(t, vo.StatusDraft, article.Status.String())
+	})
+
+	t.Run("should require a comment when requesting changes", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		transitionArticleUsecase := usecases.NewTransitionArticle(articleRepoMock, uuidGenMock)
+
+		input := dto.TransitionArticleInput{ArticleID: "article-uuid", Action: vo.ActionRequestChanges, Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		_, err := transitionArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should report a conflict when the status changed concurrently", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		transitionArticleUsecase := usecases.NewTransitionArticle(articleRepoMock, uuidGenMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusInReview)
+		input := dto.TransitionArticleInput{ArticleID: article.ID, Action: vo.ActionApprove, Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		uuidGenMock.On("NewUUID").Return("transition-uuid").Once()
+		articleRepoMock.On("SaveTransition", mock.Anything, article, mock.Anything).Return(false, nil).Once()
+
+		_, err := transitionArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "INVALID_STATE_TRANSITION", shared.GetErrorCode(err))
+	})
+
+	t.Run("should wrap repository errors as database errors", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		transitionArticleUsecase := usecases.NewTransitionArticle(articleRepoMock, uuidGenMock)
+
+		input := dto.TransitionArticleInput{ArticleID: "article-uuid", Action: vo.ActionArchive, Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, "article-uuid").Return(nil, errors.New("connection refused")).Once()
+
+		_, err := transitionArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "DATABASE_ERROR", shared.GetErrorCode(err))
+	})
+}
+
+func TestTransitionArticleEvents(t *testing.T) {
+	cases := []struct {
+		name      string
+		from      string
+		action    string
+		eventName string
+	}{
+		{"should leave ArticlePublished on the article for the outbox after publishing", vo.StatusApproved, vo.ActionPublish, articleevents.ArticlePublishedEvent},
+		{"should leave ArticleUnpublished on the article for the outbox after archiving", vo.StatusPublished, vo.ActionArchive, articleevents.ArticleUnpublishedEvent},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			articleRepoMock := new(MockArticleRepository)
+			uuidGenMock := new(MockUUIDGenerator)
+			transitionArticleUsecase := usecases.NewTransitionArticle(articleRepoMock, uuidGenMock)
+
+			article := newStoredArticle(t, "article-uuid", "author-uuid", tc.from)
+			input := dto.TransitionArticleInput{ArticleID: article.ID, Action: tc.action, Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+			articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+			uuidGenMock.On("NewUUID").Return("transition-uuid").Once()
+			articleRepoMock.On("SaveTransition", mock.Anything, article, mock.Anything).Return(true, nil).Once()
+
+			_, err := transitionArticleUsecase.Execute(context.Background(), &input)
+
+			// ✅ Event tidak diambil use case, repository yang menulisnya ke outbox
+			assert.Nil(t, err)
+			events := article.PullEvents()
+			assert.Len(t, events, 1)
+			assert.Equal(t, tc.eventName, events[0].EventName())
+		})
+	}
+}
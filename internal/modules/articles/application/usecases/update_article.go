@@ -0,0 +1,126 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// UpdateArticle adalah use case untuk mengubah judul dan konten artikel.
+// Setiap perubahan disimpan sebagai revisi baru.
+// Perubahan status dilakukan lewat TransitionArticle.
+type UpdateArticle struct {
+	articleRepository repos.ArticleRepository
+}
+
+// NewUpdateArticle adalah konstruktor untuk use case ini.
+func NewUpdateArticle(articleRepo repos.ArticleRepository) *UpdateArticle {
+	return &UpdateArticle{
+		articleRepository: articleRepo,
+	}
+}
+
+// Execute menerapkan perubahan parsial pada artikel.
+func (u *UpdateArticle) Execute(ctx context.Context, input *dto.UpdateArticleInput) (*dto.ArticleOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+
+	// 1. Mencari artikel
+	article, err := u.articleRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if article == nil || !canView(article, input.Actor) {
+		return nil, shared.NewNotFoundError("Article not found")
+	}
+
+	// 2. Otorisasi
+	if !canEdit(article, input.Actor) {
+		return nil, shared.NewForbiddenError("You are not allowed to edit this article")
+	}
+
+	// 3. Menerapkan perubahan konten
+	title := article.Title
+	if input.Title != nil {
+		titleVO, err := vo.NewTitle(*input.Title)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		title = *titleVO
+	}
+
+	body := article.Body
+	if input.Body != nil {
+		body = *input.Body
+	}
+
+	excerpt := article.Excerpt
+	if input.Excerpt != nil {
+		excerpt = *input.Excerpt
+	}
+
+	if err := article.Revise(title, body, excerpt); err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	if input.Slug != nil {
+		explicitSlug := strings.TrimSpace(*input.Slug) != ""
+		slugSource := *input.Slug
+		if !explicitSlug {
+			slugSource = title.String()
+		}
+		slugVO, err := vo.SlugFromText(slugSource)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+
+		if slugVO.String() != article.Slug {
+			slug, err := resolveSlug(ctx, u.articleRepository, *slugVO, explicitSlug, article.ID)
+			if err != nil {
+				return nil, err
+			}
+			article.ChangeSlug(*slug)
+		}
+	}
+
+	if input.CategoryID != nil && *input.CategoryID != article.CategoryID {
+		if err := ensureCategoryExists(ctx, u.articleRepository, *input.CategoryID); err != nil {
+			return nil, err
+		}
+		article.AssignCategory(*input.CategoryID)
+	}
+
+	if input.Language != nil {
+		language, err := vo.NewLanguage(*input.Language)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		article.SetLanguage(*language)
+	}
+
+	if input.FeaturedImageID != nil && *input.FeaturedImageID != article.FeaturedImageID {
+		if err := ensureImageExists(ctx, u.articleRepository, *input.FeaturedImageID); err != nil {
+			return nil, err
+		}
+		article.SetFeaturedImage(*input.FeaturedImageID)
+	}
+
+	// 4. Menyimpan perubahan beserta snapshot revisinya
+	revision := entities.NewArticleRevision(article, input.Actor.UserID, strings.TrimSpace(input.ChangeNote))
+	savedArticle, err := u.articleRepository.Revise(ctx, article, revision)
+	if err != nil {
+		// ✅ Slug yang lolos resolveSlug bisa keburu dipakai request paralel
+		if shared.IsUniqueViolation(err) {
+			return nil, shared.NewConflictError("Slug already used by another article, please try again")
+		}
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	return toArticleOutput(savedArticle), nil
+}
@@ -0,0 +1,136 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestUpdateArticle(t *testing.T) {
+	t.Run("should let the author revise their own draft", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		updateArticleUsecase := usecases.NewUpdateArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		input := dto.UpdateArticleInput{ID: article.ID, Body: strPtr("Isi baru."), ChangeNote: " Perbaiki typo ", Actor: newPrincipal("author-uuid", authvo.RoleContributor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("Revise", mock.Anything, mock.MatchedBy(func(a *entities.Article) bool {
+			return a.Body == "Isi baru." && a.Title.String() == "Pemilu 2029 dimulai"
+		}), mock.MatchedBy(func(r *entities.ArticleRevision) bool {
+			return r.Body == "Isi baru." && r.EditorID == "author-uuid" && r.ChangeNote == "Perbaiki typo"
+		})).Return(article, nil).Once()
+
+		output, err := updateArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Isi baru.", output.Body)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should forbid a contributor from editing someone else's draft", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		updateArticleUsecase := usecases.NewUpdateArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusDraft)
+		input := dto.UpdateArticleInput{ID: article.ID, Body: strPtr("Isi baru."), Actor: newPrincipal("other-uuid", authvo.RoleContributor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		_, err := updateArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+		articleRepoMock.AssertNotCalled(t, "Revise", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should forbid the author from editing a published article", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		updateArticleUsecase := usecases.NewUpdateArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		input := dto.UpdateArticleInput{ID: article.ID, Body: strPtr("Isi baru."), Actor: newPrincipal("author-uuid", authvo.RoleAuthor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+
+		_, err := updateArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+	})
+
+	t.Run("should let an editor edit any article", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		updateArticleUsecase := usecases.NewUpdateArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		input := dto.UpdateArticleInput{ID: article.ID, Title: strPtr("Judul koreksi"), Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("Revise", mock.Anything, mock.AnythingOfType("*entities.Article"), mock.AnythingOfType("*entities.ArticleRevision")).Return(article, nil).Once()
+
+		output, err := updateArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Judul koreksi", output.Title)
+	})
+
+	t.Run("should return not found for an unknown article", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		updateArticleUsecase := usecases.NewUpdateArticle(articleRepoMock)
+
+		articleRepoMock.On("FindByID", mock.Anything, "missing").Return(nil, nil).Once()
+
+		_, err := updateArticleUsecase.Execute(context.Background(), &dto.UpdateArticleInput{ID: "missing", Actor: newPrincipal("editor-uuid", authvo.RoleEditor)})
+
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+	})
+
+	t.Run("should keep the old slug of a published article as a redirect", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		updateArticleUsecase := usecases.NewUpdateArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		input := dto.UpdateArticleInput{ID: article.ID, Slug: strPtr("Pemilu 2029 Resmi Dimulai"), Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("FindRedirect", mock.Anything, "pemilu-2029-resmi-dimulai").Return("", nil).Once()
+		articleRepoMock.On("ExistsBySlug", mock.Anything, "pemilu-2029-resmi-dimulai").Return(false, nil).Once()
+		articleRepoMock.On("Revise", mock.Anything, mock.MatchedBy(func(a *entities.Article) bool {
+			return a.Slug == "pemilu-2029-resmi-dimulai" && a.PreviousSlug == "pemilu-2029-dimulai"
+		}), mock.AnythingOfType("*entities.ArticleRevision")).Return(article, nil).Once()
+
+		output, err := updateArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "pemilu-2029-resmi-dimulai", output.Slug)
+		articleRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should let an article reclaim its own old slug", func(t *testing.T) {
+		articleRepoMock := new(MockArticleRepository)
+		updateArticleUsecase := usecases.NewUpdateArticle(articleRepoMock)
+
+		article := newStoredArticle(t, "article-uuid", "author-uuid", vo.StatusPublished)
+		input := dto.UpdateArticleInput{ID: article.ID, Slug: strPtr("pemilu-dimulai"), Actor: newPrincipal("editor-uuid", authvo.RoleEditor)}
+
+		articleRepoMock.On("FindByID", mock.Anything, article.ID).Return(article, nil).Once()
+		articleRepoMock.On("FindRedirect", mock.Anything, "pemilu-dimulai").Return(article.ID, nil).Once()
+		articleRepoMock.On("Revise", mock.Anything, mock.AnythingOfType("*entities.Article"), mock.AnythingOfType("*entities.ArticleRevision")).Return(article, nil).Once()
+
+		output, err := updateArticleUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "pemilu-dimulai", output.Slug)
+		articleRepoMock.AssertNotCalled(t, "ExistsBySlug", mock.Anything, mock.Anything)
+	})
+}
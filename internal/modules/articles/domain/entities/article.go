@@ -0,0 +1,298 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	events "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/events"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	MaxExcerptLength  = 500
+	autoExcerptLength = 200
+)
+
+var (
+	ErrArticleBodyEmpty      = errors.New("body cannot be empty")
+	ErrArticleExcerptTooLong = errors.New("excerpt must be at most 500 characters")
+	ErrArticleAuthorEmpty    = errors.New("author cannot be empty")
+
+	ErrArticlePublishAtPast       = errors.New("publish_at must be in the future")
+	ErrArticleUnpublishBeforeLive = errors.New("unpublish_at must be after publish_at")
+	ErrArticleNotSchedulable      = errors.New("archived articles cannot be scheduled")
+	ErrArticleAlreadyPublished    = errors.New("article is already published, only unpublish_at can be scheduled")
+)
+
+type Article struct {
+	ID         string
+	Title      vo.Title
+	Slug       string
+	Body       string
+	Excerpt    string
+	Language   vo.Language
+	AuthorID   string
+	CategoryID string // kosong jika belum masuk section
+	// FeaturedImageID adalah ID media gambar utama, kosong jika tanpa gambar.
+	FeaturedImageID string
+	Status          vo.ArticleStatus
+	PublishedAt     *time.Time
+
+	// Jadwal publish/unpublish otomatis (embargo). ScheduledBy adalah user
+	// yang memasang jadwal dan dicatat sebagai aktor transisi oleh scheduler.
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+	ScheduledBy string
+
+	// Tags diurutkan berdasarkan nama; disimpan di tabel article_tags.
+	Tags []ArticleTag
+
+	// PreviousSlug diisi ChangeSlug jika slug lama sudah pernah tayang dan
+	// harus tetap diarahkan (301) ke slug baru. Tidak disimpan sebagai kolom.
+	PreviousSlug string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	shared.EventRecorder
+}
+
+func NewArticle(id string, title vo.Title, slug, body, excerpt, authorID string) (*Article, error) {
+	if strings.TrimSpace(authorID) == "" {
+		return nil, ErrArticleAuthorEmpty
+	}
+
+	body, excerpt, err := normalizeContent(body, excerpt)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Article{
+		ID:        id,
+		Title:     title,
+		Slug:      slug,
+		Body:      body,
+		Excerpt:   excerpt,
+		AuthorID:  authorID,
+		Language:  vo.DefaultLanguage(),
+		Status:    vo.DraftStatus(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Revise mengganti judul dan konten artikel.
+func (a *Article) Revise(title vo.Title, body, excerpt string) error {
+	body, excerpt, err := normalizeContent(body, excerpt)
+	if err != nil {
+		return err
+	}
+
+	a.Title = title
+	a.Body = body
+	a.Excerpt = excerpt
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// ChangeStatus mengganti status dan mencatat waktu publish pertama kali.
+// Masuk atau keluar dari status published dicatat sebagai domain event.
+func (a *Article) ChangeStatus(status vo.ArticleStatus) {
+	now := time.Now()
+	if status.Is(vo.StatusPublished) && a.PublishedAt == nil {
+		a.PublishedAt = &now
+	}
+
+	wasPublished := a.IsPublished()
+	a.Status = status
+	a.UpdatedAt = now
+
+	switch {
+	case !wasPublished && a.IsPublished():
+		a.RecordEvent(events.ArticlePublished{
+			ArticleID:  a.ID,
+			Slug:       a.Slug,
+			AuthorID:   a.AuthorID,
+			CategoryID: a.CategoryID,
+			At:         now,
+		})
+	case wasPublished && !a.IsPublished():
+		a.RecordEvent(events.ArticleUnpublished{
+			ArticleID:  a.ID,
+			Slug:       a.Slug,
+			CategoryID: a.CategoryID,
+			Status:     status.String(),
+			At:         now,
+		})
+	}
+}
+
+// ApplyAction menjalankan aksi workflow dan mengembalikan status sebelumnya.
+// Mengembalikan vo.ErrInvalidStateTransition jika aksi tidak sah dari status saat ini.
+func (a *Article) ApplyAction(action vo.WorkflowAction) (vo.ArticleStatus, error) {
+	from := a.Status
+	to, err := action.Target(from)
+	if err != nil {
+		return from, err
+	}
+
+	a.ChangeStatus(to)
+
+	// Jadwal yang sudah terlewati (atau dibatalkan karena artikel kembali ke
+	// draft untuk direvisi) dibuang supaya scheduler tidak menjalankannya lagi.
+	// publish_at yang lewat selama artikel masih di-review juga dibuang saat
+	// approve (UpdatedAt baru diisi ChangeStatus), agar artikel tidak langsung
+	// terbit tanpa dijadwalkan ulang
+	switch to.String() {
+	case vo.StatusApproved:
+		if a.PublishAt != nil && !a.PublishAt.After(a.UpdatedAt) {
+			a.PublishAt = nil
+		}
+	case vo.StatusDraft, vo.StatusPublished:
+		a.PublishAt = nil
+	case vo.StatusArchived:
+		a.PublishAt = nil
+		a.UnpublishAt = nil
+	}
+	return from, nil
+}
+
+// Schedule memasang jadwal publish dan/atau unpublish. Nilai nil menghapus jadwal.
+func (a *Article) Schedule(publishAt, unpublishAt *time.Time, scheduledBy string, now time.Time) error {
+	if a.Status.Is(vo.StatusArchived) {
+		return ErrArticleNotSchedulable
+	}
+	if publishAt != nil {
+		if a.IsPublished() {
+			return ErrArticleAlreadyPublished
+		}
+		if !publishAt.After(now) {
+			return ErrArticlePublishAtPast
+		}
+	}
+	if unpublishAt != nil {
+		liveFrom := now
+		if publishAt != nil {
+			liveFrom = *publishAt
+		}
+		if !unpublishAt.After(liveFrom) {
+			return ErrArticleUnpublishBeforeLive
+		}
+	}
+
+	a.PublishAt = publishAt
+	a.UnpublishAt = unpublishAt
+	a.ScheduledBy = scheduledBy
+	if publishAt == nil && unpublishAt == nil {
+		a.ScheduledBy = ""
+	}
+	a.UpdatedAt = now
+	return nil
+}
+
+// ApplyDueSchedule menjalankan jadwal yang sudah jatuh tempo pada waktu now:
+// artikel approved dipublish saat publish_at, artikel published diarsipkan
+// saat unpublish_at. Mengembalikan aksi workflow yang dijalankan dan status
+// sebelumnya; applied false jika tidak ada jadwal yang jatuh tempo.
+func (a *Article) ApplyDueSchedule(now time.Time) (action string, from vo.ArticleStatus, applied bool) {
+	from = a.Status
+
+	switch {
+	case a.Status.Is(vo.StatusApproved) && isDue(a.PublishAt, now):
+		action = vo.ActionPublish
+	case a.Status.Is(vo.StatusPublished) && isDue(a.UnpublishAt, now):
+		action = vo.ActionArchive
+	default:
+		return "", from, false
+	}
+
+	workflowAction, _ := vo.NewWorkflowAction(action)
+	if _, err := a.ApplyAction(*workflowAction); err != nil {
+		return "", from, false
+	}
+	return action, from, true
+}
+
+// ChangeSlug mengganti slug artikel. Slug artikel yang pernah dipublikasikan
+// sudah tersebar (media sosial, mesin pencari), jadi dicatat di PreviousSlug.
+func (a *Article) ChangeSlug(slug vo.Slug) {
+	if slug.String() == a.Slug {
+		return
+	}
+	if a.PublishedAt != nil && a.PreviousSlug == "" {
+		a.PreviousSlug = a.Slug
+	}
+
+	a.Slug = slug.String()
+	a.UpdatedAt = time.Now()
+}
+
+// AssignCategory memindahkan artikel ke section lain (kosong untuk melepas).
+func (a *Article) AssignCategory(categoryID string) {
+	a.CategoryID = categoryID
+	a.UpdatedAt = time.Now()
+}
+
+// SetLanguage mengganti bahasa artikel, yang juga menentukan konfigurasi pencarian.
+func (a *Article) SetLanguage(language vo.Language) {
+	a.Language = language
+	a.UpdatedAt = time.Now()
+}
+
+// SetFeaturedImage mengganti gambar utama artikel (kosong untuk melepas).
+func (a *Article) SetFeaturedImage(mediaID string) {
+	a.FeaturedImageID = mediaID
+	a.UpdatedAt = time.Now()
+}
+
+func (a *Article) IsOwnedBy(userID string) bool {
+	return a.AuthorID == userID
+}
+
+func (a *Article) IsPublished() bool {
+	return a.Status.Is(vo.StatusPublished)
+}
+
+func (a *Article) IsDraft() bool {
+	return a.Status.Is(vo.StatusDraft)
+}
+
+func isDue(at *time.Time, now time.Time) bool {
+	return at != nil && !at.After(now)
+}
+
+// normalizeContent memvalidasi body dan membuat excerpt otomatis jika kosong.
+func normalizeContent(body, excerpt string) (string, string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", "", ErrArticleBodyEmpty
+	}
+
+	excerpt = strings.TrimSpace(excerpt)
+	if utf8.RuneCountInString(excerpt) > MaxExcerptLength {
+		return "", "", ErrArticleExcerptTooLong
+	}
+	if excerpt == "" {
+		excerpt = truncateWords(strings.Join(strings.Fields(body), " "), autoExcerptLength)
+	}
+
+	return body, excerpt, nil
+}
+
+// truncateWords memotong teks maksimal limit karakter tanpa memotong kata.
+func truncateWords(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+
+	cut := string(runes[:limit])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return cut + "…"
+}
@@ -0,0 +1,29 @@
+package entities
+
+import "time"
+
+// ArticleRevision adalah snapshot immutable isi artikel setelah satu perubahan.
+// Number diisi oleh repository saat disimpan (1 untuk versi pertama).
+type ArticleRevision struct {
+	ArticleID  string
+	Number     int
+	Title      string
+	Body       string
+	Excerpt    string
+	EditorID   string
+	ChangeNote string
+	CreatedAt  time.Time
+}
+
+// NewArticleRevision membuat snapshot dari kondisi artikel saat ini.
+func NewArticleRevision(article *Article, editorID, changeNote string) *ArticleRevision {
+	return &ArticleRevision{
+		ArticleID:  article.ID,
+		Title:      article.Title.String(),
+		Body:       article.Body,
+		Excerpt:    article.Excerpt,
+		EditorID:   editorID,
+		ChangeNote: changeNote,
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+const MaxArticleTags = 10
+
+var ErrArticleTooManyTags = errors.New("article can have at most 10 tags")
+
+// ArticleTag adalah tag yang ditempel ke artikel. Slug sudah dinormalisasi
+// oleh TagSlug milik modul tags; ID hanya dipakai jika tag dengan slug
+// tersebut (atau aliasnya) belum ada dan harus dibuat.
+type ArticleTag struct {
+	ID   string
+	Name string
+	Slug string
+}
+
+// SetTags mengganti seluruh tag artikel. Tag dengan slug yang sama
+// dianggap satu tag; ejaan pertama yang dipertahankan.
+func (a *Article) SetTags(tags []ArticleTag) error {
+	seen := make(map[string]bool, len(tags))
+	unique := make([]ArticleTag, 0, len(tags))
+	for _, tag := range tags {
+		if seen[tag.Slug] {
+			continue
+		}
+		seen[tag.Slug] = true
+		unique = append(unique, tag)
+	}
+
+	if len(unique) > MaxArticleTags {
+		return ErrArticleTooManyTags
+	}
+
+	a.Tags = unique
+	a.UpdatedAt = time.Now()
+	return nil
+}
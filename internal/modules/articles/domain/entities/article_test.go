@@ -0,0 +1,342 @@
+package entities_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	events "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/events"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+)
+
+// Helper function
+func CreateValidArticle(t *testing.T) *entities.Article {
+	t.Helper()
+
+	titleVO, err := vo.NewTitle("Pemilu 2029 dimulai")
+	if err != nil {
+		t.Fatalf("Error creating title value object: %v", err)
+	}
+
+	article, err := entities.NewArticle("article-uuid", *titleVO, "pemilu-2029-dimulai", "Isi berita.", "", "author-uuid")
+	if err != nil {
+		t.Fatalf("Error creating article: %v", err)
+	}
+
+	return article
+}
+
+func TestNewArticle(t *testing.T) {
+	t.Run("should create a new draft article with valid data", func(t *testing.T) {
+		article := CreateValidArticle(t)
+
+		if article.ID != "article-uuid" {
+			t.Errorf("Expected article ID to be 'article-uuid', but got '%s'", article.ID)
+		}
+		if !article.IsDraft() {
+			t.Errorf("Expected status to be draft, but got '%s'", article.Status.String())
+		}
+		if article.Excerpt != "Isi berita." {
+			t.Errorf("Expected excerpt to be derived from body, but got '%s'", article.Excerpt)
+		}
+		if article.PublishedAt != nil {
+			t.Error("Expected PublishedAt to be nil for a draft")
+		}
+		if article.CreatedAt.IsZero() || article.UpdatedAt.IsZero() {
+			t.Error("Expected timestamps to be set")
+		}
+	})
+
+	t.Run("should truncate the auto excerpt on a word boundary", func(t *testing.T) {
+		titleVO, _ := vo.NewTitle("Judul panjang")
+		body := strings.Repeat("kata ", 100)
+
+		article, err := entities.NewArticle("id", *titleVO, "judul-panjang", body, "", "author-uuid")
+
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if !strings.HasSuffix(article.Excerpt, "kata…") || len([]rune(article.Excerpt)) > 201 {
+			t.Errorf("Expected truncated excerpt, but got '%s'", article.Excerpt)
+		}
+	})
+
+	t.Run("should return an error for empty body", func(t *testing.T) {
+		titleVO, _ := vo.NewTitle("Judul berita")
+		_, err := entities.NewArticle("id", *titleVO, "judul-berita", "   ", "", "author-uuid")
+		if !errors.Is(err, entities.ErrArticleBodyEmpty) {
+			t.Errorf("Expected error ErrArticleBodyEmpty, but got %v", err)
+		}
+	})
+
+	t.Run("should return an error for too long excerpt", func(t *testing.T) {
+		titleVO, _ := vo.NewTitle("Judul berita")
+		_, err := entities.NewArticle("id", *titleVO, "judul-berita", "Isi", strings.Repeat("a", 501), "author-uuid")
+		if !errors.Is(err, entities.ErrArticleExcerptTooLong) {
+			t.Errorf("Expected error ErrArticleExcerptTooLong, but got %v", err)
+		}
+	})
+
+	t.Run("should return an error for too short title", func(t *testing.T) {
+		_, err := vo.NewTitle("abc")
+		if !errors.Is(err, vo.ErrTitleInvalidLength) {
+			t.Errorf("Expected error ErrTitleInvalidLength, but got %v", err)
+		}
+	})
+}
+
+func TestArticleChangeStatus(t *testing.T) {
+	t.Run("should set PublishedAt only the first time it is published", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		published, _ := vo.NewArticleStatus(vo.StatusPublished)
+		archived, _ := vo.NewArticleStatus(vo.StatusArchived)
+
+		article.ChangeStatus(*published)
+		firstPublishedAt := article.PublishedAt
+
+		article.ChangeStatus(*archived)
+		article.ChangeStatus(*published)
+
+		if firstPublishedAt == nil || article.PublishedAt != firstPublishedAt {
+			t.Error("Expected PublishedAt to be kept from the first publication")
+		}
+	})
+
+	t.Run("should record events only when entering or leaving published", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		inReview, _ := vo.NewArticleStatus(vo.StatusInReview)
+		published, _ := vo.NewArticleStatus(vo.StatusPublished)
+		archived, _ := vo.NewArticleStatus(vo.StatusArchived)
+
+		article.ChangeStatus(*inReview)
+		article.ChangeStatus(*published)
+		article.ChangeStatus(*published)
+		article.ChangeStatus(*archived)
+
+		recorded := article.PullEvents()
+		if len(recorded) != 2 {
+			t.Fatalf("Expected 2 events, but got %d", len(recorded))
+		}
+		if recorded[0].EventName() != events.ArticlePublishedEvent || recorded[1].EventName() != events.ArticleUnpublishedEvent {
+			t.Errorf("Expected [%s %s], but got [%s %s]", events.ArticlePublishedEvent, events.ArticleUnpublishedEvent, recorded[0].EventName(), recorded[1].EventName())
+		}
+		if unpublished := recorded[1].(events.ArticleUnpublished); unpublished.Status != vo.StatusArchived {
+			t.Errorf("Expected status '%s', but got '%s'", vo.StatusArchived, unpublished.Status)
+		}
+		if len(article.PullEvents()) != 0 {
+			t.Error("Expected PullEvents to clear recorded events")
+		}
+	})
+}
+
+func TestArticleApplyAction(t *testing.T) {
+	approve, _ := vo.NewWorkflowAction(vo.ActionApprove)
+
+	t.Run("should drop a publish_at that passed while the article was in review", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		inReview, _ := vo.NewArticleStatus(vo.StatusInReview)
+		article.ChangeStatus(*inReview)
+		publishAt := time.Now().Add(-time.Hour)
+		article.PublishAt = &publishAt
+
+		if _, err := article.ApplyAction(*approve); err != nil {
+			t.Fatalf("Expected approve to succeed, but got %v", err)
+		}
+		if article.PublishAt != nil {
+			t.Error("Expected past publish_at to be dropped on approve")
+		}
+		if _, _, applied := article.ApplyDueSchedule(time.Now()); applied {
+			t.Error("Expected approved article not to be published by a stale schedule")
+		}
+	})
+
+	t.Run("should keep a future publish_at on approve", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		inReview, _ := vo.NewArticleStatus(vo.StatusInReview)
+		article.ChangeStatus(*inReview)
+		publishAt := time.Now().Add(time.Hour)
+		article.PublishAt = &publishAt
+
+		if _, err := article.ApplyAction(*approve); err != nil {
+			t.Fatalf("Expected approve to succeed, but got %v", err)
+		}
+		if article.PublishAt == nil || !article.PublishAt.Equal(publishAt) {
+			t.Error("Expected future publish_at to be kept on approve")
+		}
+	})
+}
+
+func TestArticleSchedule(t *testing.T) {
+	now := time.Date(2029, 2, 14, 5, 0, 0, 0, time.UTC)
+	publishAt := now.Add(time.Hour)
+	unpublishAt := now.Add(48 * time.Hour)
+
+	t.Run("should store the schedule and who set it", func(t *testing.T) {
+		article := CreateValidArticle(t)
+
+		if err := article.Schedule(&publishAt, &unpublishAt, "editor-uuid", now); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if article.PublishAt == nil || !article.PublishAt.Equal(publishAt) {
+			t.Errorf("Expected PublishAt to be %v, but got %v", publishAt, article.PublishAt)
+		}
+		if article.ScheduledBy != "editor-uuid" {
+			t.Errorf("Expected ScheduledBy to be 'editor-uuid', but got '%s'", article.ScheduledBy)
+		}
+	})
+
+	t.Run("should reject publish_at in the past", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		past := now.Add(-time.Minute)
+
+		err := article.Schedule(&past, nil, "editor-uuid", now)
+		if !errors.Is(err, entities.ErrArticlePublishAtPast) {
+			t.Errorf("Expected ErrArticlePublishAtPast, but got %v", err)
+		}
+	})
+
+	t.Run("should reject unpublish_at before publish_at", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		early := publishAt.Add(-time.Minute)
+
+		err := article.Schedule(&publishAt, &early, "editor-uuid", now)
+		if !errors.Is(err, entities.ErrArticleUnpublishBeforeLive) {
+			t.Errorf("Expected ErrArticleUnpublishBeforeLive, but got %v", err)
+		}
+	})
+
+	t.Run("should clear the schedule when both values are nil", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		_ = article.Schedule(&publishAt, nil, "editor-uuid", now)
+
+		if err := article.Schedule(nil, nil, "editor-uuid", now); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if article.PublishAt != nil || article.ScheduledBy != "" {
+			t.Error("Expected schedule to be cleared")
+		}
+	})
+}
+
+func TestArticleApplyDueSchedule(t *testing.T) {
+	now := time.Date(2029, 2, 14, 6, 0, 0, 0, time.UTC)
+
+	t.Run("should publish an approved article once publish_at is due", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		approved, _ := vo.NewArticleStatus(vo.StatusApproved)
+		article.ChangeStatus(*approved)
+		publishAt := now.Add(-time.Second)
+		article.PublishAt = &publishAt
+
+		action, from, applied := article.ApplyDueSchedule(now)
+
+		if !applied || action != vo.ActionPublish || from.String() != vo.StatusApproved {
+			t.Fatalf("Expected publish from approved, but got action=%q from=%q applied=%v", action, from.String(), applied)
+		}
+		if !article.IsPublished() || article.PublishAt != nil {
+			t.Error("Expected article to be published and publish_at consumed")
+		}
+	})
+
+	t.Run("should not publish an article that is still in review", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		inReview, _ := vo.NewArticleStatus(vo.StatusInReview)
+		article.ChangeStatus(*inReview)
+		publishAt := now.Add(-time.Second)
+		article.PublishAt = &publishAt
+
+		if _, _, applied := article.ApplyDueSchedule(now); applied {
+			t.Error("Expected unapproved article not to be published")
+		}
+	})
+
+	t.Run("should archive a published article once unpublish_at is due", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		published, _ := vo.NewArticleStatus(vo.StatusPublished)
+		article.ChangeStatus(*published)
+		unpublishAt := now
+		article.UnpublishAt = &unpublishAt
+
+		action, _, applied := article.ApplyDueSchedule(now)
+
+		if !applied || action != vo.ActionArchive || !article.Status.Is(vo.StatusArchived) {
+			t.Errorf("Expected article to be archived, but got action=%q status=%q", action, article.Status.String())
+		}
+	})
+
+	t.Run("should do nothing before the schedule is due", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		approved, _ := vo.NewArticleStatus(vo.StatusApproved)
+		article.ChangeStatus(*approved)
+		publishAt := now.Add(time.Minute)
+		article.PublishAt = &publishAt
+
+		if _, _, applied := article.ApplyDueSchedule(now); applied {
+			t.Error("Expected nothing to be applied before publish_at")
+		}
+	})
+}
+
+func TestArticleSetTags(t *testing.T) {
+	t.Run("should keep the first spelling of duplicate slugs", func(t *testing.T) {
+		article := CreateValidArticle(t)
+
+		err := article.SetTags([]entities.ArticleTag{
+			{Name: "COVID-19", Slug: "covid-19"},
+			{Name: "covid 19", Slug: "covid-19"},
+			{Name: "Pemilu", Slug: "pemilu"},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if len(article.Tags) != 2 {
+			t.Fatalf("Expected 2 tags, but got %d", len(article.Tags))
+		}
+		if article.Tags[0].Name != "COVID-19" {
+			t.Errorf("Expected first spelling 'COVID-19', but got '%s'", article.Tags[0].Name)
+		}
+	})
+
+	t.Run("should reject more than the maximum number of tags", func(t *testing.T) {
+		article := CreateValidArticle(t)
+
+		tags := make([]entities.ArticleTag, 0, entities.MaxArticleTags+1)
+		for i := 0; i <= entities.MaxArticleTags; i++ {
+			slug := "tag-" + strings.Repeat("x", i+1)
+			tags = append(tags, entities.ArticleTag{Name: slug, Slug: slug})
+		}
+
+		err := article.SetTags(tags)
+		if !errors.Is(err, entities.ErrArticleTooManyTags) {
+			t.Errorf("Expected error ErrArticleTooManyTags, but got %v", err)
+		}
+	})
+}
+
+func TestArticleChangeSlug(t *testing.T) {
+	t.Run("should not keep a redirect for a draft that never went live", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		slug, _ := vo.SlugFromText("Judul baru")
+
+		article.ChangeSlug(*slug)
+
+		if article.Slug != "judul-baru" || article.PreviousSlug != "" {
+			t.Errorf("Expected slug 'judul-baru' without redirect, but got '%s' (previous '%s')", article.Slug, article.PreviousSlug)
+		}
+	})
+
+	t.Run("should keep the live slug of a published article", func(t *testing.T) {
+		article := CreateValidArticle(t)
+		published, _ := vo.NewArticleStatus(vo.StatusPublished)
+		article.ChangeStatus(*published)
+		slug, _ := vo.SlugFromText("Judul baru")
+
+		article.ChangeSlug(*slug)
+
+		if article.PreviousSlug != "pemilu-2029-dimulai" {
+			t.Errorf("Expected previous slug 'pemilu-2029-dimulai', but got '%s'", article.PreviousSlug)
+		}
+	})
+}
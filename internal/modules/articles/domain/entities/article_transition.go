@@ -0,0 +1,28 @@
+package entities
+
+import "time"
+
+// ArticleTransition adalah catatan audit satu perpindahan status artikel.
+type ArticleTransition struct {
+	ID         string
+	ArticleID  string
+	Action     string
+	FromStatus string
+	ToStatus   string
+	ActorID    string
+	Comment    string
+	CreatedAt  time.Time
+}
+
+func NewArticleTransition(id, articleID, action, fromStatus, toStatus, actorID, comment string) *ArticleTransition {
+	return &ArticleTransition{
+		ID:         id,
+		ArticleID:  articleID,
+		Action:     action,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+		ActorID:    actorID,
+		Comment:    comment,
+		CreatedAt:  time.Now(),
+	}
+}
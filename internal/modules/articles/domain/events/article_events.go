@@ -0,0 +1,44 @@
+package events
+
+import "time"
+
+const (
+	ArticlePublishedEvent   = "article.published"
+	ArticleUnpublishedEvent = "article.unpublished"
+)
+
+// ArticlePublished dicatat saat artikel berpindah ke status published,
+// baik lewat workflow maupun jadwal publish.
+type ArticlePublished struct {
+	ArticleID  string    `json:"article_id"`
+	Slug       string    `json:"slug"`
+	AuthorID   string    `json:"author_id"`
+	CategoryID string    `json:"category_id,omitempty"`
+	At         time.Time `json:"occurred_at"`
+}
+
+func (e ArticlePublished) EventName() string {
+	return ArticlePublishedEvent
+}
+
+func (e ArticlePublished) OccurredAt() time.Time {
+	return e.At
+}
+
+// ArticleUnpublished dicatat saat artikel published keluar dari status
+// published (diarsipkan manual atau oleh jadwal unpublish).
+type ArticleUnpublished struct {
+	ArticleID  string    `json:"article_id"`
+	Slug       string    `json:"slug"`
+	CategoryID string    `json:"category_id,omitempty"`
+	Status     string    `json:"status"`
+	At         time.Time `json:"occurred_at"`
+}
+
+func (e ArticleUnpublished) EventName() string {
+	return ArticleUnpublishedEvent
+}
+
+func (e ArticleUnpublished) OccurredAt() time.Time {
+	return e.At
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+)
+
+// ArticleFilter adalah kriteria untuk FindAll. Field kosong berarti tidak difilter.
+// CategoryID mencakup seluruh subtree kategori tersebut.
+type ArticleFilter struct {
+	Status     string
+	AuthorID   string
+	CategoryID string
+	TagSlug    string
+	Limit      int
+	Offset     int
+}
+
+// Penanda kata yang cocok pada SearchResult. Karakter kontrol dipakai supaya
+// tidak bentrok dengan isi artikel; lapisan aplikasi menggantinya dengan markup.
+const (
+	HighlightStart = "\x01"
+	HighlightStop  = "\x02"
+)
+
+// SearchFilter adalah kriteria untuk Search. Hanya artikel published yang dicari.
+// Language berisi regconfig ("indonesian"/"english"); kosong berarti setiap
+// artikel dicocokkan dengan konfigurasi bahasanya sendiri.
+type SearchFilter struct {
+	Query         string
+	Language      string
+	AuthorID      string
+	CategoryID    string
+	TagSlug       string
+	PublishedFrom *time.Time
+	PublishedTo   *time.Time // eksklusif
+	Limit         int
+	Offset        int
+}
+
+// SearchResult adalah artikel yang cocok beserta skor relevansi dan cuplikan
+// judul/isi dengan kata yang cocok diapit HighlightStart dan HighlightStop.
+type SearchResult struct {
+	Article        *entities.Article
+	Rank           float64
+	TitleHighlight string
+	BodyHighlight  string
+}
+
+type ArticleRepository interface {
+	// Save menyimpan artikel baru beserta revisi pertama dan tag-nya dalam satu transaksi.
+	Save(ctx context.Context, article *entities.Article, revision *entities.ArticleRevision) (*entities.Article, error)
+	// UpdateSchedule menyimpan publish_at, unpublish_at dan scheduled_by saja.
+	// Mengembalikan false jika status di database sudah bukan article.Status
+	// (diubah oleh transisi atau worker jadwal).
+	UpdateSchedule(ctx context.Context, article *entities.Article) (bool, error)
+	// Revise menyimpan perubahan konten beserta revisi barunya dalam satu transaksi.
+	// Nomor revisi diisi ke revision.Number. Jika article.PreviousSlug diisi,
+	// slug lama dicatat sebagai redirect dalam transaksi yang sama.
+	Revise(ctx context.Context, article *entities.Article, revision *entities.ArticleRevision) (*entities.Article, error)
+	FindByID(ctx context.Context, id string) (*entities.Article, error)
+	FindBySlug(ctx context.Context, slug string) (*entities.Article, error)
+	FindAll(ctx context.Context, filter ArticleFilter) ([]*entities.Article, int, error)
+	// Search mencari artikel published dengan full-text search, urut dari
+	// yang paling relevan, beserta total hasil.
+	Search(ctx context.Context, filter SearchFilter) ([]*SearchResult, int, error)
+	// ExistsBySlug juga mengecek slug lama di tabel redirect, karena slug
+	// tersebut masih dipakai untuk mengarahkan link lama.
+	ExistsBySlug(ctx context.Context, slug string) (bool, error)
+	// FindRedirect mengembalikan ID artikel pemilik slug lama, atau string kosong.
+	FindRedirect(ctx context.Context, oldSlug string) (string, error)
+	CategoryExists(ctx context.Context, categoryID string) (bool, error)
+	// ImageExists mengecek apakah mediaID adalah gambar di media library.
+	ImageExists(ctx context.Context, mediaID string) (bool, error)
+	// SetTags mengganti seluruh link tag artikel dalam satu transaksi. Tag
+	// dicocokkan lewat slug atau alias hasil merge, dan dibuat jika belum
+	// ada. ID pada article.Tags diganti dengan ID tag yang tersimpan.
+	SetTags(ctx context.Context, article *entities.Article) error
+	// Delete menyembunyikan artikel (soft delete); riwayat revisinya tetap disimpan.
+	Delete(ctx context.Context, id string) error
+
+	// SaveTransition menyimpan status baru artikel beserta catatan transisinya
+	// secara atomik. Mengembalikan false jika status di database sudah bukan
+	// transition.FromStatus (diubah oleh request lain).
+	SaveTransition(ctx context.Context, article *entities.Article, transition *entities.ArticleTransition) (bool, error)
+	FindTransitions(ctx context.Context, articleID string) ([]*entities.ArticleTransition, error)
+
+	// FindRevisions mengembalikan revisi artikel, terbaru lebih dulu.
+	FindRevisions(ctx context.Context, articleID string) ([]*entities.ArticleRevision, error)
+	FindRevision(ctx context.Context, articleID string, number int) (*entities.ArticleRevision, error)
+
+	// ApplyDueSchedules mengunci maksimal limit artikel yang jadwalnya jatuh
+	// tempo pada now (FOR UPDATE SKIP LOCKED, aman untuk beberapa replika) dan
+	// memanggil apply untuk masing-masing dalam satu transaksi. apply
+	// mengembalikan transisi yang disimpan bersama status baru, atau nil untuk
+	// melewati artikel. Mengembalikan jumlah artikel yang diubah.
+	ApplyDueSchedules(ctx context.Context, now time.Time, limit int, apply func(article *entities.Article) *entities.ArticleTransition) (int, error)
+}
@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+type ArticleStatus struct {
+	value string
+}
+
+const (
+	StatusDraft     = "draft"
+	StatusInReview  = "in_review"
+	StatusApproved  = "approved"
+	StatusPublished = "published"
+	StatusArchived  = "archived"
+)
+
+var validStatuses = map[string]bool{
+	StatusDraft:     true,
+	StatusInReview:  true,
+	StatusApproved:  true,
+	StatusPublished: true,
+	StatusArchived:  true,
+}
+
+var (
+	ErrStatusEmpty   = errors.New("status cannot be empty")
+	ErrStatusInvalid = errors.New("status must be one of draft, in_review, approved, published, archived")
+)
+
+func NewArticleStatus(value string) (*ArticleStatus, error) {
+	payload := strings.ToLower(strings.TrimSpace(value))
+
+	if payload == "" {
+		return nil, ErrStatusEmpty
+	}
+
+	if !validStatuses[payload] {
+		return nil, ErrStatusInvalid
+	}
+
+	return &ArticleStatus{value: payload}, nil
+}
+
+// DraftStatus adalah status awal setiap artikel baru.
+func DraftStatus() ArticleStatus {
+	return ArticleStatus{value: StatusDraft}
+}
+
+func (s *ArticleStatus) String() string {
+	return s.value
+}
+
+func (s *ArticleStatus) Value() string {
+	return s.value
+}
+
+func (s *ArticleStatus) Is(status string) bool {
+	return s.value == status
+}
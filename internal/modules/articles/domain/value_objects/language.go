@@ -0,0 +1,63 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// Language adalah bahasa artikel (kode ISO 639-1). Bahasa menentukan
+// konfigurasi full-text search Postgres untuk stemming dan stop word.
+type Language struct {
+	value string
+}
+
+const (
+	LanguageIndonesian = "id"
+	LanguageEnglish    = "en"
+)
+
+// searchConfigs memetakan kode bahasa ke regconfig Postgres.
+var searchConfigs = map[string]string{
+	LanguageIndonesian: "indonesian",
+	LanguageEnglish:    "english",
+}
+
+var ErrLanguageInvalid = errors.New("language must be one of id, en")
+
+func NewLanguage(value string) (*Language, error) {
+	payload := strings.ToLower(strings.TrimSpace(value))
+
+	if _, ok := searchConfigs[payload]; !ok {
+		return nil, ErrLanguageInvalid
+	}
+
+	return &Language{value: payload}, nil
+}
+
+// LanguageFromSearchConfig membuat ulang Language dari regconfig yang tersimpan.
+func LanguageFromSearchConfig(config string) (*Language, error) {
+	for code, name := range searchConfigs {
+		if name == config {
+			return &Language{value: code}, nil
+		}
+	}
+	return nil, ErrLanguageInvalid
+}
+
+// DefaultLanguage adalah bahasa artikel jika tidak disebutkan.
+func DefaultLanguage() Language {
+	return Language{value: LanguageIndonesian}
+}
+
+// SearchConfig adalah nama regconfig Postgres untuk bahasa ini.
+func (l *Language) SearchConfig() string {
+	return searchConfigs[l.value]
+}
+
+func (l *Language) String() string {
+	return l.value
+}
+
+func (l *Language) Value() string {
+	return l.value
+}
@@ -0,0 +1,56 @@
+package valueobjects_test
+
+import (
+	"errors"
+	"testing"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+)
+
+func TestNewLanguage(t *testing.T) {
+	validCases := []struct {
+		input  string
+		code   string
+		config string
+	}{
+		{"id", "id", "indonesian"},
+		{" EN ", "en", "english"},
+	}
+
+	for _, tc := range validCases {
+		t.Run(tc.input, func(t *testing.T) {
+			language, err := vo.NewLanguage(tc.input)
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if language.String() != tc.code {
+				t.Errorf("Expected code '%s', but got '%s'", tc.code, language.String())
+			}
+			if language.SearchConfig() != tc.config {
+				t.Errorf("Expected search config '%s', but got '%s'", tc.config, language.SearchConfig())
+			}
+		})
+	}
+
+	for _, input := range []string{"", "fr", "indonesian"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			if _, err := vo.NewLanguage(input); !errors.Is(err, vo.ErrLanguageInvalid) {
+				t.Errorf("Expected error '%v', but got '%v'", vo.ErrLanguageInvalid, err)
+			}
+		})
+	}
+}
+
+func TestLanguageFromSearchConfig(t *testing.T) {
+	language, err := vo.LanguageFromSearchConfig("english")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if language.String() != vo.LanguageEnglish {
+		t.Errorf("Expected code '%s', but got '%s'", vo.LanguageEnglish, language.String())
+	}
+
+	if _, err := vo.LanguageFromSearchConfig("simple"); !errors.Is(err, vo.ErrLanguageInvalid) {
+		t.Errorf("Expected error '%v', but got '%v'", vo.ErrLanguageInvalid, err)
+	}
+}
@@ -0,0 +1,151 @@
+package valueobjects
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Slug adalah bagian URL artikel, mis. "pemilu-2029-dimulai".
+type Slug struct {
+	value string
+}
+
+const MaxSlugLength = 100
+
+// ^[a-z0-9]+ - Dimulai dengan huruf kecil atau angka.
+// (-[a-z0-9]+)*$ - Dipisah tanda hubung tunggal, tidak boleh diakhiri tanda hubung.
+var slugRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+var slugSeparators = regexp.MustCompile(`-+`)
+
+var (
+	ErrSlugEmpty             = errors.New("slug must contain at least one letter or number")
+	ErrSlugTooLong           = errors.New("slug must be at most 100 characters")
+	ErrSlugInvalidCharacters = errors.New("slug can only contain lowercase letters, numbers, and single hyphens")
+)
+
+// transliterations memetakan huruf Latin beraksen ke padanan ASCII-nya.
+// Huruf kapital sudah diubah ke huruf kecil sebelum dicari di sini.
+var transliterations = buildTransliterations(map[string]string{
+	"a":  "àáâãäåāăą",
+	"c":  "çćĉċč",
+	"d":  "ďđð",
+	"e":  "èéêëēĕėęě",
+	"g":  "ĝğġģ",
+	"h":  "ĥħ",
+	"i":  "ìíîïĩīĭįı",
+	"j":  "ĵ",
+	"k":  "ķ",
+	"l":  "ĺļľŀł",
+	"n":  "ñńņňŉ",
+	"o":  "òóôõöøōŏő",
+	"r":  "ŕŗř",
+	"s":  "śŝşšș",
+	"t":  "ţťŧț",
+	"u":  "ùúûüũūŭůűų",
+	"w":  "ŵ",
+	"y":  "ýÿŷ",
+	"z":  "źżž",
+	"ae": "æ",
+	"oe": "œ",
+	"ss": "ß",
+	"th": "þ",
+})
+
+// slugWords adalah simbol yang lazim di judul berita berbahasa Indonesia
+// dan tetap punya arti di URL, mis. "Suku Bunga Naik 5%" → "suku-bunga-naik-5-persen".
+var slugWords = map[rune]string{
+	'&': "dan",
+	'%': "persen",
+}
+
+// NewSlug memvalidasi slug yang sudah jadi (mis. dari database).
+func NewSlug(value string) (*Slug, error) {
+	payload := strings.TrimSpace(value)
+
+	if payload == "" {
+		return nil, ErrSlugEmpty
+	}
+
+	if len(payload) > MaxSlugLength {
+		return nil, ErrSlugTooLong
+	}
+
+	if !slugRegex.MatchString(payload) {
+		return nil, ErrSlugInvalidCharacters
+	}
+
+	return &Slug{value: payload}, nil
+}
+
+// SlugFromText membuat slug dari teks Unicode bebas seperti judul berita.
+// Huruf beraksen ditransliterasi, apostrof dibuang ("Jum'at" → "jumat"),
+// dan emoji, tanda baca serta aksara non-Latin menjadi pemisah kata.
+// Slug yang terlalu panjang dipotong di batas kata.
+func SlugFromText(text string) (*Slug, error) {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			builder.WriteRune(r)
+		case isApostrophe(r), unicode.Is(unicode.Mn, r):
+			// Dibuang tanpa pemisah; combining mark adalah aksen yang terpisah dari hurufnya
+		default:
+			if ascii, ok := transliterations[r]; ok {
+				builder.WriteString(ascii)
+			} else if word, ok := slugWords[r]; ok {
+				builder.WriteString("-" + word + "-")
+			} else {
+				builder.WriteByte('-')
+			}
+		}
+	}
+
+	slug := strings.Trim(slugSeparators.ReplaceAllString(builder.String(), "-"), "-")
+	return NewSlug(truncateSlug(slug, MaxSlugLength))
+}
+
+// WithSuffix mengembalikan slug dengan akhiran angka, mis. "banjir-jakarta-2".
+// Slug dasar dipotong jika perlu supaya hasilnya tetap dalam MaxSlugLength.
+func (s *Slug) WithSuffix(n int) Slug {
+	suffix := "-" + strconv.Itoa(n)
+	return Slug{value: truncateSlug(s.value, MaxSlugLength-len(suffix)) + suffix}
+}
+
+func (s *Slug) String() string {
+	return s.value
+}
+
+func (s *Slug) Value() string {
+	return s.value
+}
+
+// truncateSlug memotong slug maksimal limit karakter, sebisa mungkin di batas kata.
+func truncateSlug(slug string, limit int) string {
+	if len(slug) <= limit {
+		return slug
+	}
+
+	cut := slug[:limit]
+	if i := strings.LastIndex(cut, "-"); i > 0 {
+		return cut[:i]
+	}
+	return cut
+}
+
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’' || r == '‘' || r == '`'
+}
+
+func buildTransliterations(groups map[string]string) map[rune]string {
+	table := make(map[rune]string)
+	for ascii, letters := range groups {
+		for _, r := range letters {
+			table[r] = ascii
+		}
+	}
+	return table
+}
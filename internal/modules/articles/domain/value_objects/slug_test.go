@@ -0,0 +1,84 @@
+package valueobjects_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+)
+
+func TestSlugFromText(t *testing.T) {
+	validCases := []struct {
+		text     string
+		expected string
+	}{
+		{"Pemilu 2029:  Hasil Hitung Cepat!", "pemilu-2029-hasil-hitung-cepat"},
+		{"Jokowi & Prabowo Bertemu di Istana", "jokowi-dan-prabowo-bertemu-di-istana"},
+		{"Suku Bunga BI Naik 0,25%", "suku-bunga-bi-naik-0-25-persen"},
+		{"Sholat Jum'at di Masjid Istiqlal", "sholat-jumat-di-masjid-istiqlal"},
+		{"Café Crème à São Paulo", "cafe-creme-a-sao-paulo"},
+		{"Straße in Łódź", "strasse-in-lodz"},
+		{"Timnas Menang 🎉🔥 Lawan Vietnam ⚽", "timnas-menang-lawan-vietnam"},
+		{"Cafe\u0301 Latte", "cafe-latte"},
+		{"東京 Olympics 2032", "olympics-2032"},
+	}
+
+	for _, tc := range validCases {
+		t.Run(tc.text, func(t *testing.T) {
+			slug, err := vo.SlugFromText(tc.text)
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if slug.String() != tc.expected {
+				t.Errorf("Expected slug '%s', but got '%s'", tc.expected, slug.String())
+			}
+		})
+	}
+
+	t.Run("should reject text without letters or numbers", func(t *testing.T) {
+		_, err := vo.SlugFromText("🎉🔥 !!!")
+		if !errors.Is(err, vo.ErrSlugEmpty) {
+			t.Errorf("Expected error ErrSlugEmpty, but got %v", err)
+		}
+	})
+
+	t.Run("should truncate long text at a word boundary", func(t *testing.T) {
+		slug, err := vo.SlugFromText(strings.Repeat("berita ", 30))
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if len(slug.String()) > vo.MaxSlugLength || strings.HasSuffix(slug.String(), "-") || !strings.HasSuffix(slug.String(), "berita") {
+			t.Errorf("Expected slug cut at a word boundary, but got '%s'", slug.String())
+		}
+	})
+}
+
+func TestSlugWithSuffix(t *testing.T) {
+	t.Run("should append a numeric suffix", func(t *testing.T) {
+		slug, _ := vo.SlugFromText("Banjir Jakarta")
+		suffixed := slug.WithSuffix(2)
+		if suffixed.String() != "banjir-jakarta-2" {
+			t.Errorf("Expected 'banjir-jakarta-2', but got '%s'", suffixed.String())
+		}
+	})
+
+	t.Run("should stay within the maximum length", func(t *testing.T) {
+		slug, _ := vo.SlugFromText(strings.Repeat("a", vo.MaxSlugLength))
+		suffixed := slug.WithSuffix(12)
+		if len(suffixed.String()) > vo.MaxSlugLength || !strings.HasSuffix(suffixed.String(), "-12") {
+			t.Errorf("Expected a truncated slug ending in '-12', but got '%s'", suffixed.String())
+		}
+	})
+}
+
+func TestNewSlug(t *testing.T) {
+	for _, value := range []string{"Pemilu-2029", "pemilu--2029", "-pemilu", "pemilu_2029"} {
+		t.Run("reject "+value, func(t *testing.T) {
+			_, err := vo.NewSlug(value)
+			if !errors.Is(err, vo.ErrSlugInvalidCharacters) {
+				t.Errorf("Expected error ErrSlugInvalidCharacters, but got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+type Title struct {
+	value string
+}
+
+const (
+	MinTitleLength = 5
+	MaxTitleLength = 200
+)
+
+var (
+	ErrTitleEmpty         = errors.New("title cannot be empty")
+	ErrTitleInvalidLength = errors.New("title must be between 5 and 200 characters")
+)
+
+func NewTitle(value string) (*Title, error) {
+	// ✅ Rapikan spasi ganda di tengah judul
+	payload := strings.Join(strings.Fields(value), " ")
+
+	if payload == "" {
+		return nil, ErrTitleEmpty
+	}
+
+	length := utf8.RuneCountInString(payload)
+	if length < MinTitleLength || length > MaxTitleLength {
+		return nil, ErrTitleInvalidLength
+	}
+
+	return &Title{value: payload}, nil
+}
+
+func (t *Title) String() string {
+	return t.value
+}
+
+func (t *Title) Value() string {
+	return t.value
+}
@@ -0,0 +1,68 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// WorkflowAction adalah aksi editorial yang memindahkan status artikel.
+type WorkflowAction struct {
+	value string
+}
+
+const (
+	ActionSubmit         = "submit"
+	ActionApprove        = "approve"
+	ActionRequestChanges = "request_changes"
+	ActionPublish        = "publish"
+	ActionArchive        = "archive"
+)
+
+// workflowTransitions: aksi -> status asal -> status tujuan.
+//
+//	draft -> in_review -> approved -> published -> archived
+//	in_review/approved --request_changes--> draft
+var workflowTransitions = map[string]map[string]string{
+	ActionSubmit:         {StatusDraft: StatusInReview},
+	ActionApprove:        {StatusInReview: StatusApproved},
+	ActionRequestChanges: {StatusInReview: StatusDraft, StatusApproved: StatusDraft},
+	ActionPublish:        {StatusApproved: StatusPublished},
+	ActionArchive:        {StatusPublished: StatusArchived},
+}
+
+var (
+	ErrWorkflowActionEmpty    = errors.New("action cannot be empty")
+	ErrWorkflowActionInvalid  = errors.New("action must be one of submit, approve, request_changes, publish, archive")
+	ErrInvalidStateTransition = errors.New("invalid state transition")
+)
+
+func NewWorkflowAction(value string) (*WorkflowAction, error) {
+	payload := strings.ToLower(strings.TrimSpace(value))
+
+	if payload == "" {
+		return nil, ErrWorkflowActionEmpty
+	}
+
+	if _, ok := workflowTransitions[payload]; !ok {
+		return nil, ErrWorkflowActionInvalid
+	}
+
+	return &WorkflowAction{value: payload}, nil
+}
+
+// Target mengembalikan status tujuan jika aksi ini sah dari status from.
+func (a *WorkflowAction) Target(from ArticleStatus) (ArticleStatus, error) {
+	to, ok := workflowTransitions[a.value][from.value]
+	if !ok {
+		return ArticleStatus{}, ErrInvalidStateTransition
+	}
+	return ArticleStatus{value: to}, nil
+}
+
+func (a *WorkflowAction) String() string {
+	return a.value
+}
+
+func (a *WorkflowAction) Value() string {
+	return a.value
+}
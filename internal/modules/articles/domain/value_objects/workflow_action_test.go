@@ -0,0 +1,74 @@
+package valueobjects_test
+
+import (
+	"errors"
+	"testing"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+)
+
+func status(t *testing.T, value string) vo.ArticleStatus {
+	t.Helper()
+	s, err := vo.NewArticleStatus(value)
+	if err != nil {
+		t.Fatalf("Error creating status: %v", err)
+	}
+	return *s
+}
+
+func TestWorkflowActionTarget(t *testing.T) {
+	validCases := []struct {
+		action string
+		from   string
+		to     string
+	}{
+		{vo.ActionSubmit, vo.StatusDraft, vo.StatusInReview},
+		{vo.ActionApprove, vo.StatusInReview, vo.StatusApproved},
+		{vo.ActionRequestChanges, vo.StatusInReview, vo.StatusDraft},
+		{vo.ActionRequestChanges, vo.StatusApproved, vo.StatusDraft},
+		{vo.ActionPublish, vo.StatusApproved, vo.StatusPublished},
+		{vo.ActionArchive, vo.StatusPublished, vo.StatusArchived},
+	}
+
+	for _, tc := range validCases {
+		t.Run(tc.action+" from "+tc.from, func(t *testing.T) {
+			action, _ := vo.NewWorkflowAction(tc.action)
+			to, err := action.Target(status(t, tc.from))
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if to.String() != tc.to {
+				t.Errorf("Expected target '%s', but got '%s'", tc.to, to.String())
+			}
+		})
+	}
+
+	invalidCases := []struct {
+		action string
+		from   string
+	}{
+		{vo.ActionPublish, vo.StatusDraft},
+		{vo.ActionPublish, vo.StatusInReview},
+		{vo.ActionApprove, vo.StatusDraft},
+		{vo.ActionSubmit, vo.StatusPublished},
+		{vo.ActionArchive, vo.StatusDraft},
+		{vo.ActionRequestChanges, vo.StatusPublished},
+	}
+
+	for _, tc := range invalidCases {
+		t.Run("reject "+tc.action+" from "+tc.from, func(t *testing.T) {
+			action, _ := vo.NewWorkflowAction(tc.action)
+			_, err := action.Target(status(t, tc.from))
+			if !errors.Is(err, vo.ErrInvalidStateTransition) {
+				t.Errorf("Expected error ErrInvalidStateTransition, but got %v", err)
+			}
+		})
+	}
+
+	t.Run("should return an error for unknown action", func(t *testing.T) {
+		_, err := vo.NewWorkflowAction("delete")
+		if !errors.Is(err, vo.ErrWorkflowActionInvalid) {
+			t.Errorf("Expected error ErrWorkflowActionInvalid, but got %v", err)
+		}
+	})
+}
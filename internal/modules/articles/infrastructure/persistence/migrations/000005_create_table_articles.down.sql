@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_articles_updated_at ON articles;
+DROP INDEX IF EXISTS idx_articles_status_published_at;
+DROP INDEX IF EXISTS idx_articles_author_id;
+DROP TABLE IF EXISTS articles;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS articles (
+    id VARCHAR(255) PRIMARY KEY,
+    title VARCHAR(200) NOT NULL,
+    slug VARCHAR(255) NOT NULL UNIQUE,
+    body TEXT NOT NULL,
+    excerpt VARCHAR(500) NOT NULL DEFAULT '',
+    author_id VARCHAR(255) NOT NULL REFERENCES users(id),
+    status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    published_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index untuk performance
+CREATE INDEX IF NOT EXISTS idx_articles_author_id ON articles(author_id);
+CREATE INDEX IF NOT EXISTS idx_articles_status_published_at ON articles(status, published_at DESC);
+
+-- Trigger untuk auto-update updated_at (function dibuat oleh migration users)
+CREATE TRIGGER update_articles_updated_at
+    BEFORE UPDATE ON articles
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS article_transitions (
+    id VARCHAR(255) PRIMARY KEY,
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    action VARCHAR(30) NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL REFERENCES users(id),
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index untuk riwayat workflow per artikel
+CREATE INDEX IF NOT EXISTS idx_article_transitions_article_id ON article_transitions(article_id, created_at);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_articles_unpublish_at;
+DROP INDEX IF EXISTS idx_articles_publish_at;
+
+ALTER TABLE articles
+    DROP COLUMN IF EXISTS scheduled_by,
+    DROP COLUMN IF EXISTS unpublish_at,
+    DROP COLUMN IF EXISTS publish_at;
@@ -0,0 +1,8 @@
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS scheduled_by VARCHAR(255) REFERENCES users(id);
+
+-- Partial index untuk polling scheduler (hanya baris yang punya jadwal)
+CREATE INDEX IF NOT EXISTS idx_articles_publish_at ON articles(publish_at) WHERE publish_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_articles_unpublish_at ON articles(unpublish_at) WHERE unpublish_at IS NOT NULL;
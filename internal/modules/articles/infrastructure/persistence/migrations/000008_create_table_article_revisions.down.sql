@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS prevent_article_revisions_change ON article_revisions;
+DROP FUNCTION IF EXISTS prevent_article_revision_change();
+DROP TABLE IF EXISTS article_revisions;
@@ -0,0 +1,26 @@
+-- ✅ RESTRICT: riwayat revisi tidak ikut terhapus bersama artikel.
+-- Artikel dihapus secara soft delete (lihat migration add_soft_delete_to_articles)
+CREATE TABLE IF NOT EXISTS article_revisions (
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE RESTRICT,
+    revision_number INTEGER NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL,
+    excerpt VARCHAR(500) NOT NULL DEFAULT '',
+    editor_id VARCHAR(255) NOT NULL REFERENCES users(id),
+    change_note VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (article_id, revision_number)
+);
+
+-- Revisi bersifat immutable: tolak UPDATE dan DELETE agar riwayat tidak bisa diubah
+CREATE OR REPLACE FUNCTION prevent_article_revision_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'article revisions are immutable';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER prevent_article_revisions_change
+    BEFORE UPDATE OR DELETE ON article_revisions
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_article_revision_change();
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_articles_category_id;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS category_id;
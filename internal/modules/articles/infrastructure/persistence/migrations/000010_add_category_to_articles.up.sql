@@ -0,0 +1,4 @@
+-- Artikel dikelompokkan ke satu section (kategori dibuat oleh migration categories)
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS category_id VARCHAR(255) REFERENCES categories(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_articles_category_id ON articles(category_id);
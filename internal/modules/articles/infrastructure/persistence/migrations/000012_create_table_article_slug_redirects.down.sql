@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_article_slug_redirects_article_id;
+DROP TABLE IF EXISTS article_slug_redirects;
@@ -0,0 +1,8 @@
+-- Slug lama artikel yang pernah dipublikasikan; GET slug lama dijawab 301 ke slug baru
+CREATE TABLE IF NOT EXISTS article_slug_redirects (
+    old_slug VARCHAR(100) PRIMARY KEY,
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_slug_redirects_article_id ON article_slug_redirects(article_id);
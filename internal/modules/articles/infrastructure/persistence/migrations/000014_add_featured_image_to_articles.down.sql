@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_articles_featured_image_id;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS featured_image_id;
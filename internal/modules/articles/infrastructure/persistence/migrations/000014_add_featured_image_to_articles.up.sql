@@ -0,0 +1,4 @@
+-- Gambar utama artikel (media dibuat oleh migration media)
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS featured_image_id VARCHAR(255) REFERENCES media(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_articles_featured_image_id ON articles(featured_image_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_articles_search_vector;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE articles DROP COLUMN IF EXISTS language;
@@ -0,0 +1,13 @@
+-- Bahasa artikel menentukan konfigurasi text search (stemming & stop word).
+-- Tipe regconfig dipakai langsung supaya to_tsvector di generated column immutable.
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS language regconfig NOT NULL DEFAULT 'indonesian'
+    CHECK (language IN ('indonesian'::regconfig, 'english'::regconfig));
+
+-- Judul berbobot A, ringkasan B, isi C
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector(language, coalesce(title, '')), 'A') ||
+    setweight(to_tsvector(language, coalesce(excerpt, '')), 'B') ||
+    setweight(to_tsvector(language, coalesce(body, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_articles_search_vector ON articles USING GIN (search_vector);
@@ -0,0 +1,3 @@
+-- Artikel tidak pernah dihapus permanen karena revisinya immutable;
+-- deleted_at menyembunyikan artikel dari CMS dan halaman publik
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,917 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type ArticleRepositoryPostgres struct {
+	db     *sql.DB
+	outbox shared.EventOutbox
+}
+
+func NewArticleRepositoryPostgres(db *sql.DB, outbox shared.EventOutbox) repos.ArticleRepository {
+	return &ArticleRepositoryPostgres{db: db, outbox: outbox}
+}
+
+const articleColumns = "id, title, slug, body, excerpt, language, author_id, category_id, featured_image_id, status, published_at, publish_at, unpublish_at, scheduled_by, created_at, updated_at"
+
+const revisionColumns = "article_id, revision_number, title, body, excerpt, editor_id, change_note, created_at"
+
+// rowScanner dipenuhi oleh *sql.Row dan *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (r *ArticleRepositoryPostgres) Save(ctx context.Context, article *entities.Article, revision *entities.ArticleRevision) (*entities.Article, error) {
+	query := `
+		INSERT INTO articles (id, title, slug, body, excerpt, language, author_id, category_id, featured_image_id, status, published_at, publish_at, unpublish_at, scheduled_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		RETURNING created_at, updated_at
+	`
+	var createdAt, updatedAt time.Time
+
+	// ✅ Artikel dan revisi pertamanya disimpan dalam satu transaksi
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(
+		ctx,
+		query,
+		article.ID,
+		article.Title.String(),
+		article.Slug,
+		article.Body,
+		article.Excerpt,
+		article.Language.SearchConfig(),
+		article.AuthorID,
+		nullString(article.CategoryID),
+		nullString(article.FeaturedImageID),
+		article.Status.String(),
+		article.PublishedAt,
+		article.PublishAt,
+		article.UnpublishAt,
+		nullString(article.ScheduledBy),
+		article.CreatedAt,
+		article.UpdatedAt,
+	).Scan(&createdAt, &updatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := insertRevision(ctx, tx, revision); err != nil {
+		return nil, err
+	}
+
+	if err := replaceTags(ctx, tx, article); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	article.CreatedAt = createdAt
+	article.UpdatedAt = updatedAt
+
+	return article, nil
+}
+
+func (r *ArticleRepositoryPostgres) UpdateSchedule(ctx context.Context, article *entities.Article) (bool, error) {
+	// ✅ Optimistic check: jadwal divalidasi terhadap article.Status, jadi hanya
+	// disimpan jika status di database belum diubah transisi atau worker jadwal
+	result, err := shared.Executor(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE articles
+		SET publish_at = $2, unpublish_at = $3, scheduled_by = $4, updated_at = $5
+		WHERE id = $1 AND status = $6`,
+		article.ID,
+		article.PublishAt,
+		article.UnpublishAt,
+		nullString(article.ScheduledBy),
+		article.UpdatedAt,
+		article.Status.String(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *ArticleRepositoryPostgres) Revise(ctx context.Context, article *entities.Article, revision *entities.ArticleRevision) (*entities.Article, error) {
+	// ✅ Perubahan konten dan revisinya disimpan dalam satu transaksi.
+	// UPDATE mengunci baris artikel sehingga nomor revisi tidak bisa bentrok.
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := updateArticle(ctx, tx, article); err != nil {
+		return nil, err
+	}
+
+	if err := insertRevision(ctx, tx, revision); err != nil {
+		return nil, err
+	}
+
+	if article.PreviousSlug != "" {
+		if err := saveSlugRedirect(ctx, tx, article); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return article, nil
+}
+
+func (r *ArticleRepositoryPostgres) FindByID(ctx context.Context, id string) (*entities.Article, error) {
+	query := "SELECT " + articleColumns + " FROM articles WHERE id = $1 AND deleted_at IS NULL"
+
+	article, err := scanArticle(shared.Executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil // Artikel tidak ditemukan
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadTags(ctx, article); err != nil {
+		return nil, err
+	}
+	return article, nil
+}
+
+func (r *ArticleRepositoryPostgres) FindBySlug(ctx context.Context, slug string) (*entities.Article, error) {
+	query := "SELECT " + articleColumns + " FROM articles WHERE slug = $1 AND deleted_at IS NULL"
+
+	article, err := scanArticle(shared.Executor(ctx, r.db).QueryRowContext(ctx, query, slug))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadTags(ctx, article); err != nil {
+		return nil, err
+	}
+	return article, nil
+}
+
+func (r *ArticleRepositoryPostgres) FindAll(ctx context.Context, filter repos.ArticleFilter) ([]*entities.Article, int, error) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []interface{}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	conditions = append(conditions, relationConditions(&args, filter.AuthorID, filter.CategoryID, filter.TagSlug)...)
+
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM articles"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query := fmt.Sprintf(
+		"SELECT %s FROM articles%s ORDER BY COALESCE(published_at, created_at) DESC LIMIT $%d OFFSET $%d",
+		articleColumns, where, len(args)-1, len(args),
+	)
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var articles []*entities.Article
+	for rows.Next() {
+		article, err := scanArticle(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		articles = append(articles, article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.loadTags(ctx, articles...); err != nil {
+		return nil, 0, err
+	}
+
+	return articles, total, nil
+}
+
+func (r *ArticleRepositoryPostgres) Search(ctx context.Context, filter repos.SearchFilter) ([]*repos.SearchResult, int, error) {
+	args := []interface{}{filter.Query, vo.StatusPublished}
+
+	// ✅ Query diparse dengan regconfig filter, atau regconfig masing-masing artikel
+	config := "language"
+	conditions := []string{"status = $2", "deleted_at IS NULL"}
+	if filter.Language != "" {
+		args = append(args, filter.Language)
+		config = fmt.Sprintf("$%d::regconfig", len(args))
+		conditions = append(conditions, "language = "+config)
+	}
+	tsquery := fmt.Sprintf("websearch_to_tsquery(%s, $1)", config)
+	conditions = append(conditions, "search_vector @@ "+tsquery)
+
+	conditions = append(conditions, relationConditions(&args, filter.AuthorID, filter.CategoryID, filter.TagSlug)...)
+	if filter.PublishedFrom != nil {
+		args = append(args, *filter.PublishedFrom)
+		conditions = append(conditions, fmt.Sprintf("published_at >= $%d", len(args)))
+	}
+	if filter.PublishedTo != nil {
+		args = append(args, *filter.PublishedTo)
+		conditions = append(conditions, fmt.Sprintf("published_at < $%d", len(args)))
+	}
+
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM articles"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// ts_headline mahal, jadi hanya dihitung untuk satu halaman hasil
+	args = append(args, filter.Limit, filter.Offset, titleHeadlineOptions, bodyHeadlineOptions)
+	n := len(args)
+	query := fmt.Sprintf(`
+		WITH matches AS (
+			SELECT id, ts_rank_cd(search_vector, %[1]s) AS rank, %[1]s AS query
+			FROM articles%[2]s
+			ORDER BY rank DESC, published_at DESC
+			LIMIT $%[3]d OFFSET $%[4]d
+		)
+		SELECT %[5]s, m.rank,
+			ts_headline(language, title, m.query, $%[6]d),
+			ts_headline(language, body, m.query, $%[7]d)
+		FROM matches m JOIN articles USING (id)
+		ORDER BY m.rank DESC, published_at DESC
+	`, tsquery, where, n-3, n-2, articleColumns, n-1, n)
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var results []*repos.SearchResult
+	var articles []*entities.Article
+	for rows.Next() {
+		var result repos.SearchResult
+		article, err := scanArticle(rowWithExtras{rows, []interface{}{&result.Rank, &result.TitleHighlight, &result.BodyHighlight}})
+		if err != nil {
+			return nil, 0, err
+		}
+		result.Article = article
+		results = append(results, &result)
+		articles = append(articles, article)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.loadTags(ctx, articles...); err != nil {
+		return nil, 0, err
+	}
+
+	return results, total, nil
+}
+
+func (r *ArticleRepositoryPostgres) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM articles WHERE slug = $1)
+			OR EXISTS (SELECT 1 FROM article_slug_redirects WHERE old_slug = $1)
+	`
+
+	var exists bool
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, slug).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *ArticleRepositoryPostgres) FindRedirect(ctx context.Context, oldSlug string) (string, error) {
+	query := "SELECT article_id FROM article_slug_redirects WHERE old_slug = $1"
+
+	var articleID string
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, oldSlug).Scan(&articleID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return articleID, err
+}
+
+func (r *ArticleRepositoryPostgres) CategoryExists(ctx context.Context, categoryID string) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM categories WHERE id = $1)"
+
+	var exists bool
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, categoryID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *ArticleRepositoryPostgres) ImageExists(ctx context.Context, mediaID string) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM media WHERE id = $1 AND content_type LIKE 'image/%')"
+
+	var exists bool
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, mediaID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *ArticleRepositoryPostgres) SetTags(ctx context.Context, article *entities.Article) error {
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := replaceTags(ctx, tx, article); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE articles SET updated_at = $2 WHERE id = $1", article.ID, article.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// loadTags mengisi Tags untuk sekumpulan artikel dengan satu query.
+func (r *ArticleRepositoryPostgres) loadTags(ctx context.Context, articles ...*entities.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entities.Article, len(articles))
+	ids := make([]string, 0, len(articles))
+	for _, article := range articles {
+		byID[article.ID] = article
+		ids = append(ids, article.ID)
+	}
+
+	query := `
+		SELECT at.article_id, t.id, t.name, t.slug
+		FROM article_tags at
+		JOIN tags t ON t.id = at.tag_id
+		WHERE at.article_id = ANY($1)
+		ORDER BY t.name
+	`
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var articleID string
+		var tag entities.ArticleTag
+		if err := rows.Scan(&articleID, &tag.ID, &tag.Name, &tag.Slug); err != nil {
+			return err
+		}
+		article := byID[articleID]
+		article.Tags = append(article.Tags, tag)
+	}
+
+	return rows.Err()
+}
+
+// Delete melakukan soft delete: revisi artikel immutable dan tidak boleh
+// ikut terhapus, jadi barisnya hanya ditandai deleted_at.
+func (r *ArticleRepositoryPostgres) Delete(ctx context.Context, id string) error {
+	query := "UPDATE articles SET deleted_at = NOW(), updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL"
+	_, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, id)
+	return err
+}
+
+func (r *ArticleRepositoryPostgres) SaveTransition(ctx context.Context, article *entities.Article, transition *entities.ArticleTransition) (bool, error) {
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	applied, err := r.applyTransition(ctx, tx, article, transition)
+	if err != nil || !applied {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *ArticleRepositoryPostgres) FindTransitions(ctx context.Context, articleID string) ([]*entities.ArticleTransition, error) {
+	query := `
+		SELECT id, article_id, action, from_status, to_status, actor_id, comment, created_at
+		FROM article_transitions
+		WHERE article_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, articleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []*entities.ArticleTransition
+	for rows.Next() {
+		var transition entities.ArticleTransition
+		if err := rows.Scan(
+			&transition.ID,
+			&transition.ArticleID,
+			&transition.Action,
+			&transition.FromStatus,
+			&transition.ToStatus,
+			&transition.ActorID,
+			&transition.Comment,
+			&transition.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		transitions = append(transitions, &transition)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transitions, nil
+}
+
+func (r *ArticleRepositoryPostgres) ApplyDueSchedules(ctx context.Context, now time.Time, limit int, apply func(article *entities.Article) *entities.ArticleTransition) (int, error) {
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// ✅ SKIP LOCKED: baris yang sedang diproses replika lain dilewati, bukan ditunggu
+	query := "SELECT " + articleColumns + ` FROM articles
+		WHERE ((status = $1 AND publish_at <= $3)
+		   OR (status = $2 AND unpublish_at <= $3))
+		  AND deleted_at IS NULL
+		ORDER BY COALESCE(publish_at, unpublish_at)
+		LIMIT $4
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, query, vo.StatusApproved, vo.StatusPublished, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var articles []*entities.Article
+	for rows.Next() {
+		article, err := scanArticle(rows)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		articles = append(articles, article)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, article := range articles {
+		transition := apply(article)
+		if transition == nil {
+			continue
+		}
+
+		ok, err := r.applyTransition(ctx, tx, article, transition)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			applied++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return applied, nil
+}
+
+func (r *ArticleRepositoryPostgres) FindRevisions(ctx context.Context, articleID string) ([]*entities.ArticleRevision, error) {
+	query := "SELECT " + revisionColumns + " FROM article_revisions WHERE article_id = $1 ORDER BY revision_number DESC"
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, articleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*entities.ArticleRevision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (r *ArticleRepositoryPostgres) FindRevision(ctx context.Context, articleID string, number int) (*entities.ArticleRevision, error) {
+	query := "SELECT " + revisionColumns + " FROM article_revisions WHERE article_id = $1 AND revision_number = $2"
+
+	revision, err := scanRevision(shared.Executor(ctx, r.db).QueryRowContext(ctx, query, articleID, number))
+	if err == sql.ErrNoRows {
+		return nil, nil // Revisi tidak ditemukan
+	}
+	return revision, err
+}
+
+// insertRevision menyimpan revisi dengan nomor berikutnya untuk artikelnya.
+func insertRevision(ctx context.Context, tx shared.DBTX, revision *entities.ArticleRevision) error {
+	query := `
+		INSERT INTO article_revisions (article_id, revision_number, title, body, excerpt, editor_id, change_note, created_at)
+		SELECT $1, COALESCE(MAX(revision_number), 0) + 1, $2, $3, $4, $5, $6, $7
+		FROM article_revisions
+		WHERE article_id = $1
+		RETURNING revision_number
+	`
+
+	return tx.QueryRowContext(
+		ctx,
+		query,
+		revision.ArticleID,
+		revision.Title,
+		revision.Body,
+		revision.Excerpt,
+		revision.EditorID,
+		revision.ChangeNote,
+		revision.CreatedAt,
+	).Scan(&revision.Number)
+}
+
+// saveSlugRedirect mencatat article.PreviousSlug sebagai redirect ke artikel
+// ini. Redirect untuk slug yang kini dipakai lagi oleh artikel dihapus.
+func saveSlugRedirect(ctx context.Context, tx shared.DBTX, article *entities.Article) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM article_slug_redirects WHERE old_slug = $1", article.Slug); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO article_slug_redirects (old_slug, article_id) VALUES ($1, $2)
+		ON CONFLICT (old_slug) DO UPDATE SET article_id = EXCLUDED.article_id`,
+		article.PreviousSlug,
+		article.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	article.PreviousSlug = ""
+	return nil
+}
+
+// replaceTags mengganti link tag artikel di dalam tx. Tag yang belum ada
+// dibuat memakai ID dari article.Tags.
+func replaceTags(ctx context.Context, tx shared.DBTX, article *entities.Article) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM article_tags WHERE article_id = $1", article.ID); err != nil {
+		return err
+	}
+
+	linked := make(map[string]bool, len(article.Tags))
+	tags := make([]entities.ArticleTag, 0, len(article.Tags))
+	for _, tag := range article.Tags {
+		if err := resolveTag(ctx, tx, &tag); err != nil {
+			return err
+		}
+		// Dua ejaan bisa berujung di tag yang sama lewat alias
+		if linked[tag.ID] {
+			continue
+		}
+		linked[tag.ID] = true
+
+		_, err := tx.ExecContext(ctx, "INSERT INTO article_tags (article_id, tag_id) VALUES ($1, $2)", article.ID, tag.ID)
+		if err != nil {
+			return err
+		}
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+	article.Tags = tags
+	return nil
+}
+
+// resolveTag mencari tag berdasarkan slug atau alias, lalu membuatnya jika
+// belum ada. tag diisi ulang dengan data tag yang tersimpan.
+func resolveTag(ctx context.Context, tx shared.DBTX, tag *entities.ArticleTag) error {
+	query := `
+		SELECT id, name, slug FROM tags WHERE slug = $1
+		UNION ALL
+		SELECT t.id, t.name, t.slug FROM tag_aliases a JOIN tags t ON t.id = a.tag_id WHERE a.slug = $1
+		LIMIT 1
+	`
+
+	err := tx.QueryRowContext(ctx, query, tag.Slug).Scan(&tag.ID, &tag.Name, &tag.Slug)
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	// ✅ ON CONFLICT: reporter lain bisa membuat tag yang sama secara bersamaan
+	return tx.QueryRowContext(
+		ctx,
+		`INSERT INTO tags (id, name, slug) VALUES ($1, $2, $3)
+		ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug
+		RETURNING id, name, slug`,
+		tag.ID,
+		tag.Name,
+		tag.Slug,
+	).Scan(&tag.ID, &tag.Name, &tag.Slug)
+}
+
+// queryRower dipenuhi oleh *sql.DB dan *sql.Tx.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// updateArticle menyimpan kolom konten artikel. Status, published_at dan
+// jadwal sengaja tidak ditulis: status hanya diubah applyTransition dan jadwal
+// hanya diubah UpdateSchedule, keduanya dijaga optimistic check, sehingga edit
+// yang membaca salinan lama tidak bisa menimpa perubahan di antaranya. Nilai
+// terbaru dari database dibaca ulang lewat RETURNING.
+func updateArticle(ctx context.Context, db queryRower, article *entities.Article) error {
+	query := `
+		UPDATE articles
+		SET title = $2, slug = $3, body = $4, excerpt = $5,
+			category_id = $6, featured_image_id = $7, language = $8, updated_at = $9
+		WHERE id = $1
+		RETURNING updated_at, status, published_at, publish_at, unpublish_at, scheduled_by
+	`
+
+	var status string
+	var scheduledBy sql.NullString
+	err := db.QueryRowContext(
+		ctx,
+		query,
+		article.ID,
+		article.Title.String(),
+		article.Slug,
+		article.Body,
+		article.Excerpt,
+		nullString(article.CategoryID),
+		nullString(article.FeaturedImageID),
+		article.Language.SearchConfig(),
+		time.Now(),
+	).Scan(&article.UpdatedAt, &status, &article.PublishedAt, &article.PublishAt, &article.UnpublishAt, &scheduledBy)
+	if err != nil {
+		return err
+	}
+
+	statusVO, err := vo.NewArticleStatus(status)
+	if err != nil {
+		return err
+	}
+	article.Status = *statusVO
+	article.ScheduledBy = scheduledBy.String
+	return nil
+}
+
+// applyTransition menyimpan status baru beserta catatan transisinya di dalam tx.
+// Mengembalikan false jika status di database sudah bukan transition.FromStatus.
+func (r *ArticleRepositoryPostgres) applyTransition(ctx context.Context, tx shared.DBTX, article *entities.Article, transition *entities.ArticleTransition) (bool, error) {
+	// ✅ Optimistic check: hanya update jika status belum diubah request lain
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE articles
+		SET status = $2, published_at = $3, publish_at = $4, unpublish_at = $5, updated_at = $6
+		WHERE id = $1 AND status = $7`,
+		article.ID,
+		article.Status.String(),
+		article.PublishedAt,
+		article.PublishAt,
+		article.UnpublishAt,
+		article.UpdatedAt,
+		transition.FromStatus,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO article_transitions (id, article_id, action, from_status, to_status, actor_id, comment, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		transition.ID,
+		transition.ArticleID,
+		transition.Action,
+		transition.FromStatus,
+		transition.ToStatus,
+		transition.ActorID,
+		transition.Comment,
+		transition.CreatedAt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	// ✅ Event publish/unpublish ikut ter-commit bersama transisinya
+	if err := r.outbox.Append(ctx, tx, article.PullEvents()); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// relationConditions membuat kondisi WHERE untuk filter penulis, section dan
+// tag, dipakai bersama oleh FindAll dan Search.
+func relationConditions(args *[]interface{}, authorID, categoryID, tagSlug string) []string {
+	var conditions []string
+
+	if authorID != "" {
+		*args = append(*args, authorID)
+		conditions = append(conditions, fmt.Sprintf("author_id = $%d", len(*args)))
+	}
+	if categoryID != "" {
+		// ✅ Materialized path: seluruh kategori yang path-nya diawali path kategori filter
+		*args = append(*args, categoryID)
+		conditions = append(conditions, fmt.Sprintf(
+			"category_id IN (SELECT c.id FROM categories c JOIN categories root ON c.path LIKE root.path || '%%' WHERE root.id = $%d)",
+			len(*args),
+		))
+	}
+	if tagSlug != "" {
+		// Slug lama hasil merge tetap menemukan artikel di tag tujuannya
+		*args = append(*args, tagSlug)
+		conditions = append(conditions, fmt.Sprintf(
+			"id IN (SELECT at.article_id FROM article_tags at JOIN tags t ON t.id = at.tag_id WHERE t.slug = $%[1]d OR t.id = (SELECT tag_id FROM tag_aliases WHERE slug = $%[1]d))",
+			len(*args),
+		))
+	}
+
+	return conditions
+}
+
+// Opsi ts_headline: judul disorot utuh, isi dipotong menjadi dua cuplikan.
+var (
+	headlineSelectors    = fmt.Sprintf(`StartSel="%s", StopSel="%s"`, repos.HighlightStart, repos.HighlightStop)
+	titleHeadlineOptions = headlineSelectors + ", HighlightAll=true"
+	bodyHeadlineOptions  = headlineSelectors + ", MaxFragments=2, MaxWords=35, MinWords=15"
+)
+
+// rowWithExtras membaca kolom tambahan setelah articleColumns pada baris yang sama.
+type rowWithExtras struct {
+	row    rowScanner
+	extras []interface{}
+}
+
+func (r rowWithExtras) Scan(dest ...interface{}) error {
+	return r.row.Scan(append(dest, r.extras...)...)
+}
+
+// nullString menyimpan string kosong sebagai NULL (untuk kolom foreign key opsional).
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// scanArticle membaca satu baris articleColumns dan membuat ulang value object-nya.
+func scanArticle(row rowScanner) (*entities.Article, error) {
+	var article entities.Article
+	var title, language, status string
+	var publishedAt, publishAt, unpublishAt sql.NullTime
+	var categoryID, featuredImageID, scheduledBy sql.NullString
+
+	err := row.Scan(
+		&article.ID,
+		&title,
+		&article.Slug,
+		&article.Body,
+		&article.Excerpt,
+		&language,
+		&article.AuthorID,
+		&categoryID,
+		&featuredImageID,
+		&status,
+		&publishedAt,
+		&publishAt,
+		&unpublishAt,
+		&scheduledBy,
+		&article.CreatedAt,
+		&article.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// ✅ Recreate value objects dari data yang diambil
+	titleVO, err := vo.NewTitle(title)
+	if err != nil {
+		return nil, err
+	}
+	article.Title = *titleVO
+
+	statusVO, err := vo.NewArticleStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	article.Status = *statusVO
+
+	languageVO, err := vo.LanguageFromSearchConfig(language)
+	if err != nil {
+		return nil, err
+	}
+	article.Language = *languageVO
+
+	if publishedAt.Valid {
+		article.PublishedAt = &publishedAt.Time
+	}
+	if publishAt.Valid {
+		article.PublishAt = &publishAt.Time
+	}
+	if unpublishAt.Valid {
+		article.UnpublishAt = &unpublishAt.Time
+	}
+	article.CategoryID = categoryID.String
+	article.FeaturedImageID = featuredImageID.String
+	article.ScheduledBy = scheduledBy.String
+
+	return &article, nil
+}
+
+func scanRevision(row rowScanner) (*entities.ArticleRevision, error) {
+	var revision entities.ArticleRevision
+
+	err := row.Scan(
+		&revision.ArticleID,
+		&revision.Number,
+		&revision.Title,
+		&revision.Body,
+		&revision.Excerpt,
+		&revision.EditorID,
+		&revision.ChangeNote,
+		&revision.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revision, nil
+}
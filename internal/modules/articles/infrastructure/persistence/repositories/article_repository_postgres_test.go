@@ -0,0 +1,198 @@
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	repositories "github.com/jokosaputro95/cms-news-api/internal/modules/articles/infrastructure/persistence/repositories"
+	outboxrepos "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/infrastructure/persistence/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+	"github.com/jokosaputro95/cms-news-api/internal/shared/testdb"
+)
+
+// setupArticleRepositoryTest menyimpan satu artikel draft milik user baru.
+func setupArticleRepositoryTest(t *testing.T) (*sql.DB, repos.ArticleRepository, *entities.Article) {
+	t.Helper()
+	db := testdb.Open(t)
+	uuidGen := &shared.DefaultUUIDGenerator{}
+
+	authorID := uuidGen.NewUUID()
+	testdb.CreateUser(t, db, authorID)
+
+	titleVO, _ := vo.NewTitle("Pemilu 2029 dimulai")
+	article, err := entities.NewArticle(uuidGen.NewUUID(), *titleVO, "pemilu-"+authorID, "Isi berita.", "", authorID)
+	if err != nil {
+		t.Fatalf("Error creating article: %v", err)
+	}
+
+	articleRepo := repositories.NewArticleRepositoryPostgres(db, outboxrepos.NewOutboxWriterPostgres())
+	if _, err := articleRepo.Save(context.Background(), article, entities.NewArticleRevision(article, authorID, "")); err != nil {
+		t.Fatalf("Error saving article: %v", err)
+	}
+	// Revisi immutable, jadi artikel (dan penulisnya) sengaja tertinggal di database test
+	t.Cleanup(func() {
+		db.Exec("DELETE FROM outbox WHERE payload->>'article_id' = $1", article.ID)
+	})
+
+	return db, articleRepo, article
+}
+
+// transition menjalankan aksi workflow pada salinan artikel terbaru dari database.
+func transition(t *testing.T, articleRepo repos.ArticleRepository, articleID, action string) *entities.Article {
+	t.Helper()
+	ctx := context.Background()
+
+	article, err := articleRepo.FindByID(ctx, articleID)
+	if err != nil || article == nil {
+		t.Fatalf("Error loading article: %v", err)
+	}
+
+	workflowAction, _ := vo.NewWorkflowAction(action)
+	from, err := article.ApplyAction(*workflowAction)
+	if err != nil {
+		t.Fatalf("Error applying %s: %v", action, err)
+	}
+
+	record := entities.NewArticleTransition((&shared.DefaultUUIDGenerator{}).NewUUID(), article.ID, action, from.String(), article.Status.String(), article.AuthorID, "")
+	applied, err := articleRepo.SaveTransition(ctx, article, record)
+	if err != nil || !applied {
+		t.Fatalf("Expected %s to be applied, but got %v (err %v)", action, applied, err)
+	}
+	return article
+}
+
+func TestArticleRepositoryReviseKeepsConcurrentTransition(t *testing.T) {
+	t.Run("should not revert a transition committed after the edit was loaded", func(t *testing.T) {
+		_, articleRepo, article := setupArticleRepositoryTest(t)
+		ctx := context.Background()
+
+		// 1. Editor membuka artikel saat masih draft
+		stale, err := articleRepo.FindByID(ctx, article.ID)
+		if err != nil {
+			t.Fatalf("Error loading article: %v", err)
+		}
+
+		// 2. Sementara itu artikel disubmit dan di-approve
+		transition(t, articleRepo, article.ID, vo.ActionSubmit)
+		transition(t, articleRepo, article.ID, vo.ActionApprove)
+
+		// 3. Edit dari salinan lama selesai belakangan
+		titleVO, _ := vo.NewTitle("Pemilu 2029 diperbarui")
+		if err := stale.Revise(*titleVO, stale.Body, stale.Excerpt); err != nil {
+			t.Fatalf("Error revising article: %v", err)
+		}
+		saved, err := articleRepo.Revise(ctx, stale, entities.NewArticleRevision(stale, stale.AuthorID, ""))
+		if err != nil {
+			t.Fatalf("Error saving revision: %v", err)
+		}
+
+		reloaded, err := articleRepo.FindByID(ctx, article.ID)
+		if err != nil {
+			t.Fatalf("Error reloading article: %v", err)
+		}
+		if !reloaded.Status.Is(vo.StatusApproved) {
+			t.Errorf("Expected status to stay approved, but got %s", reloaded.Status.String())
+		}
+		if !saved.Status.Is(vo.StatusApproved) {
+			t.Errorf("Expected Revise to return the stored status approved, but got %s", saved.Status.String())
+		}
+		if reloaded.Title.String() != "Pemilu 2029 diperbarui" {
+			t.Errorf("Expected the edit to be saved, but got title %q", reloaded.Title.String())
+		}
+	})
+}
+
+func TestArticleRepositoryUpdateSchedule(t *testing.T) {
+	t.Run("should save the schedule when the status is unchanged", func(t *testing.T) {
+		_, articleRepo, article := setupArticleRepositoryTest(t)
+		ctx := context.Background()
+		transition(t, articleRepo, article.ID, vo.ActionSubmit)
+		approved := transition(t, articleRepo, article.ID, vo.ActionApprove)
+
+		publishAt := time.Now().Add(time.Hour)
+		if err := approved.Schedule(&publishAt, nil, approved.AuthorID, time.Now()); err != nil {
+			t.Fatalf("Error scheduling article: %v", err)
+		}
+		saved, err := articleRepo.UpdateSchedule(ctx, approved)
+		if err != nil || !saved {
+			t.Fatalf("Expected schedule to be saved, but got %v (err %v)", saved, err)
+		}
+
+		reloaded, _ := articleRepo.FindByID(ctx, article.ID)
+		if reloaded.PublishAt == nil || reloaded.ScheduledBy != approved.AuthorID {
+			t.Errorf("Expected publish_at and scheduled_by to be stored, but got %v and %q", reloaded.PublishAt, reloaded.ScheduledBy)
+		}
+	})
+
+	t.Run("should not resurrect a schedule the worker already applied", func(t *testing.T) {
+		_, articleRepo, article := setupArticleRepositoryTest(t)
+		ctx := context.Background()
+		transition(t, articleRepo, article.ID, vo.ActionSubmit)
+		transition(t, articleRepo, article.ID, vo.ActionApprove)
+
+		// 1. Editor membuka artikel saat masih approved
+		stale, _ := articleRepo.FindByID(ctx, article.ID)
+
+		// 2. Sementara itu artikel dipublish
+		transition(t, articleRepo, article.ID, vo.ActionPublish)
+
+		// 3. Jadwal dari salinan lama disimpan belakangan
+		publishAt := time.Now().Add(time.Hour)
+		if err := stale.Schedule(&publishAt, nil, stale.AuthorID, time.Now()); err != nil {
+			t.Fatalf("Error scheduling article: %v", err)
+		}
+		saved, err := articleRepo.UpdateSchedule(ctx, stale)
+		if err != nil {
+			t.Fatalf("Error saving schedule: %v", err)
+		}
+		if saved {
+			t.Errorf("Expected UpdateSchedule to report a status conflict")
+		}
+
+		reloaded, _ := articleRepo.FindByID(ctx, article.ID)
+		if reloaded.PublishAt != nil {
+			t.Errorf("Expected publish_at to stay empty, but got %v", reloaded.PublishAt)
+		}
+	})
+}
+
+func TestArticleRepositoryDelete(t *testing.T) {
+	t.Run("should hide the article but keep its revisions", func(t *testing.T) {
+		db, articleRepo, article := setupArticleRepositoryTest(t)
+		ctx := context.Background()
+
+		if err := articleRepo.Delete(ctx, article.ID); err != nil {
+			t.Fatalf("Error deleting article: %v", err)
+		}
+
+		found, err := articleRepo.FindByID(ctx, article.ID)
+		if err != nil || found != nil {
+			t.Errorf("Expected deleted article to be hidden, but got %v (err %v)", found, err)
+		}
+
+		var revisions int
+		db.QueryRow("SELECT COUNT(*) FROM article_revisions WHERE article_id = $1", article.ID).Scan(&revisions)
+		if revisions != 1 {
+			t.Errorf("Expected revision history to be kept, but got %d revisions", revisions)
+		}
+	})
+
+	t.Run("should reject deleting or updating a revision", func(t *testing.T) {
+		db, _, article := setupArticleRepositoryTest(t)
+
+		if _, err := db.Exec("DELETE FROM article_revisions WHERE article_id = $1", article.ID); err == nil {
+			t.Error("Expected revision delete to be rejected")
+		}
+		if _, err := db.Exec("UPDATE article_revisions SET title = 'x' WHERE article_id = $1", article.ID); err == nil {
+			t.Error("Expected revision update to be rejected")
+		}
+		if _, err := db.Exec("DELETE FROM articles WHERE id = $1", article.ID); err == nil {
+			t.Error("Expected hard delete of an article with revisions to be rejected")
+		}
+	})
+}
@@ -0,0 +1,370 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type ArticleHandler struct {
+	createUseCase *usecases.CreateArticle
+	getUseCase    *usecases.GetArticle
+	listUseCase   *usecases.ListArticles
+	updateUseCase *usecases.UpdateArticle
+	deleteUseCase *usecases.DeleteArticle
+
+	transitionUseCase      *usecases.TransitionArticle
+	listTransitionsUseCase *usecases.ListArticleTransitions
+	scheduleUseCase        *usecases.ScheduleArticle
+
+	listRevisionsUseCase   *usecases.ListArticleRevisions
+	getRevisionUseCase     *usecases.GetArticleRevision
+	diffRevisionsUseCase   *usecases.DiffArticleRevisions
+	restoreRevisionUseCase *usecases.RestoreArticleRevision
+
+	tagUseCase *usecases.TagArticle
+
+	searchUseCase *usecases.SearchArticles
+}
+
+func NewArticleHandler(
+	createUseCase *usecases.CreateArticle,
+	getUseCase *usecases.GetArticle,
+	listUseCase *usecases.ListArticles,
+	updateUseCase *usecases.UpdateArticle,
+	deleteUseCase *usecases.DeleteArticle,
+	transitionUseCase *usecases.TransitionArticle,
+	listTransitionsUseCase *usecases.ListArticleTransitions,
+	scheduleUseCase *usecases.ScheduleArticle,
+	listRevisionsUseCase *usecases.ListArticleRevisions,
+	getRevisionUseCase *usecases.GetArticleRevision,
+	diffRevisionsUseCase *usecases.DiffArticleRevisions,
+	restoreRevisionUseCase *usecases.RestoreArticleRevision,
+	tagUseCase *usecases.TagArticle,
+	searchUseCase *usecases.SearchArticles) *ArticleHandler {
+	return &ArticleHandler{
+		createUseCase: createUseCase,
+		getUseCase:    getUseCase,
+		listUseCase:   listUseCase,
+		updateUseCase: updateUseCase,
+		deleteUseCase: deleteUseCase,
+
+		transitionUseCase:      transitionUseCase,
+		listTransitionsUseCase: listTransitionsUseCase,
+		scheduleUseCase:        scheduleUseCase,
+
+		listRevisionsUseCase:   listRevisionsUseCase,
+		getRevisionUseCase:     getRevisionUseCase,
+		diffRevisionsUseCase:   diffRevisionsUseCase,
+		restoreRevisionUseCase: restoreRevisionUseCase,
+
+		tagUseCase: tagUseCase,
+
+		searchUseCase: searchUseCase,
+	}
+}
+
+func (h *ArticleHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var input dto.CreateArticleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.Title) == "" {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Title is required", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(input.Body) == "" {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Body is required", http.StatusBadRequest)
+		return
+	}
+	input.Actor = actor(r)
+
+	result, err := h.createUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article created successfully", http.StatusCreated)
+}
+
+func (h *ArticleHandler) Get(w http.ResponseWriter, r *http.Request) {
+	input := dto.GetArticleInput{ID: r.PathValue("id"), Actor: actor(r)}
+
+	result, err := h.getUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article retrieved successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+	input := dto.GetArticleInput{Slug: r.PathValue("slug"), Actor: actor(r)}
+
+	result, err := h.getUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	// ✅ Slug lama (artikel di-rename) diarahkan permanen ke slug barunya
+	if result.Slug != input.Slug {
+		http.Redirect(w, r, "/api/v1/article-slugs/"+url.PathEscape(result.Slug), http.StatusMovedPermanently)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article retrieved successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	input := dto.ListArticlesInput{
+		Status:     query.Get("status"),
+		AuthorID:   query.Get("author_id"),
+		CategoryID: query.Get("category_id"),
+		Tag:        query.Get("tag"),
+		Page:       queryInt(query.Get("page")),
+		Limit:      queryInt(query.Get("limit")),
+		Actor:      actor(r),
+	}
+
+	result, err := h.listUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Articles retrieved successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) Search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	input := dto.SearchArticlesInput{
+		Query:      query.Get("q"),
+		Language:   query.Get("lang"),
+		CategoryID: query.Get("category_id"),
+		Tag:        query.Get("tag"),
+		AuthorID:   query.Get("author_id"),
+		From:       query.Get("from"),
+		To:         query.Get("to"),
+		Page:       queryInt(query.Get("page")),
+		Limit:      queryInt(query.Get("limit")),
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.Query) == "" {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Query parameter 'q' is required", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.searchUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Search results retrieved successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var input dto.UpdateArticleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.ID = r.PathValue("id")
+	input.Actor = actor(r)
+
+	result, err := h.updateUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article updated successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	input := dto.DeleteArticleInput{ID: r.PathValue("id"), Actor: actor(r)}
+
+	if err := h.deleteUseCase.Execute(r.Context(), &input); err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, nil, "Article deleted successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) Transition(w http.ResponseWriter, r *http.Request) {
+	var input dto.TransitionArticleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.Action) == "" {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Action is required", http.StatusBadRequest)
+		return
+	}
+	input.ArticleID = r.PathValue("id")
+	input.Actor = actor(r)
+
+	result, err := h.transitionUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article status updated successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) ListTransitions(w http.ResponseWriter, r *http.Request) {
+	input := dto.ListArticleTransitionsInput{ArticleID: r.PathValue("id"), Actor: actor(r)}
+
+	result, err := h.listTransitionsUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article transitions retrieved successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	var input dto.ScheduleArticleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.ArticleID = r.PathValue("id")
+	input.Actor = actor(r)
+
+	result, err := h.scheduleUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article schedule updated successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	input := dto.ListArticleRevisionsInput{ArticleID: r.PathValue("id"), Actor: actor(r)}
+
+	result, err := h.listRevisionsUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article revisions retrieved successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) GetRevision(w http.ResponseWriter, r *http.Request) {
+	number := queryInt(r.PathValue("number"))
+	if number <= 0 {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Revision number must be a positive integer", http.StatusBadRequest)
+		return
+	}
+	input := dto.GetArticleRevisionInput{ArticleID: r.PathValue("id"), Number: number, Actor: actor(r)}
+
+	result, err := h.getRevisionUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article revision retrieved successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) DiffRevisions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	input := dto.DiffArticleRevisionsInput{
+		ArticleID: r.PathValue("id"),
+		From:      queryInt(query.Get("from")),
+		To:        queryInt(query.Get("to")),
+		Actor:     actor(r),
+	}
+
+	result, err := h.diffRevisionsUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article revision diff retrieved successfully", http.StatusOK)
+}
+
+func (h *ArticleHandler) RestoreRevision(w http.ResponseWriter, r *http.Request) {
+	var input dto.RestoreArticleRevisionInput
+	// Body opsional: hanya berisi change_note
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+			return
+		}
+	}
+
+	input.Number = queryInt(r.PathValue("number"))
+	if input.Number <= 0 {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Revision number must be a positive integer", http.StatusBadRequest)
+		return
+	}
+	input.ArticleID = r.PathValue("id")
+	input.Actor = actor(r)
+
+	result, err := h.restoreRevisionUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article revision restored successfully", http.StatusOK)
+}
+
+// actor mengambil principal dari context; nil untuk request anonim.
+func (h *ArticleHandler) Tag(w http.ResponseWriter, r *http.Request) {
+	var input dto.TagArticleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.ArticleID = r.PathValue("id")
+	input.Actor = actor(r)
+
+	result, err := h.tagUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Article tags updated successfully", http.StatusOK)
+}
+
+func actor(r *http.Request) *shared.Principal {
+	principal, ok := shared.PrincipalFromContext(r.Context())
+	if !ok {
+		return nil
+	}
+	return principal
+}
+
+func queryInt(value string) int {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+	return n
+}
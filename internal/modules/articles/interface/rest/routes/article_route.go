@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"net/http"
+
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/articles/interface/rest/handlers"
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	middleware "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/middleware"
+)
+
+func SetupArticleRoutes(mux *http.ServeMux, articleHandler *handlers.ArticleHandler, jwtMiddleware *middleware.JWTMiddleware) {
+	// Public endpoints (token opsional: penulis & editor bisa melihat draft)
+	mux.Handle("GET /api/v1/articles", jwtMiddleware.OptionalAuthenticate(http.HandlerFunc(articleHandler.List)))
+	mux.Handle("GET /api/v1/articles/{id}", jwtMiddleware.OptionalAuthenticate(http.HandlerFunc(articleHandler.Get)))
+	// Lookup slug sengaja di luar /articles/{id}/... agar tidak bentrok dengan sub-resource artikel
+	mux.Handle("GET /api/v1/article-slugs/{slug}", jwtMiddleware.OptionalAuthenticate(http.HandlerFunc(articleHandler.GetBySlug)))
+	// Pencarian hanya mencakup artikel published
+	mux.HandleFunc("GET /api/v1/search", articleHandler.Search)
+
+	// Protected endpoints
+	mux.Handle("POST /api/v1/articles", jwtMiddleware.Protect(string(authvo.PermissionArticleCreate), articleHandler.Create))
+	mux.Handle("PATCH /api/v1/articles/{id}", jwtMiddleware.AuthenticateFunc(articleHandler.Update))
+	mux.Handle("DELETE /api/v1/articles/{id}", jwtMiddleware.AuthenticateFunc(articleHandler.Delete))
+
+	// Workflow editorial
+	mux.Handle("POST /api/v1/articles/{id}/transitions", jwtMiddleware.AuthenticateFunc(articleHandler.Transition))
+	mux.Handle("GET /api/v1/articles/{id}/transitions", jwtMiddleware.AuthenticateFunc(articleHandler.ListTransitions))
+	mux.Handle("PUT /api/v1/articles/{id}/schedule", jwtMiddleware.Protect(string(authvo.PermissionArticlePublish), articleHandler.Schedule))
+
+	// Tag
+	mux.Handle("PUT /api/v1/articles/{id}/tags", jwtMiddleware.AuthenticateFunc(articleHandler.Tag))
+
+	// Riwayat revisi
+	mux.Handle("GET /api/v1/articles/{id}/revisions", jwtMiddleware.AuthenticateFunc(articleHandler.ListRevisions))
+	mux.Handle("GET /api/v1/articles/{id}/revisions/diff", jwtMiddleware.AuthenticateFunc(articleHandler.DiffRevisions))
+	mux.Handle("GET /api/v1/articles/{id}/revisions/{number}", jwtMiddleware.AuthenticateFunc(articleHandler.GetRevision))
+	mux.Handle("POST /api/v1/articles/{id}/revisions/{number}/restore", jwtMiddleware.AuthenticateFunc(articleHandler.RestoreRevision))
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/articles/application/usecases"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// ScheduledPublisher menjalankan PublishScheduledArticles secara periodik.
+// Aman dijalankan di beberapa replika sekaligus karena repository mengunci
+// baris dengan FOR UPDATE SKIP LOCKED.
+type ScheduledPublisher struct {
+	useCase  *usecases.PublishScheduledArticles
+	interval time.Duration
+}
+
+// defaultScheduledPublishInterval dipakai jika interval tidak positif, karena
+// time.NewTicker panic untuk interval <= 0.
+const defaultScheduledPublishInterval = 30 * time.Second
+
+func NewScheduledPublisher(useCase *usecases.PublishScheduledArticles, interval time.Duration) *ScheduledPublisher {
+	if interval <= 0 {
+		interval = defaultScheduledPublishInterval
+	}
+	return &ScheduledPublisher{
+		useCase:  useCase,
+		interval: interval,
+	}
+}
+
+var _ shared.Worker = (*ScheduledPublisher)(nil)
+
+// Run melakukan polling sampai ctx dibatalkan.
+func (p *ScheduledPublisher) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			applied, err := p.useCase.Execute(ctx, now)
+			if err != nil {
+				log.Printf("❌ Scheduled publisher failed: %v", err)
+				continue
+			}
+			if applied > 0 {
+				log.Printf("✅ Scheduled publisher changed %d article(s)", applied)
+			}
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package dto
+
+type AssignUserRolesInput struct {
+	UserID string   `json:"-"`
+	Roles  []string `json:"roles" validate:"required,min=1"`
+	// ActorID adalah user yang melakukan perubahan, diisi dari principal.
+	ActorID string `json:"-"`
+}
+
+type UserRolesOutput struct {
+	ID       string   `json:"id"`
+	Username string   `json:"username"`
+	Email    string   `json:"email"`
+	Roles    []string `json:"roles"`
+}
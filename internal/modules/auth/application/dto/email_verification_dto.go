@@ -0,0 +1,9 @@
+package dto
+
+type VerifyEmailInput struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ResendEmailVerificationInput struct {
+	Email string `json:"email" validate:"required,email"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type LoginUserInput struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+type LogoutUserInput struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+
+	// Diisi dari principal hasil JWT middleware, bukan dari body.
+	UserID               string    `json:"-"`
+	AccessTokenID        string    `json:"-"`
+	AccessTokenExpiresAt time.Time `json:"-"`
+}
@@ -6,12 +6,17 @@ type RegisterUserInput struct {
 	Username string `json:"username" validate:"required,min=3,max=30"`
 	Email    string `json:"email" validate:"required,email,min=3,max=30"`
 	Password string `json:"password" validate:"required,min=8,max=100"`
+	// SkipVerificationEmail dipakai akun yang dibuat operator (CLI) dan langsung
+	// ditandai terverifikasi. Tidak bisa diisi dari request JSON.
+	SkipVerificationEmail bool `json:"-"`
 }
 
 type RegisterUserOutput struct {
 	ID       string `json:"id"`
 	Username string `json:"username"`
 	Email    string `json:"email"`
+	// VerificationEmailSent false berarti link verifikasi gagal dikirim dan perlu dikirim ulang.
+	VerificationEmailSent bool `json:"verification_email_sent"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
\ No newline at end of file
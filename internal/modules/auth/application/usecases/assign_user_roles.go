@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// AssignUserRoles adalah use case untuk mengganti role seorang user.
+type AssignUserRoles struct {
+	userRepository repos.UserRepository
+}
+
+// NewAssignUserRoles adalah konstruktor untuk use case ini.
+func NewAssignUserRoles(userRepo repos.UserRepository) *AssignUserRoles {
+	return &AssignUserRoles{
+		userRepository: userRepo,
+	}
+}
+
+// Execute memvalidasi role baru lalu menyimpannya. Role baru berlaku pada
+// access token berikutnya (login atau refresh).
+func (a *AssignUserRoles) Execute(ctx context.Context, input *dto.AssignUserRolesInput) (*dto.UserRolesOutput, error) {
+	// 1. Validasi Input
+	if len(input.Roles) == 0 {
+		return nil, shared.NewValidationError(vo.ErrRoleEmpty.Error())
+	}
+
+	roles := make([]vo.Role, 0, len(input.Roles))
+	for _, name := range input.Roles {
+		role, err := vo.NewRole(name)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		roles = append(roles, *role)
+	}
+
+	// 2. Mencari user
+	user, err := a.userRepository.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if user == nil {
+		return nil, shared.NewNotFoundError("User not found")
+	}
+
+	// 3. Admin tidak boleh mencabut role admin miliknya sendiri (mencegah terkunci)
+	if input.ActorID == user.ID && user.HasRole(vo.RoleAdmin) && !containsRole(roles, vo.RoleAdmin) {
+		return nil, shared.NewForbiddenError("You cannot remove your own admin role")
+	}
+
+	if err := user.AssignRoles(roles); err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 4. Menyimpan perubahan
+	savedUser, err := a.userRepository.Update(ctx, user)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	return &dto.UserRolesOutput{
+		ID:       savedUser.ID,
+		Username: savedUser.Username.String(),
+		Email:    savedUser.Email.String(),
+		Roles:    savedUser.RoleNames(),
+	}, nil
+}
+
+func containsRole(roles []vo.Role, name string) bool {
+	for _, role := range roles {
+		if role.String() == name {
+			return true
+		}
+	}
+	return false
+}
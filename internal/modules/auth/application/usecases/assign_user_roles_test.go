@@ -0,0 +1,77 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestAssignUserRoles(t *testing.T) {
+	t.Run("should replace the user's roles", func(t *testing.T) {
+		userRepoMock := new(MockUserRepository)
+		assignUserRolesUsecase := usecases.NewAssignUserRoles(userRepoMock)
+
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		input := dto.AssignUserRolesInput{UserID: user.ID, Roles: []string{"editor"}, ActorID: "admin-uuid"}
+
+		userRepoMock.On("FindByID", mock.Anything, user.ID).Return(user, nil).Once()
+		userRepoMock.On("Update", mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+			return u.HasRole(vo.RoleEditor) && !u.HasRole(vo.RoleReader)
+		})).Return(user, nil).Once()
+
+		output, err := assignUserRolesUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"editor"}, output.Roles)
+		userRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should return a validation error for an unknown role", func(t *testing.T) {
+		userRepoMock := new(MockUserRepository)
+		assignUserRolesUsecase := usecases.NewAssignUserRoles(userRepoMock)
+
+		input := dto.AssignUserRolesInput{UserID: "user-uuid", Roles: []string{"superuser"}}
+
+		_, err := assignUserRolesUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		userRepoMock.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return not found for an unknown user", func(t *testing.T) {
+		userRepoMock := new(MockUserRepository)
+		assignUserRolesUsecase := usecases.NewAssignUserRoles(userRepoMock)
+
+		input := dto.AssignUserRolesInput{UserID: "missing", Roles: []string{"author"}}
+		userRepoMock.On("FindByID", mock.Anything, "missing").Return(nil, nil).Once()
+
+		_, err := assignUserRolesUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+	})
+
+	t.Run("should not let an admin remove their own admin role", func(t *testing.T) {
+		userRepoMock := new(MockUserRepository)
+		assignUserRolesUsecase := usecases.NewAssignUserRoles(userRepoMock)
+
+		admin := newStoredUser(t, "admin-uuid", "$2a$12$hashedpassword")
+		adminRole, _ := vo.NewRole(vo.RoleAdmin)
+		_ = admin.AssignRoles([]vo.Role{*adminRole})
+		input := dto.AssignUserRolesInput{UserID: admin.ID, Roles: []string{"editor"}, ActorID: admin.ID}
+
+		userRepoMock.On("FindByID", mock.Anything, admin.ID).Return(admin, nil).Once()
+
+		_, err := assignUserRolesUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+		userRepoMock.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
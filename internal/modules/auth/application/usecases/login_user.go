@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// Pesan yang sama untuk email tidak terdaftar dan password salah,
+// supaya endpoint login tidak membocorkan email mana yang terdaftar.
+const invalidCredentialsMessage = "Invalid email or password"
+
+// LoginUser adalah use case untuk autentikasi pengguna dan penerbitan token.
+type LoginUser struct {
+	userRepository repos.UserRepository
+	hasher         vo.Hasher
+	tokenIssuer    *tokenIssuer
+}
+
+// NewLoginUser adalah konstruktor untuk use case ini.
+func NewLoginUser(
+	userRepo repos.UserRepository,
+	refreshTokenRepo repos.RefreshTokenRepository,
+	hasher vo.Hasher,
+	tokenManager vo.TokenManager) *LoginUser {
+	return &LoginUser{
+		userRepository: userRepo,
+		hasher:         hasher,
+		tokenIssuer: &tokenIssuer{
+			tokenManager:           tokenManager,
+			refreshTokenRepository: refreshTokenRepo,
+		},
+	}
+}
+
+// Execute memverifikasi kredensial dan mengembalikan access token serta refresh token.
+func (l *LoginUser) Execute(ctx context.Context, input *dto.LoginUserInput) (*dto.TokenPairOutput, error) {
+	// 1. Validasi Input
+	emailVO, err := vo.NewEmail(input.Email)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	if strings.TrimSpace(input.Password) == "" {
+		return nil, shared.NewValidationError(vo.ErrPasswordEmpty.Error())
+	}
+
+	// 2. Mencari user berdasarkan email
+	user, err := l.userRepository.FindByEmail(ctx, emailVO.String())
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if user == nil {
+		return nil, shared.NewUnauthorizedError(invalidCredentialsMessage)
+	}
+
+	// 3. Membandingkan password dengan hash yang tersimpan
+	if err := l.hasher.Compare(user.HashedPassword, input.Password); err != nil {
+		return nil, shared.NewUnauthorizedError(invalidCredentialsMessage)
+	}
+
+	// 4. Akun baru harus verifikasi email dulu; dicek setelah password
+	// supaya status verifikasi tidak bocor ke pihak yang tidak tahu password
+	if !user.IsEmailVerified() {
+		return nil, shared.NewForbiddenError("Email address has not been verified")
+	}
+
+	// 5. Menerbitkan token dengan refresh token family baru
+	return l.tokenIssuer.issue(ctx, user, "")
+}
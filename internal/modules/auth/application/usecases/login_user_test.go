@@ -0,0 +1,217 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type MockTokenManager struct {
+	mock.Mock
+}
+
+func (m *MockTokenManager) GenerateAccessToken(userID string, roles []string) (*vo.Token, error) {
+	args := m.Called(userID, roles)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*vo.Token), args.Error(1)
+}
+
+func (m *MockTokenManager) ParseAccessToken(value string) (*vo.TokenClaims, error) {
+	args := m.Called(value)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*vo.TokenClaims), args.Error(1)
+}
+
+func (m *MockTokenManager) GenerateRefreshToken() (*vo.Token, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*vo.Token), args.Error(1)
+}
+
+func (m *MockTokenManager) HashToken(value string) string {
+	args := m.Called(value)
+	return args.String(0)
+}
+
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockRefreshTokenRepository) Save(ctx context.Context, token *entities.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) MarkRotated(ctx context.Context, id string) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	args := m.Called(ctx, familyID)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func setupLoginUserTest(t *testing.T) (*MockUserRepository, *MockRefreshTokenRepository, *MockHasher, *MockTokenManager, *usecases.LoginUser) {
+	t.Helper()
+	userRepoMock := new(MockUserRepository)
+	refreshTokenRepoMock := new(MockRefreshTokenRepository)
+	hasherMock := new(MockHasher)
+	tokenManagerMock := new(MockTokenManager)
+
+	loginUserUsecase := usecases.NewLoginUser(userRepoMock, refreshTokenRepoMock, hasherMock, tokenManagerMock)
+
+	return userRepoMock, refreshTokenRepoMock, hasherMock, tokenManagerMock, loginUserUsecase
+}
+
+// expectTokenPair menyiapkan mock untuk penerbitan pasangan token.
+func expectTokenPair(tokenManagerMock *MockTokenManager, userID string) (*vo.Token, *vo.Token) {
+	now := time.Now()
+	accessToken := &vo.Token{Value: "access", ID: "jti-1", Type: vo.TokenTypeAccess, IssuedAt: now, ExpiresAt: now.Add(15 * time.Minute)}
+	refreshToken := &vo.Token{Value: "refresh", ID: "jti-2", Type: vo.TokenTypeRefresh, IssuedAt: now, ExpiresAt: now.Add(24 * time.Hour)}
+
+	tokenManagerMock.On("GenerateAccessToken", userID, []string{vo.RoleReader}).Return(accessToken, nil).Once()
+	tokenManagerMock.On("GenerateRefreshToken").Return(refreshToken, nil).Once()
+	tokenManagerMock.On("HashToken", refreshToken.Value).Return("refresh-hash").Once()
+
+	return accessToken, refreshToken
+}
+
+func newStoredUser(t *testing.T, id, hashedPassword string) *entities.User {
+	t.Helper()
+	usernameVO, _ := vo.NewUsername("jokosaputro")
+	emailVO, _ := vo.NewEmail("joko@test.com")
+	user, err := entities.NewUser(id, *usernameVO, *emailVO, hashedPassword)
+	if err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+	user.VerifyEmail(user.CreatedAt)
+	return user
+}
+
+func TestLoginUser(t *testing.T) {
+	t.Run("should login and issue tokens successfully", func(t *testing.T) {
+		userRepoMock, refreshTokenRepoMock, hasherMock, tokenManagerMock, loginUserUsecase := setupLoginUserTest(t)
+
+		input := dto.LoginUserInput{Email: "joko@test.com", Password: "password123"}
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+
+		userRepoMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil).Once()
+		hasherMock.On("Compare", user.HashedPassword, input.Password).Return(nil).Once()
+		_, refreshToken := expectTokenPair(tokenManagerMock, user.ID)
+		refreshTokenRepoMock.On("Save", mock.Anything, mock.MatchedBy(func(token *entities.RefreshToken) bool {
+			// ✅ Login memulai family baru dan hanya menyimpan hash
+			return token.FamilyID == refreshToken.ID && token.TokenHash == "refresh-hash"
+		})).Return(nil).Once()
+
+		output, err := loginUserUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "access", output.AccessToken)
+		assert.Equal(t, "refresh", output.RefreshToken)
+		assert.Equal(t, "Bearer", output.TokenType)
+		assert.Equal(t, int64(900), output.ExpiresIn)
+
+		userRepoMock.AssertExpectations(t)
+		hasherMock.AssertExpectations(t)
+		tokenManagerMock.AssertExpectations(t)
+		refreshTokenRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should return unauthorized when email is not registered", func(t *testing.T) {
+		userRepoMock, _, _, _, loginUserUsecase := setupLoginUserTest(t)
+
+		input := dto.LoginUserInput{Email: "unknown@test.com", Password: "password123"}
+		userRepoMock.On("FindByEmail", mock.Anything, input.Email).Return(nil, nil).Once()
+
+		_, err := loginUserUsecase.Execute(context.Background(), &input)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "UNAUTHORIZED", shared.GetErrorCode(err))
+		userRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should return unauthorized when password does not match", func(t *testing.T) {
+		userRepoMock, _, hasherMock, tokenManagerMock, loginUserUsecase := setupLoginUserTest(t)
+
+		input := dto.LoginUserInput{Email: "joko@test.com", Password: "wrongpassword"}
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+
+		userRepoMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil).Once()
+		hasherMock.On("Compare", user.HashedPassword, input.Password).Return(assert.AnError).Once()
+
+		_, err := loginUserUsecase.Execute(context.Background(), &input)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "UNAUTHORIZED", shared.GetErrorCode(err))
+		tokenManagerMock.AssertNotCalled(t, "GenerateAccessToken", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return forbidden until email is verified", func(t *testing.T) {
+		userRepoMock, _, hasherMock, tokenManagerMock, loginUserUsecase := setupLoginUserTest(t)
+
+		input := dto.LoginUserInput{Email: "joko@test.com", Password: "password123"}
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		user.EmailVerifiedAt = nil
+
+		userRepoMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil).Once()
+		hasherMock.On("Compare", user.HashedPassword, input.Password).Return(nil).Once()
+
+		_, err := loginUserUsecase.Execute(context.Background(), &input)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+		tokenManagerMock.AssertNotCalled(t, "GenerateAccessToken", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return a validation error for invalid email", func(t *testing.T) {
+		_, _, _, _, loginUserUsecase := setupLoginUserTest(t)
+
+		input := dto.LoginUserInput{Email: "not-an-email", Password: "password123"}
+
+		_, err := loginUserUsecase.Execute(context.Background(), &input)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+	})
+
+	t.Run("should return a database error when repository fails", func(t *testing.T) {
+		userRepoMock, _, _, _, loginUserUsecase := setupLoginUserTest(t)
+
+		input := dto.LoginUserInput{Email: "joko@test.com", Password: "password123"}
+		userRepoMock.On("FindByEmail", mock.Anything, input.Email).Return(nil, assert.AnError).Once()
+
+		_, err := loginUserUsecase.Execute(context.Background(), &input)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, "DATABASE_ERROR", shared.GetErrorCode(err))
+	})
+}
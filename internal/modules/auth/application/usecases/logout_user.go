@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// LogoutUser adalah use case untuk mengakhiri sesi pengguna: refresh token
+// family dicabut dan jti access token dimasukkan ke revocation store.
+type LogoutUser struct {
+	refreshTokenRepository repos.RefreshTokenRepository
+	revocationStore        repos.TokenRevocationStore
+	tokenManager           vo.TokenManager
+}
+
+// NewLogoutUser adalah konstruktor untuk use case ini.
+func NewLogoutUser(
+	refreshTokenRepo repos.RefreshTokenRepository,
+	revocationStore repos.TokenRevocationStore,
+	tokenManager vo.TokenManager) *LogoutUser {
+	return &LogoutUser{
+		refreshTokenRepository: refreshTokenRepo,
+		revocationStore:        revocationStore,
+		tokenManager:           tokenManager,
+	}
+}
+
+// Execute mencabut access token dan refresh token milik sesi saat ini. Access
+// token selalu dicabut lebih dulu, sehingga refresh token yang salah atau sudah
+// dirotasi tidak membuat access token (mungkin curian) tetap berlaku.
+func (l *LogoutUser) Execute(ctx context.Context, input *dto.LogoutUserInput) error {
+	// 1. Validasi Input
+	if input.UserID == "" || input.AccessTokenID == "" {
+		return shared.NewUnauthorizedError("Authentication required")
+	}
+
+	// 2. Memasukkan jti access token ke revocation store sampai exp-nya lewat
+	if err := l.revocationStore.Revoke(ctx, input.AccessTokenID, input.AccessTokenExpiresAt); err != nil {
+		return shared.NewDatabaseError(err)
+	}
+
+	// 3. Mencabut refresh token family milik user yang sama
+	if strings.TrimSpace(input.RefreshToken) == "" {
+		return shared.NewValidationError("refresh token cannot be empty")
+	}
+
+	stored, err := l.refreshTokenRepository.FindByHash(ctx, l.tokenManager.HashToken(input.RefreshToken))
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if stored == nil || stored.UserID != input.UserID {
+		return shared.NewUnauthorizedError("Invalid refresh token")
+	}
+
+	if err := l.refreshTokenRepository.RevokeFamily(ctx, stored.FamilyID); err != nil {
+		return shared.NewDatabaseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type MockTokenRevocationStore struct {
+	mock.Mock
+}
+
+func (m *MockTokenRevocationStore) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	args := m.Called(ctx, tokenID, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockTokenRevocationStore) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	args := m.Called(ctx, tokenID)
+	return args.Bool(0), args.Error(1)
+}
+
+func setupLogoutUserTest(t *testing.T) (*MockRefreshTokenRepository, *MockTokenRevocationStore, *MockTokenManager, *usecases.LogoutUser) {
+	t.Helper()
+	refreshTokenRepoMock := new(MockRefreshTokenRepository)
+	revocationStoreMock := new(MockTokenRevocationStore)
+	tokenManagerMock := new(MockTokenManager)
+
+	logoutUserUsecase := usecases.NewLogoutUser(refreshTokenRepoMock, revocationStoreMock, tokenManagerMock)
+
+	return refreshTokenRepoMock, revocationStoreMock, tokenManagerMock, logoutUserUsecase
+}
+
+func TestLogoutUser(t *testing.T) {
+	t.Run("should revoke the refresh token family and the access token jti", func(t *testing.T) {
+		refreshTokenRepoMock, revocationStoreMock, tokenManagerMock, logoutUserUsecase := setupLogoutUserTest(t)
+
+		input := dto.LogoutUserInput{RefreshToken: "refresh", UserID: "user-uuid", AccessTokenID: "jti-1", AccessTokenExpiresAt: time.Now().Add(time.Minute)}
+		stored := entities.NewRefreshToken("rt-1", "user-uuid", "family-1", "refresh-hash", time.Now().Add(time.Hour))
+
+		tokenManagerMock.On("HashToken", "refresh").Return("refresh-hash").Once()
+		refreshTokenRepoMock.On("FindByHash", mock.Anything, "refresh-hash").Return(stored, nil).Once()
+		refreshTokenRepoMock.On("RevokeFamily", mock.Anything, "family-1").Return(nil).Once()
+		revocationStoreMock.On("Revoke", mock.Anything, "jti-1", input.AccessTokenExpiresAt).Return(nil).Once()
+
+		err := logoutUserUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		refreshTokenRepoMock.AssertExpectations(t)
+		revocationStoreMock.AssertExpectations(t)
+		tokenManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should revoke the access token even when the refresh token belongs to another user", func(t *testing.T) {
+		refreshTokenRepoMock, revocationStoreMock, tokenManagerMock, logoutUserUsecase := setupLogoutUserTest(t)
+
+		input := dto.LogoutUserInput{RefreshToken: "refresh", UserID: "user-uuid", AccessTokenID: "jti-1", AccessTokenExpiresAt: time.Now().Add(time.Minute)}
+		stored := entities.NewRefreshToken("rt-1", "other-user", "family-1", "refresh-hash", time.Now().Add(time.Hour))
+
+		revocationStoreMock.On("Revoke", mock.Anything, "jti-1", input.AccessTokenExpiresAt).Return(nil).Once()
+		tokenManagerMock.On("HashToken", "refresh").Return("refresh-hash").Once()
+		refreshTokenRepoMock.On("FindByHash", mock.Anything, "refresh-hash").Return(stored, nil).Once()
+
+		err := logoutUserUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "UNAUTHORIZED", shared.GetErrorCode(err))
+		refreshTokenRepoMock.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything)
+		revocationStoreMock.AssertExpectations(t)
+	})
+
+	t.Run("should revoke the access token even without a refresh token", func(t *testing.T) {
+		refreshTokenRepoMock, revocationStoreMock, _, logoutUserUsecase := setupLogoutUserTest(t)
+
+		input := dto.LogoutUserInput{UserID: "user-uuid", AccessTokenID: "jti-1", AccessTokenExpiresAt: time.Now().Add(time.Minute)}
+		revocationStoreMock.On("Revoke", mock.Anything, "jti-1", input.AccessTokenExpiresAt).Return(nil).Once()
+
+		err := logoutUserUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		refreshTokenRepoMock.AssertNotCalled(t, "FindByHash", mock.Anything, mock.Anything)
+		revocationStoreMock.AssertExpectations(t)
+	})
+
+	t.Run("should return unauthorized without an authenticated principal", func(t *testing.T) {
+		_, _, _, logoutUserUsecase := setupLogoutUserTest(t)
+
+		err := logoutUserUsecase.Execute(context.Background(), &dto.LogoutUserInput{RefreshToken: "refresh"})
+
+		assert.Equal(t, "UNAUTHORIZED", shared.GetErrorCode(err))
+	})
+}
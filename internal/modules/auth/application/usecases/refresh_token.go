@@ -0,0 +1,95 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// RefreshToken adalah use case untuk merotasi refresh token.
+// Setiap refresh token hanya bisa dipakai sekali; jika token yang sudah
+// dirotasi dipakai lagi, seluruh family-nya dicabut.
+type RefreshToken struct {
+	userRepository         repos.UserRepository
+	refreshTokenRepository repos.RefreshTokenRepository
+	tokenManager           vo.TokenManager
+	tokenIssuer            *tokenIssuer
+}
+
+// NewRefreshToken adalah konstruktor untuk use case ini.
+func NewRefreshToken(
+	userRepo repos.UserRepository,
+	refreshTokenRepo repos.RefreshTokenRepository,
+	tokenManager vo.TokenManager) *RefreshToken {
+	return &RefreshToken{
+		userRepository:         userRepo,
+		refreshTokenRepository: refreshTokenRepo,
+		tokenManager:           tokenManager,
+		tokenIssuer: &tokenIssuer{
+			tokenManager:           tokenManager,
+			refreshTokenRepository: refreshTokenRepo,
+		},
+	}
+}
+
+// Execute memvalidasi refresh token, menandainya sebagai terpakai, lalu menerbitkan pasangan token baru.
+func (r *RefreshToken) Execute(ctx context.Context, input *dto.RefreshTokenInput) (*dto.TokenPairOutput, error) {
+	// 1. Validasi Input
+	if strings.TrimSpace(input.RefreshToken) == "" {
+		return nil, shared.NewValidationError("refresh token cannot be empty")
+	}
+
+	// 2. Mencari token berdasarkan hash
+	stored, err := r.refreshTokenRepository.FindByHash(ctx, r.tokenManager.HashToken(input.RefreshToken))
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if stored == nil {
+		return nil, shared.NewUnauthorizedError("Invalid refresh token")
+	}
+
+	// 3. Reuse detection: token lama dipakai ulang, cabut seluruh family
+	if stored.IsRotated() {
+		return nil, r.revokeFamily(ctx, stored.FamilyID)
+	}
+	if stored.IsRevoked() {
+		return nil, shared.NewUnauthorizedError("Refresh token has been revoked")
+	}
+	if stored.IsExpired(time.Now()) {
+		return nil, shared.NewUnauthorizedError("Refresh token has expired")
+	}
+
+	// 4. Menandai token sebagai sudah dirotasi
+	rotated, err := r.refreshTokenRepository.MarkRotated(ctx, stored.ID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if !rotated {
+		// ✅ Request lain sudah merotasi token ini lebih dulu
+		return nil, r.revokeFamily(ctx, stored.FamilyID)
+	}
+
+	// 5. Memastikan user masih ada
+	user, err := r.userRepository.FindByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if user == nil {
+		return nil, shared.NewUnauthorizedError("Invalid refresh token")
+	}
+
+	// 6. Menerbitkan pasangan token baru dalam family yang sama (dengan role terbaru)
+	return r.tokenIssuer.issue(ctx, user, stored.FamilyID)
+}
+
+func (r *RefreshToken) revokeFamily(ctx context.Context, familyID string) error {
+	if err := r.refreshTokenRepository.RevokeFamily(ctx, familyID); err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	return shared.NewUnauthorizedError("Refresh token reuse detected, session revoked")
+}
@@ -0,0 +1,128 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func setupRefreshTokenTest(t *testing.T) (*MockUserRepository, *MockRefreshTokenRepository, *MockTokenManager, *usecases.RefreshToken) {
+	t.Helper()
+	userRepoMock := new(MockUserRepository)
+	refreshTokenRepoMock := new(MockRefreshTokenRepository)
+	tokenManagerMock := new(MockTokenManager)
+
+	refreshTokenUsecase := usecases.NewRefreshToken(userRepoMock, refreshTokenRepoMock, tokenManagerMock)
+
+	return userRepoMock, refreshTokenRepoMock, tokenManagerMock, refreshTokenUsecase
+}
+
+func TestRefreshToken(t *testing.T) {
+	t.Run("should rotate the refresh token within the same family", func(t *testing.T) {
+		userRepoMock, refreshTokenRepoMock, tokenManagerMock, refreshTokenUsecase := setupRefreshTokenTest(t)
+
+		input := dto.RefreshTokenInput{RefreshToken: "old-refresh"}
+		stored := entities.NewRefreshToken("rt-1", "user-uuid", "family-1", "old-hash", time.Now().Add(time.Hour))
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+
+		tokenManagerMock.On("HashToken", input.RefreshToken).Return("old-hash").Once()
+		refreshTokenRepoMock.On("FindByHash", mock.Anything, "old-hash").Return(stored, nil).Once()
+		refreshTokenRepoMock.On("MarkRotated", mock.Anything, stored.ID).Return(true, nil).Once()
+		userRepoMock.On("FindByID", mock.Anything, stored.UserID).Return(user, nil).Once()
+		expectTokenPair(tokenManagerMock, user.ID)
+		refreshTokenRepoMock.On("Save", mock.Anything, mock.MatchedBy(func(token *entities.RefreshToken) bool {
+			return token.FamilyID == "family-1" && token.UserID == user.ID
+		})).Return(nil).Once()
+
+		output, err := refreshTokenUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "access", output.AccessToken)
+		assert.Equal(t, "refresh", output.RefreshToken)
+
+		userRepoMock.AssertExpectations(t)
+		refreshTokenRepoMock.AssertExpectations(t)
+		tokenManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should revoke the whole family when a rotated token is replayed", func(t *testing.T) {
+		_, refreshTokenRepoMock, tokenManagerMock, refreshTokenUsecase := setupRefreshTokenTest(t)
+
+		input := dto.RefreshTokenInput{RefreshToken: "old-refresh"}
+		rotatedAt := time.Now().Add(-time.Minute)
+		stored := entities.NewRefreshToken("rt-1", "user-uuid", "family-1", "old-hash", time.Now().Add(time.Hour))
+		stored.RotatedAt = &rotatedAt
+
+		tokenManagerMock.On("HashToken", input.RefreshToken).Return("old-hash").Once()
+		refreshTokenRepoMock.On("FindByHash", mock.Anything, "old-hash").Return(stored, nil).Once()
+		refreshTokenRepoMock.On("RevokeFamily", mock.Anything, "family-1").Return(nil).Once()
+
+		_, err := refreshTokenUsecase.Execute(context.Background(), &input)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "UNAUTHORIZED", shared.GetErrorCode(err))
+		refreshTokenRepoMock.AssertExpectations(t)
+		tokenManagerMock.AssertNotCalled(t, "GenerateAccessToken", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should revoke the family when a concurrent request rotated the token first", func(t *testing.T) {
+		_, refreshTokenRepoMock, tokenManagerMock, refreshTokenUsecase := setupRefreshTokenTest(t)
+
+		input := dto.RefreshTokenInput{RefreshToken: "old-refresh"}
+		stored := entities.NewRefreshToken("rt-1", "user-uuid", "family-1", "old-hash", time.Now().Add(time.Hour))
+
+		tokenManagerMock.On("HashToken", input.RefreshToken).Return("old-hash").Once()
+		refreshTokenRepoMock.On("FindByHash", mock.Anything, "old-hash").Return(stored, nil).Once()
+		refreshTokenRepoMock.On("MarkRotated", mock.Anything, stored.ID).Return(false, nil).Once()
+		refreshTokenRepoMock.On("RevokeFamily", mock.Anything, "family-1").Return(nil).Once()
+
+		_, err := refreshTokenUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "UNAUTHORIZED", shared.GetErrorCode(err))
+		refreshTokenRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should reject an expired refresh token", func(t *testing.T) {
+		_, refreshTokenRepoMock, tokenManagerMock, refreshTokenUsecase := setupRefreshTokenTest(t)
+
+		input := dto.RefreshTokenInput{RefreshToken: "old-refresh"}
+		stored := entities.NewRefreshToken("rt-1", "user-uuid", "family-1", "old-hash", time.Now().Add(-time.Minute))
+
+		tokenManagerMock.On("HashToken", input.RefreshToken).Return("old-hash").Once()
+		refreshTokenRepoMock.On("FindByHash", mock.Anything, "old-hash").Return(stored, nil).Once()
+
+		_, err := refreshTokenUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "UNAUTHORIZED", shared.GetErrorCode(err))
+		refreshTokenRepoMock.AssertNotCalled(t, "MarkRotated", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject an unknown refresh token", func(t *testing.T) {
+		_, refreshTokenRepoMock, tokenManagerMock, refreshTokenUsecase := setupRefreshTokenTest(t)
+
+		input := dto.RefreshTokenInput{RefreshToken: "unknown"}
+
+		tokenManagerMock.On("HashToken", input.RefreshToken).Return("unknown-hash").Once()
+		refreshTokenRepoMock.On("FindByHash", mock.Anything, "unknown-hash").Return(nil, nil).Once()
+
+		_, err := refreshTokenUsecase.Execute(context.Background(), &input)
+
+		assert.Equal(t, "UNAUTHORIZED", shared.GetErrorCode(err))
+	})
+
+	t.Run("should return a validation error for an empty token", func(t *testing.T) {
+		_, _, _, refreshTokenUsecase := setupRefreshTokenTest(t)
+
+		_, err := refreshTokenUsecase.Execute(context.Background(), &dto.RefreshTokenInput{})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+	})
+}
@@ -2,6 +2,8 @@ package usecases
 
 import (
 	"context"
+	"log"
+	"time"
 
 	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
 	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
@@ -12,20 +14,32 @@ import (
 
 // RegisterUser adalah use case untuk mendaftarkan pengguna baru.
 type RegisterUser struct {
-	userRepository repos.UserRepository
-	uuidGenerator  shared.UUIDGenerator
-	hasher         vo.Hasher
+	userRepository     repos.UserRepository
+	uuidGenerator      shared.UUIDGenerator
+	hasher             vo.Hasher
+	verificationMailer *verificationMailer
+	eventPublisher     shared.EventPublisher
 }
 
 // NewRegisterUser adalah konstruktor untuk use case ini.
 func NewRegisterUser(
 	userRepo repos.UserRepository, 
 	uuidGen shared.UUIDGenerator, 
-	hasher vo.Hasher) *RegisterUser {
+	hasher vo.Hasher,
+	signer vo.EmailVerificationSigner,
+	mailer vo.Mailer,
+	verificationSettings EmailVerificationSettings,
+	eventPublisher shared.EventPublisher) *RegisterUser {
 	return &RegisterUser{
 		userRepository: userRepo,
 		uuidGenerator:  uuidGen,
 		hasher:         hasher,
+		verificationMailer: &verificationMailer{
+			signer:   signer,
+			mailer:   mailer,
+			settings: verificationSettings,
+		},
+		eventPublisher: eventPublisher,
 	}
 }
 
@@ -76,13 +90,36 @@ func (r *RegisterUser) Execute(ctx context.Context, input *dto.RegisterUserInput
 		return nil, shared.NewDatabaseError(err)
 	}
 
-	// 6. Mengembalikan DTO output
+	// 6. Mengirim domain event (UserRegistered) setelah user tersimpan.
+	// Repository yang menulis outbox sudah mengambil event-nya, sehingga tidak terkirim dua kali.
+	// ✅ Jika dipanggil di dalam WithinTx, event baru dikirim setelah commit
+	events := user.PullEvents()
+	shared.AfterCommit(ctx, func() {
+		r.eventPublisher.Publish(context.WithoutCancel(ctx), events...)
+	})
+
+	// 7. Mengirim link verifikasi email. Kegagalan kirim tidak membatalkan
+	// pendaftaran, user bisa meminta kirim ulang.
+	verificationSent := false
+	if !input.SkipVerificationEmail {
+		verificationSent = r.verificationMailer.send(ctx, savedUser) == nil
+	}
+
+	// ✅ Pengiriman dicatat agar resend pertama juga menunggu ResendInterval
+	if verificationSent {
+		if _, err := r.userRepository.MarkVerificationSent(ctx, savedUser.ID, time.Now()); err != nil {
+			log.Printf("❌ Recording verification mail for user %s failed: %v", savedUser.ID, err)
+		}
+	}
+
+	// 8. Mengembalikan DTO output
 	output := &dto.RegisterUserOutput{
-		ID:        savedUser.ID,
-		Username:  savedUser.Username.String(),
-		Email:     savedUser.Email.String(),
-		CreatedAt: savedUser.CreatedAt,
-		UpdatedAt: savedUser.UpdatedAt,
+		ID:                    savedUser.ID,
+		Username:              savedUser.Username.String(),
+		Email:                 savedUser.Email.String(),
+		VerificationEmailSent: verificationSent,
+		CreatedAt:             savedUser.CreatedAt,
+		UpdatedAt:             savedUser.UpdatedAt,
 	}
 
 	return output, nil
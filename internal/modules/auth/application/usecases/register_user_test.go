@@ -2,7 +2,9 @@ package usecases_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -10,7 +12,9 @@ import (
 	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
 	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
 	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	authevents "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/events"
 	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
 )
 
 // --- Mock Implementations ---
@@ -69,6 +73,27 @@ func (m *MockUserRepository) Delete(ctx context.Context, id string) error{
 	return args.Error(0)
 }
 
+func (m *MockUserRepository) LockAdminIDs(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockUserRepository) MarkVerificationSent(ctx context.Context, id string, sentBefore time.Time) (bool, error) {
+	args := m.Called(ctx, id, sentBefore)
+	return args.Bool(0), args.Error(1)
+}
+
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockEventPublisher) Publish(ctx context.Context, events ...shared.DomainEvent) {
+	m.Called(ctx, events)
+}
+
 type MockUUIDGenerator struct {
 	mock.Mock
 }
@@ -96,23 +121,44 @@ func (m *MockHasher) Compare(hashedPassword string, password string) error {
 
 func setupRegisterUserTest(t *testing.T) (*MockUserRepository, *MockUUIDGenerator, *MockHasher, *usecases.RegisterUser) {
 	t.Helper()
-	userRepoMock := new(MockUserRepository)
-	uuidGenMock := new(MockUUIDGenerator)
-	hasherMock := new(MockHasher) 
-	
-	registerUserUsecase := usecases.NewRegisterUser(userRepoMock, uuidGenMock, hasherMock)
-	
+	userRepoMock, uuidGenMock, hasherMock, signerMock, mailerMock, registerUserUsecase := setupRegisterUserTestWithMail(t)
+
+	// ✅ Pengiriman link verifikasi diuji terpisah di TestRegisterUserEmailVerification
+	signerMock.On("SignEmailVerification", mock.Anything, mock.Anything).Return(newVerificationToken("signed"), nil).Maybe()
+	mailerMock.On("Send", mock.Anything, mock.Anything).Return(nil).Maybe()
+	userRepoMock.On("MarkVerificationSent", mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Maybe()
+
 	return userRepoMock, uuidGenMock, hasherMock, registerUserUsecase
 }
 
-// ✅ Helper function untuk test cases yang tidak perlu semua mock
-func setupRegisterUserTestMinimal(t *testing.T) (*MockUserRepository, *MockHasher, *usecases.RegisterUser) {
+// setupRegisterUserTestWithMail juga mengembalikan mock signer dan mailer untuk link verifikasi.
+func setupRegisterUserTestWithMail(t *testing.T) (*MockUserRepository, *MockUUIDGenerator, *MockHasher, *MockEmailVerificationSigner, *MockMailer, *usecases.RegisterUser) {
 	t.Helper()
 	userRepoMock := new(MockUserRepository)
 	uuidGenMock := new(MockUUIDGenerator)
 	hasherMock := new(MockHasher)
-	
-	registerUserUsecase := usecases.NewRegisterUser(userRepoMock, uuidGenMock, hasherMock)
+	signerMock := new(MockEmailVerificationSigner)
+	mailerMock := new(MockMailer)
+	publisherMock := new(MockEventPublisher)
+	publisherMock.On("Publish", mock.Anything, mock.Anything).Maybe()
+
+	registerUserUsecase := usecases.NewRegisterUser(
+		userRepoMock,
+		uuidGenMock,
+		hasherMock,
+		signerMock,
+		mailerMock,
+		usecases.EmailVerificationSettings{VerifyURL: "https://news.example.com/verify-email", ResendInterval: time.Minute},
+		publisherMock,
+	)
+
+	return userRepoMock, uuidGenMock, hasherMock, signerMock, mailerMock, registerUserUsecase
+}
+
+// ✅ Helper function untuk test cases yang tidak perlu semua mock
+func setupRegisterUserTestMinimal(t *testing.T) (*MockUserRepository, *MockHasher, *usecases.RegisterUser) {
+	t.Helper()
+	userRepoMock, _, hasherMock, _, _, registerUserUsecase := setupRegisterUserTestWithMail(t)
 	
 	return userRepoMock, hasherMock, registerUserUsecase
 }
@@ -171,7 +217,8 @@ func TestRegisterUser(t *testing.T) {
 		_, err := registerUserUsecase.Execute(context.Background(), &input)
 		
 		assert.NotNil(t, err)
-		assert.EqualError(t, err, "email already exists")
+		assert.ErrorContains(t, err, "Email already registered")
+		assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
 		
 		userRepoMock.AssertExpectations(t)
 	})
@@ -188,7 +235,8 @@ func TestRegisterUser(t *testing.T) {
 		_, err := registerUserUsecase.Execute(context.Background(), &input)
 		
 		assert.NotNil(t, err)
-		assert.EqualError(t, err, "username cannot be empty")
+		assert.ErrorContains(t, err, "username cannot be empty")
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
 	})
 
 	// ✅ Test tambahan untuk password validation
@@ -204,7 +252,8 @@ func TestRegisterUser(t *testing.T) {
 		_, err := registerUserUsecase.Execute(context.Background(), &input)
 		
 		assert.NotNil(t, err)
-		assert.EqualError(t, err, "password must be between 8 and 128 characters")
+		assert.ErrorContains(t, err, "password must be between 8 and 128 characters")
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
 	})
 
 	t.Run("should return an error when hashing fails", func(t *testing.T) {
@@ -222,7 +271,7 @@ func TestRegisterUser(t *testing.T) {
 		_, err := registerUserUsecase.Execute(context.Background(), &input)
 		
 		assert.NotNil(t, err)
-		assert.Equal(t, assert.AnError, err)
+		assert.ErrorIs(t, err, assert.AnError)
 		
 		userRepoMock.AssertExpectations(t)
 		hasherMock.AssertExpectations(t)
@@ -249,7 +298,7 @@ func TestRegisterUser(t *testing.T) {
 		_, err := registerUserUsecase.Execute(context.Background(), &input)
 		
 		assert.NotNil(t, err)
-		assert.Equal(t, assert.AnError, err)
+		assert.ErrorIs(t, err, assert.AnError)
 		
 		userRepoMock.AssertExpectations(t)
 		uuidGenMock.AssertExpectations(t)
@@ -270,8 +319,167 @@ func TestRegisterUser(t *testing.T) {
 		_, err := registerUserUsecase.Execute(context.Background(), &input)
 		
 		assert.NotNil(t, err)
-		assert.Equal(t, assert.AnError, err)
+		assert.ErrorIs(t, err, assert.AnError)
 		
 		userRepoMock.AssertExpectations(t)
 	})
-}
\ No newline at end of file
+}
+
+func TestRegisterUserEmailVerification(t *testing.T) {
+	input := dto.RegisterUserInput{
+		Username: "jokosaputro",
+		Email:    "joko@test.com",
+		Password: "password123",
+	}
+
+	expectRegistration := func(userRepoMock *MockUserRepository, uuidGenMock *MockUUIDGenerator, hasherMock *MockHasher) {
+		savedUser := newStoredUser(t, "mock-uuid-123", "$2a$12$hashedpassword")
+		savedUser.EmailVerifiedAt = nil
+
+		userRepoMock.On("ExistsByEmail", mock.Anything, input.Email).Return(false, nil).Once()
+		uuidGenMock.On("NewUUID").Return("mock-uuid-123").Once()
+		hasherMock.On("Hash", input.Password).Return("$2a$12$hashedpassword", nil).Once()
+		userRepoMock.On("Save", mock.Anything, mock.MatchedBy(func(user *entities.User) bool {
+			// ✅ Akun baru belum terverifikasi
+			return !user.IsEmailVerified()
+		})).Return(savedUser, nil).Once()
+	}
+
+	t.Run("should mail a signed verification link after registration", func(t *testing.T) {
+		userRepoMock, uuidGenMock, hasherMock, signerMock, mailerMock, registerUserUsecase := setupRegisterUserTestWithMail(t)
+		expectRegistration(userRepoMock, uuidGenMock, hasherMock)
+
+		signerMock.On("SignEmailVerification", "mock-uuid-123", input.Email).Return(newVerificationToken("signed.jwt"), nil).Once()
+		mailerMock.On("Send", mock.Anything, mock.MatchedBy(func(message vo.MailMessage) bool {
+			return message.To == input.Email &&
+				strings.Contains(message.Body, "https://news.example.com/verify-email?token=signed.jwt")
+		})).Return(nil).Once()
+		userRepoMock.On("MarkVerificationSent", mock.Anything, "mock-uuid-123", mock.Anything).Return(true, nil).Once()
+
+		output, err := registerUserUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.True(t, output.VerificationEmailSent)
+		signerMock.AssertExpectations(t)
+		mailerMock.AssertExpectations(t)
+		userRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should still register when the verification email cannot be sent", func(t *testing.T) {
+		userRepoMock, uuidGenMock, hasherMock, signerMock, mailerMock, registerUserUsecase := setupRegisterUserTestWithMail(t)
+		expectRegistration(userRepoMock, uuidGenMock, hasherMock)
+
+		signerMock.On("SignEmailVerification", "mock-uuid-123", input.Email).Return(newVerificationToken("signed.jwt"), nil).Once()
+		mailerMock.On("Send", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+
+		output, err := registerUserUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "mock-uuid-123", output.ID)
+		assert.False(t, output.VerificationEmailSent)
+		// ✅ Kirim gagal tidak memulai cooldown, resend langsung diizinkan
+		userRepoMock.AssertNotCalled(t, "MarkVerificationSent", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should skip the verification email for operator-created accounts", func(t *testing.T) {
+		userRepoMock, uuidGenMock, hasherMock, signerMock, mailerMock, registerUserUsecase := setupRegisterUserTestWithMail(t)
+
+		operatorInput := input
+		operatorInput.SkipVerificationEmail = true
+
+		userRepoMock.On("ExistsByEmail", mock.Anything, input.Email).Return(false, nil).Once()
+		uuidGenMock.On("NewUUID").Return("mock-uuid-123").Once()
+		hasherMock.On("Hash", input.Password).Return("$2a$12$hashedpassword", nil).Once()
+		userRepoMock.On("Save", mock.Anything, mock.Anything).Return(newStoredUser(t, "mock-uuid-123", "$2a$12$hashedpassword"), nil).Once()
+
+		output, err := registerUserUsecase.Execute(context.Background(), &operatorInput)
+
+		assert.Nil(t, err)
+		assert.False(t, output.VerificationEmailSent)
+		signerMock.AssertNotCalled(t, "SignEmailVerification", mock.Anything, mock.Anything)
+		mailerMock.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRegisterUserEvents(t *testing.T) {
+	t.Run("should publish UserRegistered after the user is saved", func(t *testing.T) {
+		userRepoMock := new(MockUserRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		hasherMock := new(MockHasher)
+		signerMock := new(MockEmailVerificationSigner)
+		mailerMock := new(MockMailer)
+		publisherMock := new(MockEventPublisher)
+		registerUserUsecase := usecases.NewRegisterUser(userRepoMock, uuidGenMock, hasherMock, signerMock, mailerMock, usecases.EmailVerificationSettings{}, publisherMock)
+
+		input := dto.RegisterUserInput{Username: "jokosaputro", Email: "joko@test.com", Password: "password123"}
+		userRepoMock.On("ExistsByEmail", mock.Anything, input.Email).Return(false, nil).Once()
+		uuidGenMock.On("NewUUID").Return("mock-uuid-123").Once()
+		hasherMock.On("Hash", input.Password).Return("$2a$12$hashedpassword", nil).Once()
+		userRepoMock.On("Save", mock.Anything, mock.Anything).Return(newStoredUser(t, "mock-uuid-123", "$2a$12$hashedpassword"), nil).Once()
+		signerMock.On("SignEmailVerification", mock.Anything, mock.Anything).Return(newVerificationToken("signed"), nil).Once()
+		mailerMock.On("Send", mock.Anything, mock.Anything).Return(nil).Once()
+		userRepoMock.On("MarkVerificationSent", mock.Anything, "mock-uuid-123", mock.Anything).Return(true, nil).Once()
+		publisherMock.On("Publish", mock.Anything, mock.MatchedBy(func(events []shared.DomainEvent) bool {
+			if len(events) != 1 {
+				return false
+			}
+			event, ok := events[0].(authevents.UserRegistered)
+			return ok && event.UserID == "mock-uuid-123" && event.Email == input.Email
+		})).Once()
+
+		_, err := registerUserUsecase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		publisherMock.AssertExpectations(t)
+	})
+
+	t.Run("should not publish events when the save fails", func(t *testing.T) {
+		userRepoMock, uuidGenMock, hasherMock, _, _, _ := setupRegisterUserTestWithMail(t)
+		publisherMock := new(MockEventPublisher)
+		registerUserUsecase := usecases.NewRegisterUser(userRepoMock, uuidGenMock, hasherMock, nil, nil, usecases.EmailVerificationSettings{}, publisherMock)
+
+		input := dto.RegisterUserInput{Username: "jokosaputro", Email: "joko@test.com", Password: "password123"}
+		userRepoMock.On("ExistsByEmail", mock.Anything, input.Email).Return(false, nil).Once()
+		uuidGenMock.On("NewUUID").Return("mock-uuid-123").Once()
+		hasherMock.On("Hash", input.Password).Return("$2a$12$hashedpassword", nil).Once()
+		userRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+
+		_, err := registerUserUsecase.Execute(context.Background(), &input)
+
+		assert.NotNil(t, err)
+		publisherMock.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should publish only after the surrounding transaction commits", func(t *testing.T) {
+		userRepoMock, uuidGenMock, hasherMock, _, _, _ := setupRegisterUserTestWithMail(t)
+		publisherMock := new(MockEventPublisher)
+		registerUserUsecase := usecases.NewRegisterUser(userRepoMock, uuidGenMock, hasherMock, nil, nil, usecases.EmailVerificationSettings{}, publisherMock)
+		txManager := shared.NewInMemoryTxManager()
+
+		input := dto.RegisterUserInput{Username: "jokosaputro", Email: "joko@test.com", Password: "password123", SkipVerificationEmail: true}
+		userRepoMock.On("ExistsByEmail", mock.Anything, input.Email).Return(false, nil).Twice()
+		uuidGenMock.On("NewUUID").Return("mock-uuid-123").Twice()
+		hasherMock.On("Hash", input.Password).Return("$2a$12$hashedpassword", nil).Twice()
+		userRepoMock.On("Save", mock.Anything, mock.Anything).Return(newStoredUser(t, "mock-uuid-123", "$2a$12$hashedpassword"), nil).Twice()
+		publisherMock.On("Publish", mock.Anything, mock.Anything).Once()
+
+		// Transaksi di-rollback: event tidak boleh terkirim
+		err := txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			if _, err := registerUserUsecase.Execute(ctx, &input); err != nil {
+				return err
+			}
+			return assert.AnError
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+		publisherMock.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+
+		// Transaksi di-commit: event terkirim setelah fn selesai
+		err = txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			_, err := registerUserUsecase.Execute(ctx, &input)
+			publisherMock.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+			return err
+		})
+		assert.Nil(t, err)
+		publisherMock.AssertExpectations(t)
+	})
+}
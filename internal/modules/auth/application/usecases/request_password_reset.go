@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const resetTokenBytes = 32
+
+// PasswordResetSettings mengatur link dan masa berlaku token reset password.
+type PasswordResetSettings struct {
+	// ResetURL adalah halaman frontend yang menerima query ?token=.
+	ResetURL string
+	TTL      time.Duration
+}
+
+// RequestPasswordReset adalah use case untuk mengirim link reset password.
+// Email yang tidak terdaftar tetap dianggap berhasil supaya endpoint ini
+// tidak bisa dipakai untuk menebak email mana yang terdaftar. Karena itu
+// kegagalan kirim email juga hanya dicatat di log, dan mailer sebaiknya
+// mengirim di background (mail.AsyncMailer) supaya lama respons tidak berbeda.
+type RequestPasswordReset struct {
+	userRepository               repos.UserRepository
+	passwordResetTokenRepository repos.PasswordResetTokenRepository
+	tokenManager                 vo.TokenManager
+	uuidGenerator                shared.UUIDGenerator
+	mailer                       vo.Mailer
+	settings                     PasswordResetSettings
+}
+
+// NewRequestPasswordReset adalah konstruktor untuk use case ini.
+func NewRequestPasswordReset(
+	userRepo repos.UserRepository,
+	resetTokenRepo repos.PasswordResetTokenRepository,
+	tokenManager vo.TokenManager,
+	uuidGen shared.UUIDGenerator,
+	mailer vo.Mailer,
+	settings PasswordResetSettings) *RequestPasswordReset {
+	return &RequestPasswordReset{
+		userRepository:               userRepo,
+		passwordResetTokenRepository: resetTokenRepo,
+		tokenManager:                 tokenManager,
+		uuidGenerator:                uuidGen,
+		mailer:                       mailer,
+		settings:                     settings,
+	}
+}
+
+// Execute membuat token reset baru dan mengirimkannya ke email user.
+func (r *RequestPasswordReset) Execute(ctx context.Context, input *dto.ForgotPasswordInput) error {
+	// 1. Validasi Input
+	emailVO, err := vo.NewEmail(input.Email)
+	if err != nil {
+		return shared.NewValidationError(err.Error())
+	}
+
+	// 2. Mencari user berdasarkan email
+	user, err := r.userRepository.FindByEmail(ctx, emailVO.String())
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if user == nil {
+		return nil // ✅ Respons sama dengan email yang terdaftar
+	}
+
+	// 3. Membuat token acak, hanya hash-nya yang disimpan
+	value, err := generateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	token := entities.NewPasswordResetToken(
+		r.uuidGenerator.NewUUID(),
+		user.ID,
+		r.tokenManager.HashToken(value),
+		time.Now().Add(r.settings.TTL),
+	)
+	if err := r.passwordResetTokenRepository.Save(ctx, token); err != nil {
+		return shared.NewDatabaseError(err)
+	}
+
+	// 4. Mengirim link reset ke email user
+	message := vo.MailMessage{
+		To:      user.Email.String(),
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nWe received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\nThe link expires in %s. If you did not request a password reset, you can ignore this email.\n",
+			user.Username.String(),
+			tokenLink(r.settings.ResetURL, value),
+			r.settings.TTL,
+		),
+	}
+	if err := r.mailer.Send(ctx, message); err != nil {
+		// ✅ Respons sama dengan email yang tidak terdaftar
+		log.Printf("❌ Password reset mail for user %s failed: %v", user.ID, err)
+	}
+
+	return nil
+}
+
+// tokenLink menambahkan query ?token= ke URL halaman frontend.
+func tokenLink(baseURL, token string) string {
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL + "?token=" + url.QueryEscape(token)
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
+
+// generateOpaqueToken menghasilkan 32 byte acak dalam base64 URL-safe.
+func generateOpaqueToken() (string, error) {
+	buf := make([]byte, resetTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
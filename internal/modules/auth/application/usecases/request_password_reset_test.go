@@ -0,0 +1,148 @@
+package usecases_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type MockPasswordResetTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockPasswordResetTokenRepository) Save(ctx context.Context, token *entities.PasswordResetToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockPasswordResetTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entities.PasswordResetToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.PasswordResetToken), args.Error(1)
+}
+
+func (m *MockPasswordResetTokenRepository) MarkUsed(ctx context.Context, id string) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPasswordResetTokenRepository) InvalidateForUser(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+type MockMailer struct {
+	mock.Mock
+}
+
+func (m *MockMailer) Send(ctx context.Context, message vo.MailMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+func setupRequestPasswordResetTest(t *testing.T) (*MockUserRepository, *MockPasswordResetTokenRepository, *MockTokenManager, *MockMailer, *usecases.RequestPasswordReset) {
+	t.Helper()
+	userRepoMock := new(MockUserRepository)
+	resetTokenRepoMock := new(MockPasswordResetTokenRepository)
+	tokenManagerMock := new(MockTokenManager)
+	uuidGenMock := new(MockUUIDGenerator)
+	mailerMock := new(MockMailer)
+
+	uuidGenMock.On("NewUUID").Return("reset-uuid").Maybe()
+
+	useCase := usecases.NewRequestPasswordReset(
+		userRepoMock,
+		resetTokenRepoMock,
+		tokenManagerMock,
+		uuidGenMock,
+		mailerMock,
+		usecases.PasswordResetSettings{ResetURL: "https://news.example.com/reset-password", TTL: time.Hour},
+	)
+
+	return userRepoMock, resetTokenRepoMock, tokenManagerMock, mailerMock, useCase
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	t.Run("should store only the token hash and mail the raw token", func(t *testing.T) {
+		userRepoMock, resetTokenRepoMock, tokenManagerMock, mailerMock, useCase := setupRequestPasswordResetTest(t)
+
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		userRepoMock.On("FindByEmail", mock.Anything, "joko@test.com").Return(user, nil).Once()
+
+		var rawToken string
+		tokenManagerMock.On("HashToken", mock.MatchedBy(func(value string) bool {
+			rawToken = value
+			return len(value) >= 43
+		})).Return("reset-hash").Once()
+
+		resetTokenRepoMock.On("Save", mock.Anything, mock.MatchedBy(func(token *entities.PasswordResetToken) bool {
+			ttl := time.Until(token.ExpiresAt)
+			return token.ID == "reset-uuid" &&
+				token.UserID == user.ID &&
+				token.TokenHash == "reset-hash" &&
+				ttl > 59*time.Minute && ttl <= time.Hour
+		})).Return(nil).Once()
+
+		mailerMock.On("Send", mock.Anything, mock.MatchedBy(func(message vo.MailMessage) bool {
+			link := "https://news.example.com/reset-password?token=" + url.QueryEscape(rawToken)
+			return message.To == "joko@test.com" && strings.Contains(message.Body, link)
+		})).Return(nil).Once()
+
+		err := useCase.Execute(context.Background(), &dto.ForgotPasswordInput{Email: "joko@test.com"})
+
+		assert.Nil(t, err)
+		userRepoMock.AssertExpectations(t)
+		tokenManagerMock.AssertExpectations(t)
+		resetTokenRepoMock.AssertExpectations(t)
+		mailerMock.AssertExpectations(t)
+	})
+
+	t.Run("should succeed silently when email is not registered", func(t *testing.T) {
+		userRepoMock, resetTokenRepoMock, _, mailerMock, useCase := setupRequestPasswordResetTest(t)
+
+		userRepoMock.On("FindByEmail", mock.Anything, "unknown@test.com").Return(nil, nil).Once()
+
+		err := useCase.Execute(context.Background(), &dto.ForgotPasswordInput{Email: "unknown@test.com"})
+
+		assert.Nil(t, err)
+		resetTokenRepoMock.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		mailerMock.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return a validation error for invalid email", func(t *testing.T) {
+		_, _, _, _, useCase := setupRequestPasswordResetTest(t)
+
+		err := useCase.Execute(context.Background(), &dto.ForgotPasswordInput{Email: "not-an-email"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+	})
+
+	t.Run("should not reveal mail delivery failures", func(t *testing.T) {
+		userRepoMock, resetTokenRepoMock, tokenManagerMock, mailerMock, useCase := setupRequestPasswordResetTest(t)
+
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		userRepoMock.On("FindByEmail", mock.Anything, "joko@test.com").Return(user, nil).Once()
+		tokenManagerMock.On("HashToken", mock.Anything).Return("reset-hash").Once()
+		resetTokenRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Once()
+		mailerMock.On("Send", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+
+		err := useCase.Execute(context.Background(), &dto.ForgotPasswordInput{Email: "joko@test.com"})
+
+		assert.Nil(t, err)
+		mailerMock.AssertExpectations(t)
+	})
+}
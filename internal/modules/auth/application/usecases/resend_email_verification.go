@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"context"
+	"log"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// ResendEmailVerification adalah use case untuk mengirim ulang link verifikasi email.
+// Pengiriman dibatasi satu kali per ResendInterval untuk setiap user.
+type ResendEmailVerification struct {
+	userRepository     repos.UserRepository
+	verificationMailer *verificationMailer
+}
+
+// NewResendEmailVerification adalah konstruktor untuk use case ini.
+func NewResendEmailVerification(
+	userRepo repos.UserRepository,
+	signer vo.EmailVerificationSigner,
+	mailer vo.Mailer,
+	settings EmailVerificationSettings) *ResendEmailVerification {
+	return &ResendEmailVerification{
+		userRepository: userRepo,
+		verificationMailer: &verificationMailer{
+			signer:   signer,
+			mailer:   mailer,
+			settings: settings,
+		},
+	}
+}
+
+// Execute mengirim ulang link verifikasi. Email yang tidak terdaftar, sudah
+// terverifikasi, masih dalam jeda rate limit, atau gagal dikirim dianggap
+// berhasil, supaya respons tidak membocorkan status akun pemilik email.
+func (r *ResendEmailVerification) Execute(ctx context.Context, input *dto.ResendEmailVerificationInput) error {
+	// 1. Validasi Input
+	emailVO, err := vo.NewEmail(input.Email)
+	if err != nil {
+		return shared.NewValidationError(err.Error())
+	}
+
+	// 2. Mencari user berdasarkan email
+	user, err := r.userRepository.FindByEmail(ctx, emailVO.String())
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if user == nil || user.IsEmailVerified() {
+		return nil
+	}
+
+	// 3. Rate limit: pengiriman terakhir harus lebih lama dari ResendInterval
+	allowed, err := r.userRepository.MarkVerificationSent(ctx, user.ID, time.Now().Add(-r.verificationMailer.settings.ResendInterval))
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if !allowed {
+		return nil // ✅ Lewati pengiriman tanpa memberi tahu bahwa akun ada
+	}
+
+	// 4. Mengirim link verifikasi baru
+	if err := r.verificationMailer.send(ctx, user); err != nil {
+		log.Printf("❌ Verification mail for user %s failed: %v", user.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+)
+
+func setupResendEmailVerificationTest(t *testing.T) (*MockUserRepository, *MockEmailVerificationSigner, *MockMailer, *usecases.ResendEmailVerification) {
+	t.Helper()
+	userRepoMock := new(MockUserRepository)
+	signerMock := new(MockEmailVerificationSigner)
+	mailerMock := new(MockMailer)
+
+	useCase := usecases.NewResendEmailVerification(
+		userRepoMock,
+		signerMock,
+		mailerMock,
+		usecases.EmailVerificationSettings{VerifyURL: "https://news.example.com/verify-email", ResendInterval: 5 * time.Minute},
+	)
+
+	return userRepoMock, signerMock, mailerMock, useCase
+}
+
+func TestResendEmailVerification(t *testing.T) {
+	input := dto.ResendEmailVerificationInput{Email: "joko@test.com"}
+
+	// cooldownStart memastikan batas cooldown sesuai ResendInterval
+	cooldownStart := mock.MatchedBy(func(sentBefore time.Time) bool {
+		age := time.Since(sentBefore)
+		return age >= 5*time.Minute && age < 5*time.Minute+time.Second
+	})
+
+	t.Run("should resend the link when outside the cooldown", func(t *testing.T) {
+		userRepoMock, signerMock, mailerMock, useCase := setupResendEmailVerificationTest(t)
+
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		user.EmailVerifiedAt = nil
+
+		userRepoMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil).Once()
+		userRepoMock.On("MarkVerificationSent", mock.Anything, user.ID, cooldownStart).Return(true, nil).Once()
+		signerMock.On("SignEmailVerification", user.ID, input.Email).Return(newVerificationToken("signed.jwt"), nil).Once()
+		mailerMock.On("Send", mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		userRepoMock.AssertExpectations(t)
+		signerMock.AssertExpectations(t)
+		mailerMock.AssertExpectations(t)
+	})
+
+	t.Run("should silently skip resends inside the cooldown", func(t *testing.T) {
+		userRepoMock, _, mailerMock, useCase := setupResendEmailVerificationTest(t)
+
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		user.EmailVerifiedAt = nil
+
+		userRepoMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil).Once()
+		userRepoMock.On("MarkVerificationSent", mock.Anything, user.ID, cooldownStart).Return(false, nil).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		mailerMock.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not reveal mail delivery failures", func(t *testing.T) {
+		userRepoMock, signerMock, mailerMock, useCase := setupResendEmailVerificationTest(t)
+
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		user.EmailVerifiedAt = nil
+
+		userRepoMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil).Once()
+		userRepoMock.On("MarkVerificationSent", mock.Anything, user.ID, cooldownStart).Return(true, nil).Once()
+		signerMock.On("SignEmailVerification", user.ID, input.Email).Return(newVerificationToken("signed.jwt"), nil).Once()
+		mailerMock.On("Send", mock.Anything, mock.Anything).Return(assert.AnError).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		mailerMock.AssertExpectations(t)
+	})
+
+	t.Run("should not send anything for unknown or verified emails", func(t *testing.T) {
+		verified := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+
+		for _, user := range []interface{}{nil, verified} {
+			userRepoMock, _, mailerMock, useCase := setupResendEmailVerificationTest(t)
+			userRepoMock.On("FindByEmail", mock.Anything, input.Email).Return(user, nil).Once()
+
+			err := useCase.Execute(context.Background(), &input)
+
+			assert.Nil(t, err)
+			userRepoMock.AssertNotCalled(t, "MarkVerificationSent", mock.Anything, mock.Anything, mock.Anything)
+			mailerMock.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+		}
+	})
+}
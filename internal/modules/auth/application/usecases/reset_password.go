@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const invalidResetTokenMessage = "Invalid or expired reset token"
+
+// ResetPassword adalah use case untuk mengganti password memakai token reset.
+// Setelah berhasil, seluruh sesi (refresh token) user dicabut.
+type ResetPassword struct {
+	txManager                    shared.TxManager
+	userRepository               repos.UserRepository
+	passwordResetTokenRepository repos.PasswordResetTokenRepository
+	refreshTokenRepository       repos.RefreshTokenRepository
+	tokenManager                 vo.TokenManager
+	hasher                       vo.Hasher
+}
+
+// NewResetPassword adalah konstruktor untuk use case ini.
+func NewResetPassword(
+	userRepo repos.UserRepository,
+	resetTokenRepo repos.PasswordResetTokenRepository,
+	refreshTokenRepo repos.RefreshTokenRepository,
+	tokenManager vo.TokenManager,
+	hasher vo.Hasher,
+	txManager shared.TxManager) *ResetPassword {
+	return &ResetPassword{
+		txManager:                    txManager,
+		userRepository:               userRepo,
+		passwordResetTokenRepository: resetTokenRepo,
+		refreshTokenRepository:       refreshTokenRepo,
+		tokenManager:                 tokenManager,
+		hasher:                       hasher,
+	}
+}
+
+// Execute memvalidasi token reset lalu menyimpan hash password baru.
+func (r *ResetPassword) Execute(ctx context.Context, input *dto.ResetPasswordInput) error {
+	// 1. Validasi Input
+	if strings.TrimSpace(input.Token) == "" {
+		return shared.NewValidationError("reset token cannot be empty")
+	}
+
+	if _, err := vo.NewPassword(input.Password); err != nil {
+		return shared.NewValidationError(err.Error())
+	}
+
+	// 2. Mencari token berdasarkan hash
+	token, err := r.passwordResetTokenRepository.FindByHash(ctx, r.tokenManager.HashToken(input.Token))
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if token == nil || token.IsUsed() || token.IsExpired(time.Now()) {
+		return shared.NewValidationError(invalidResetTokenMessage)
+	}
+
+	// ✅ bcrypt lambat, jadi hash dihitung sebelum transaksi agar row token
+	// tidak terkunci selama hashing
+	hashedPassword, err := r.hasher.Hash(input.Password)
+	if err != nil {
+		return err
+	}
+
+	// 3-5 dijalankan dalam satu transaksi: token tidak hangus jika password
+	// gagal disimpan, dan sesi lama pasti dicabut bersama password baru
+	return r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		// 3. Menandai token terpakai; request paralel dengan token yang sama kalah di sini
+		marked, err := r.passwordResetTokenRepository.MarkUsed(ctx, token.ID)
+		if err != nil {
+			return shared.NewDatabaseError(err)
+		}
+		if !marked {
+			return shared.NewValidationError(invalidResetTokenMessage)
+		}
+
+		// 4. Menyimpan hash password baru
+		user, err := r.userRepository.FindByID(ctx, token.UserID)
+		if err != nil {
+			return shared.NewDatabaseError(err)
+		}
+		if user == nil {
+			return shared.NewValidationError(invalidResetTokenMessage)
+		}
+
+		user.ChangePassword(hashedPassword)
+		if _, err := r.userRepository.Update(ctx, user); err != nil {
+			return shared.NewDatabaseError(err)
+		}
+
+		// 5. Mencabut seluruh sesi dan token reset lain milik user
+		if err := r.refreshTokenRepository.RevokeAllForUser(ctx, user.ID); err != nil {
+			return shared.NewDatabaseError(err)
+		}
+		if err := r.passwordResetTokenRepository.InvalidateForUser(ctx, user.ID); err != nil {
+			return shared.NewDatabaseError(err)
+		}
+
+		return nil
+	})
+}
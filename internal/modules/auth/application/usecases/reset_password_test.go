@@ -0,0 +1,147 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func setupResetPasswordTest(t *testing.T) (*MockUserRepository, *MockPasswordResetTokenRepository, *MockRefreshTokenRepository, *MockHasher, *shared.InMemoryTxManager, *usecases.ResetPassword) {
+	t.Helper()
+	userRepoMock := new(MockUserRepository)
+	resetTokenRepoMock := new(MockPasswordResetTokenRepository)
+	refreshTokenRepoMock := new(MockRefreshTokenRepository)
+	tokenManagerMock := new(MockTokenManager)
+	hasherMock := new(MockHasher)
+	txManager := shared.NewInMemoryTxManager()
+
+	tokenManagerMock.On("HashToken", "raw-token").Return("reset-hash").Maybe()
+
+	useCase := usecases.NewResetPassword(userRepoMock, resetTokenRepoMock, refreshTokenRepoMock, tokenManagerMock, hasherMock, txManager)
+
+	return userRepoMock, resetTokenRepoMock, refreshTokenRepoMock, hasherMock, txManager, useCase
+}
+
+func TestResetPassword(t *testing.T) {
+	input := dto.ResetPasswordInput{Token: "raw-token", Password: "newpassword123"}
+
+	t.Run("should change password and revoke every session", func(t *testing.T) {
+		userRepoMock, resetTokenRepoMock, refreshTokenRepoMock, hasherMock, txManager, useCase := setupResetPasswordTest(t)
+
+		token := entities.NewPasswordResetToken("reset-uuid", "user-uuid", "reset-hash", time.Now().Add(time.Hour))
+		user := newStoredUser(t, "user-uuid", "$2a$12$oldhash")
+
+		resetTokenRepoMock.On("FindByHash", mock.Anything, "reset-hash").Return(token, nil).Once()
+		resetTokenRepoMock.On("MarkUsed", mock.Anything, token.ID).Return(true, nil).Once()
+		userRepoMock.On("FindByID", mock.Anything, user.ID).Return(user, nil).Once()
+		hasherMock.On("Hash", input.Password).Return("$2a$12$newhash", nil).Once()
+		userRepoMock.On("Update", mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+			return u.HashedPassword == "$2a$12$newhash"
+		})).Return(user, nil).Once()
+		refreshTokenRepoMock.On("RevokeAllForUser", mock.Anything, user.ID).Return(nil).Once()
+		resetTokenRepoMock.On("InvalidateForUser", mock.Anything, user.ID).Return(nil).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, txManager.Commits)
+		userRepoMock.AssertExpectations(t)
+		resetTokenRepoMock.AssertExpectations(t)
+		refreshTokenRepoMock.AssertExpectations(t)
+		hasherMock.AssertExpectations(t)
+	})
+
+	t.Run("should reject unknown, expired and used tokens", func(t *testing.T) {
+		used := entities.NewPasswordResetToken("reset-uuid", "user-uuid", "reset-hash", time.Now().Add(time.Hour))
+		usedAt := time.Now()
+		used.UsedAt = &usedAt
+
+		tokens := map[string]*entities.PasswordResetToken{
+			"unknown": nil,
+			"expired": entities.NewPasswordResetToken("reset-uuid", "user-uuid", "reset-hash", time.Now().Add(-time.Minute)),
+			"used":    used,
+		}
+
+		for name, token := range tokens {
+			t.Run(name, func(t *testing.T) {
+				_, resetTokenRepoMock, _, hasherMock, _, useCase := setupResetPasswordTest(t)
+
+				resetTokenRepoMock.On("FindByHash", mock.Anything, "reset-hash").Return(token, nil).Once()
+
+				err := useCase.Execute(context.Background(), &input)
+
+				assert.NotNil(t, err)
+				assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+				resetTokenRepoMock.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything)
+				hasherMock.AssertNotCalled(t, "Hash", mock.Anything)
+			})
+		}
+	})
+
+	t.Run("should reject a token consumed by a concurrent request", func(t *testing.T) {
+		userRepoMock, resetTokenRepoMock, _, hasherMock, _, useCase := setupResetPasswordTest(t)
+
+		token := entities.NewPasswordResetToken("reset-uuid", "user-uuid", "reset-hash", time.Now().Add(time.Hour))
+		resetTokenRepoMock.On("FindByHash", mock.Anything, "reset-hash").Return(token, nil).Once()
+		hasherMock.On("Hash", input.Password).Return("$2a$12$newhash", nil).Once()
+		resetTokenRepoMock.On("MarkUsed", mock.Anything, token.ID).Return(false, nil).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		userRepoMock.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should roll back the used token when the password cannot be saved", func(t *testing.T) {
+		userRepoMock, resetTokenRepoMock, refreshTokenRepoMock, hasherMock, txManager, useCase := setupResetPasswordTest(t)
+
+		token := entities.NewPasswordResetToken("reset-uuid", "user-uuid", "reset-hash", time.Now().Add(time.Hour))
+		user := newStoredUser(t, "user-uuid", "$2a$12$oldhash")
+
+		resetTokenRepoMock.On("FindByHash", mock.Anything, "reset-hash").Return(token, nil).Once()
+		resetTokenRepoMock.On("MarkUsed", mock.Anything, token.ID).Return(true, nil).Once()
+		userRepoMock.On("FindByID", mock.Anything, user.ID).Return(user, nil).Once()
+		hasherMock.On("Hash", input.Password).Return("$2a$12$newhash", nil).Once()
+		userRepoMock.On("Update", mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 1, txManager.Rollbacks)
+		assert.Equal(t, 0, txManager.Commits)
+		refreshTokenRepoMock.AssertNotCalled(t, "RevokeAllForUser", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should hash the password before opening the transaction", func(t *testing.T) {
+		_, resetTokenRepoMock, _, hasherMock, txManager, useCase := setupResetPasswordTest(t)
+
+		token := entities.NewPasswordResetToken("reset-uuid", "user-uuid", "reset-hash", time.Now().Add(time.Hour))
+		resetTokenRepoMock.On("FindByHash", mock.Anything, "reset-hash").Return(token, nil).Once()
+		hasherMock.On("Hash", input.Password).Return("", assert.AnError).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 0, txManager.Commits+txManager.Rollbacks)
+		resetTokenRepoMock.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should validate the new password before touching the token", func(t *testing.T) {
+		_, resetTokenRepoMock, _, _, _, useCase := setupResetPasswordTest(t)
+
+		err := useCase.Execute(context.Background(), &dto.ResetPasswordInput{Token: "raw-token", Password: "short"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		resetTokenRepoMock.AssertNotCalled(t, "FindByHash", mock.Anything, mock.Anything)
+	})
+}
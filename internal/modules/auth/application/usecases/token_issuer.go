@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// tokenIssuer menerbitkan pasangan access/refresh token dan menyimpan
+// hash refresh token. Dipakai bersama oleh LoginUser dan RefreshToken.
+type tokenIssuer struct {
+	tokenManager           vo.TokenManager
+	refreshTokenRepository repos.RefreshTokenRepository
+}
+
+// issue menerbitkan token baru. familyID kosong berarti family baru (login).
+func (i *tokenIssuer) issue(ctx context.Context, user *entities.User, familyID string) (*dto.TokenPairOutput, error) {
+	// ✅ Role user ikut dibawa di klaim access token
+	accessToken, err := i.tokenManager.GenerateAccessToken(user.ID, user.RoleNames())
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := i.tokenManager.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if familyID == "" {
+		familyID = refreshToken.ID
+	}
+
+	stored := entities.NewRefreshToken(
+		refreshToken.ID,
+		user.ID,
+		familyID,
+		i.tokenManager.HashToken(refreshToken.Value),
+		refreshToken.ExpiresAt,
+	)
+	if err := i.refreshTokenRepository.Save(ctx, stored); err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	return &dto.TokenPairOutput{
+		AccessToken:           accessToken.Value,
+		RefreshToken:          refreshToken.Value,
+		TokenType:             "Bearer",
+		ExpiresIn:             int64(accessToken.ExpiresAt.Sub(accessToken.IssuedAt).Seconds()),
+		AccessTokenExpiresAt:  accessToken.ExpiresAt,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	}, nil
+}
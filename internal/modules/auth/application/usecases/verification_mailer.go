@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+)
+
+// EmailVerificationSettings mengatur link dan rate limit email verifikasi.
+type EmailVerificationSettings struct {
+	// VerifyURL adalah halaman frontend yang menerima query ?token=.
+	VerifyURL string
+	// ResendInterval adalah jeda minimal antar pengiriman ulang per user.
+	ResendInterval time.Duration
+}
+
+// verificationMailer menandatangani link verifikasi dan mengirimkannya ke
+// email user. Dipakai bersama oleh RegisterUser dan ResendEmailVerification.
+type verificationMailer struct {
+	signer   vo.EmailVerificationSigner
+	mailer   vo.Mailer
+	settings EmailVerificationSettings
+}
+
+func (v *verificationMailer) send(ctx context.Context, user *entities.User) error {
+	token, err := v.signer.SignEmailVerification(user.ID, user.Email.String())
+	if err != nil {
+		return err
+	}
+
+	message := vo.MailMessage{
+		To:      user.Email.String(),
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s. If you did not create an account, you can ignore this email.\n",
+			user.Username.String(),
+			tokenLink(v.settings.VerifyURL, token.Value),
+			token.ExpiresAt.Sub(token.IssuedAt),
+		),
+	}
+	if err := v.mailer.Send(ctx, message); err != nil {
+		return fmt.Errorf("%w: %v", vo.ErrMailDelivery, err)
+	}
+
+	return nil
+}
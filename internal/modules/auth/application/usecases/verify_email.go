@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const invalidVerificationLinkMessage = "Invalid verification link"
+
+// VerifyEmail adalah use case untuk menandai email user terverifikasi dari link yang ditandatangani.
+type VerifyEmail struct {
+	userRepository repos.UserRepository
+	signer         vo.EmailVerificationSigner
+}
+
+// NewVerifyEmail adalah konstruktor untuk use case ini.
+func NewVerifyEmail(userRepo repos.UserRepository, signer vo.EmailVerificationSigner) *VerifyEmail {
+	return &VerifyEmail{
+		userRepository: userRepo,
+		signer:         signer,
+	}
+}
+
+// Execute memverifikasi signature link lalu menyimpan waktu verifikasi.
+// Link yang sama boleh dibuka lebih dari sekali.
+func (v *VerifyEmail) Execute(ctx context.Context, input *dto.VerifyEmailInput) error {
+	// 1. Validasi Input
+	if strings.TrimSpace(input.Token) == "" {
+		return shared.NewValidationError("verification token cannot be empty")
+	}
+
+	// 2. Memverifikasi signature dan masa berlaku link
+	claims, err := v.signer.ParseEmailVerification(input.Token)
+	if errors.Is(err, vo.ErrTokenExpired) {
+		return shared.NewValidationError("Verification link has expired, please request a new one")
+	}
+	if err != nil {
+		return shared.NewValidationError(invalidVerificationLinkMessage)
+	}
+
+	// 3. Mencari user; link untuk email lama tidak berlaku lagi
+	user, err := v.userRepository.FindByID(ctx, claims.UserID)
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if user == nil || !strings.EqualFold(user.Email.String(), claims.Email) {
+		return shared.NewValidationError(invalidVerificationLinkMessage)
+	}
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	// 4. Menyimpan waktu verifikasi
+	user.VerifyEmail(time.Now())
+	if _, err := v.userRepository.Update(ctx, user); err != nil {
+		return shared.NewDatabaseError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type MockEmailVerificationSigner struct {
+	mock.Mock
+}
+
+func (m *MockEmailVerificationSigner) SignEmailVerification(userID, email string) (*vo.Token, error) {
+	args := m.Called(userID, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*vo.Token), args.Error(1)
+}
+
+func (m *MockEmailVerificationSigner) ParseEmailVerification(value string) (*vo.EmailVerificationClaims, error) {
+	args := m.Called(value)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*vo.EmailVerificationClaims), args.Error(1)
+}
+
+func newVerificationToken(value string) *vo.Token {
+	now := time.Now()
+	return &vo.Token{Value: value, Type: vo.TokenTypeEmailVerification, IssuedAt: now, ExpiresAt: now.Add(24 * time.Hour)}
+}
+
+func setupVerifyEmailTest(t *testing.T) (*MockUserRepository, *MockEmailVerificationSigner, *usecases.VerifyEmail) {
+	t.Helper()
+	userRepoMock := new(MockUserRepository)
+	signerMock := new(MockEmailVerificationSigner)
+
+	return userRepoMock, signerMock, usecases.NewVerifyEmail(userRepoMock, signerMock)
+}
+
+func TestVerifyEmail(t *testing.T) {
+	input := dto.VerifyEmailInput{Token: "signed.jwt"}
+	claims := &vo.EmailVerificationClaims{UserID: "user-uuid", Email: "joko@test.com", ExpiresAt: time.Now().Add(time.Hour)}
+
+	t.Run("should mark the email as verified", func(t *testing.T) {
+		userRepoMock, signerMock, useCase := setupVerifyEmailTest(t)
+
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		user.EmailVerifiedAt = nil
+
+		signerMock.On("ParseEmailVerification", input.Token).Return(claims, nil).Once()
+		userRepoMock.On("FindByID", mock.Anything, "user-uuid").Return(user, nil).Once()
+		userRepoMock.On("Update", mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+			return u.IsEmailVerified()
+		})).Return(user, nil).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		userRepoMock.AssertExpectations(t)
+		signerMock.AssertExpectations(t)
+	})
+
+	t.Run("should be idempotent for an already verified email", func(t *testing.T) {
+		userRepoMock, signerMock, useCase := setupVerifyEmailTest(t)
+
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		signerMock.On("ParseEmailVerification", input.Token).Return(claims, nil).Once()
+		userRepoMock.On("FindByID", mock.Anything, "user-uuid").Return(user, nil).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.Nil(t, err)
+		userRepoMock.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject a link issued for a previous email", func(t *testing.T) {
+		userRepoMock, signerMock, useCase := setupVerifyEmailTest(t)
+
+		user := newStoredUser(t, "user-uuid", "$2a$12$hashedpassword")
+		user.EmailVerifiedAt = nil
+		oldClaims := &vo.EmailVerificationClaims{UserID: "user-uuid", Email: "old@test.com", ExpiresAt: time.Now().Add(time.Hour)}
+
+		signerMock.On("ParseEmailVerification", input.Token).Return(oldClaims, nil).Once()
+		userRepoMock.On("FindByID", mock.Anything, "user-uuid").Return(user, nil).Once()
+
+		err := useCase.Execute(context.Background(), &input)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		userRepoMock.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject expired and tampered links", func(t *testing.T) {
+		for _, parseErr := range []error{vo.ErrTokenExpired, vo.ErrTokenInvalid} {
+			userRepoMock, signerMock, useCase := setupVerifyEmailTest(t)
+			signerMock.On("ParseEmailVerification", input.Token).Return(nil, parseErr).Once()
+
+			err := useCase.Execute(context.Background(), &input)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+			userRepoMock.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+		}
+	})
+}
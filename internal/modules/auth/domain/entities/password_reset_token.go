@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+// PasswordResetToken menyimpan hash dari token reset password yang dikirim
+// lewat email. Token hanya bisa dipakai sekali dan berlaku sampai ExpiresAt.
+type PasswordResetToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func NewPasswordResetToken(id, userID, tokenHash string, expiresAt time.Time) *PasswordResetToken {
+	return &PasswordResetToken{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (t *PasswordResetToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+func (t *PasswordResetToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
@@ -0,0 +1,40 @@
+package entities
+
+import "time"
+
+// RefreshToken menyimpan hash dari refresh token yang diterbitkan.
+// Semua token hasil rotasi dari satu login berbagi FamilyID yang sama,
+// sehingga satu family dapat dicabut sekaligus saat terdeteksi reuse.
+type RefreshToken struct {
+	ID        string
+	UserID    string
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func NewRefreshToken(id, userID, familyID, tokenHash string, expiresAt time.Time) *RefreshToken {
+	return &RefreshToken{
+		ID:        id,
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+func (t *RefreshToken) IsRotated() bool {
+	return t.RotatedAt != nil
+}
+
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
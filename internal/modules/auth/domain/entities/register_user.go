@@ -3,7 +3,9 @@ package entities
 import (
 	"time"
 
+	events "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/events"
 	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
 )
 
 type User struct {
@@ -11,19 +13,90 @@ type User struct {
 	Username vo.Username
 	Email vo.Email
 	HashedPassword string
+	Roles []vo.Role
+	EmailVerifiedAt *time.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	shared.EventRecorder
 }
 
 func NewUser(id string, username vo.Username, email vo.Email, hashedPassword string) (*User, error) {
 	now := time.Now()
-	return &User{
+	user := &User{
 		ID: id,
 		Username: username,
 		Email: email,
 		HashedPassword: hashedPassword,
+		Roles: []vo.Role{vo.DefaultRole()},
 		CreatedAt: now,
 		UpdatedAt: now,
-	}, nil
+	}
+
+	user.RecordEvent(events.UserRegistered{
+		UserID:   id,
+		Username: username.String(),
+		Email:    email.String(),
+		At:       now,
+	})
+
+	return user, nil
+}
+
+// RoleNames mengembalikan nama role user, dipakai untuk klaim JWT.
+func (u *User) RoleNames() []string {
+	names := make([]string, 0, len(u.Roles))
+	for _, role := range u.Roles {
+		names = append(names, role.String())
+	}
+	return names
+}
+
+func (u *User) HasRole(name string) bool {
+	for _, role := range u.Roles {
+		if role.String() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEmailVerified menandakan user sudah membuka link verifikasi email.
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// VerifyEmail menandai email user terverifikasi. Verifikasi ulang tidak mengubah waktu sebelumnya.
+func (u *User) VerifyEmail(now time.Time) {
+	if u.EmailVerifiedAt != nil {
+		return
+	}
+	u.EmailVerifiedAt = &now
+	u.UpdatedAt = now
+}
+
+// ChangePassword mengganti hash password user.
+func (u *User) ChangePassword(hashedPassword string) {
+	u.HashedPassword = hashedPassword
+	u.UpdatedAt = time.Now()
 }
 
+// AssignRoles mengganti seluruh role user. User minimal harus memiliki satu role.
+func (u *User) AssignRoles(roles []vo.Role) error {
+	if len(roles) == 0 {
+		return vo.ErrRoleEmpty
+	}
+
+	unique := make([]vo.Role, 0, len(roles))
+	seen := make(map[string]bool)
+	for _, role := range roles {
+		if !seen[role.String()] {
+			seen[role.String()] = true
+			unique = append(unique, role)
+		}
+	}
+
+	u.Roles = unique
+	u.UpdatedAt = time.Now()
+	return nil
+}
@@ -3,6 +3,7 @@ package entities_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
 	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
@@ -68,6 +69,10 @@ func TestNewUserRegister(t *testing.T) {
 			t.Errorf("Expected hashed password to be '%s', but got '%s'", expectedHashedPassword, user.HashedPassword)
 		}
 
+		if !user.HasRole(vo.RoleReader) || len(user.Roles) != 1 {
+			t.Errorf("Expected new user to have only the reader role, but got %v", user.RoleNames())
+		}
+
 		if user.CreatedAt.IsZero() {
 			t.Error("Expected CreatedAt to be set, but it's zero")
 		}
@@ -101,4 +106,51 @@ func TestNewUserRegister(t *testing.T) {
 		if err == nil { t.Error("Expected an error for short password, but got nil") }
 		if !errors.Is(err, vo.ErrPasswordInvalidLength) { t.Errorf("Expected error ErrPasswordInvalidLength, but got %v", err) }
 	})
-}
\ No newline at end of file
+}
+
+func TestUserAssignRoles(t *testing.T) {
+	t.Run("should replace roles and drop duplicates", func(t *testing.T) {
+		user := CreateValidUser(t)
+		editor, _ := vo.NewRole(vo.RoleEditor)
+		author, _ := vo.NewRole(vo.RoleAuthor)
+
+		err := user.AssignRoles([]vo.Role{*editor, *author, *editor})
+
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if len(user.Roles) != 2 || !user.HasRole(vo.RoleEditor) || !user.HasRole(vo.RoleAuthor) {
+			t.Errorf("Expected roles [editor author], but got %v", user.RoleNames())
+		}
+		if user.HasRole(vo.RoleReader) {
+			t.Error("Expected reader role to be replaced")
+		}
+	})
+
+	t.Run("should return an error for empty roles", func(t *testing.T) {
+		user := CreateValidUser(t)
+
+		err := user.AssignRoles(nil)
+
+		if !errors.Is(err, vo.ErrRoleEmpty) {
+			t.Errorf("Expected error ErrRoleEmpty, but got %v", err)
+		}
+	})
+}
+
+func TestUserVerifyEmail(t *testing.T) {
+	t.Run("should start unverified and keep the first verification time", func(t *testing.T) {
+		user := CreateValidUser(t)
+		if user.IsEmailVerified() {
+			t.Fatal("Expected new user to be unverified")
+		}
+
+		first := time.Now()
+		user.VerifyEmail(first)
+		user.VerifyEmail(first.Add(time.Hour))
+
+		if !user.IsEmailVerified() || !user.EmailVerifiedAt.Equal(first) {
+			t.Errorf("Expected email verified at %v, but got %v", first, user.EmailVerifiedAt)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package events
+
+import "time"
+
+const UserRegisteredEvent = "user.registered"
+
+// UserRegistered dicatat saat akun baru dibuat.
+type UserRegistered struct {
+	UserID   string    `json:"user_id"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+	At       time.Time `json:"occurred_at"`
+}
+
+func (e UserRegistered) EventName() string {
+	return UserRegisteredEvent
+}
+
+func (e UserRegistered) OccurredAt() time.Time {
+	return e.At
+}
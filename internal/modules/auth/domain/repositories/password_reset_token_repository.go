@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+)
+
+type PasswordResetTokenRepository interface {
+	Save(ctx context.Context, token *entities.PasswordResetToken) error
+	FindByHash(ctx context.Context, tokenHash string) (*entities.PasswordResetToken, error)
+	// MarkUsed menandai token sudah dipakai. Mengembalikan false jika token
+	// sudah dipakai sebelumnya (misalnya oleh request paralel).
+	MarkUsed(ctx context.Context, id string) (bool, error)
+	// InvalidateForUser menandai seluruh token user yang belum terpakai sebagai terpakai.
+	InvalidateForUser(ctx context.Context, userID string) error
+}
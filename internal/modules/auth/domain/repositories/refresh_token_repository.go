@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+)
+
+type RefreshTokenRepository interface {
+	Save(ctx context.Context, token *entities.RefreshToken) error
+	FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+	// MarkRotated menandai token sebagai sudah dirotasi. Mengembalikan false
+	// jika token sudah dirotasi atau dicabut sebelumnya (misalnya oleh request paralel).
+	MarkRotated(ctx context.Context, id string) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	// RevokeAllForUser mencabut seluruh refresh token user, mis. setelah reset password.
+	RevokeAllForUser(ctx context.Context, userID string) error
+}
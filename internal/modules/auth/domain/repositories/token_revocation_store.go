@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// TokenRevocationStore menyimpan jti dari access token yang sudah dicabut
+// sampai token tersebut kedaluwarsa dengan sendirinya.
+type TokenRevocationStore interface {
+	Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
 )
@@ -14,4 +15,10 @@ type UserRepository interface {
 	FindAll(ctx context.Context) ([]*entities.User, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	Delete(ctx context.Context, id string) error
+	// LockAdminIDs mengunci baris role admin sampai transaksi selesai dan
+	// mengembalikan ID user admin. Harus dipanggil di dalam WithinTx.
+	LockAdminIDs(ctx context.Context) ([]string, error)
+	// MarkVerificationSent mencatat pengiriman email verifikasi jika pengiriman
+	// terakhir sebelum sentBefore. Mengembalikan false jika masih dalam cooldown.
+	MarkVerificationSent(ctx context.Context, id string, sentBefore time.Time) (bool, error)
 }
\ No newline at end of file
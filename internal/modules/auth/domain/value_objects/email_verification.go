@@ -0,0 +1,18 @@
+package valueobjects
+
+import "time"
+
+// EmailVerificationClaims adalah isi link verifikasi email yang sudah diverifikasi signature-nya.
+type EmailVerificationClaims struct {
+	UserID    string
+	Email     string
+	ExpiresAt time.Time
+}
+
+// EmailVerificationSigner menandatangani link verifikasi email. Email ikut
+// ditandatangani supaya link lama tidak berlaku setelah email diganti.
+type EmailVerificationSigner interface {
+	SignEmailVerification(userID, email string) (*Token, error)
+	// ParseEmailVerification mengembalikan ErrTokenExpired atau ErrTokenInvalid jika gagal.
+	ParseEmailVerification(value string) (*EmailVerificationClaims, error)
+}
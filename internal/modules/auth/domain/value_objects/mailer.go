@@ -0,0 +1,20 @@
+package valueobjects
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrMailDelivery = errors.New("mail delivery failed")
+
+// MailMessage adalah email teks biasa.
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer mengirim email transaksional (reset password, verifikasi email).
+type Mailer interface {
+	Send(ctx context.Context, message MailMessage) error
+}
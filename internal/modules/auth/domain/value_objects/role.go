@@ -0,0 +1,134 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+type Role struct {
+	value string
+}
+
+type Permission string
+
+const (
+	RoleAdmin       = "admin"
+	RoleEditor      = "editor"
+	RoleAuthor      = "author"
+	RoleContributor = "contributor"
+	RoleReader      = "reader"
+)
+
+const (
+	PermissionArticleRead      Permission = "article:read"
+	PermissionArticleCreate    Permission = "article:create"
+	PermissionArticleUpdate    Permission = "article:update"     // artikel milik sendiri
+	PermissionArticleUpdateAny Permission = "article:update_any" // artikel milik siapa saja
+	PermissionArticleDelete    Permission = "article:delete"
+	PermissionArticleSubmit    Permission = "article:submit"
+	PermissionArticleReview    Permission = "article:review"
+	PermissionArticlePublish   Permission = "article:publish"
+	PermissionCategoryManage   Permission = "category:manage"
+	PermissionTagManage        Permission = "tag:manage"
+	PermissionTagMerge         Permission = "tag:merge"
+	PermissionMediaUpload      Permission = "media:upload"
+	PermissionCommentCreate    Permission = "comment:create"
+	PermissionCommentModerate  Permission = "comment:moderate"
+	PermissionUserManage       Permission = "user:manage"
+)
+
+// rolePermissions adalah matriks hak akses per role. Editor redaksi dan
+// kontributor lepas sengaja dibedakan: kontributor hanya bisa menulis dan
+// mengajukan artikelnya sendiri untuk direview.
+var rolePermissions = map[string][]Permission{
+	RoleAdmin: {
+		PermissionArticleRead, PermissionArticleCreate, PermissionArticleUpdate, PermissionArticleUpdateAny,
+		PermissionArticleDelete, PermissionArticleSubmit, PermissionArticleReview, PermissionArticlePublish,
+		PermissionCategoryManage, PermissionTagManage, PermissionTagMerge, PermissionMediaUpload,
+		PermissionCommentCreate, PermissionCommentModerate, PermissionUserManage,
+	},
+	RoleEditor: {
+		PermissionArticleRead, PermissionArticleCreate, PermissionArticleUpdate, PermissionArticleUpdateAny,
+		PermissionArticleDelete, PermissionArticleSubmit, PermissionArticleReview, PermissionArticlePublish,
+		PermissionCategoryManage, PermissionTagManage, PermissionMediaUpload,
+		PermissionCommentCreate, PermissionCommentModerate,
+	},
+	RoleAuthor: {
+		PermissionArticleRead, PermissionArticleCreate, PermissionArticleUpdate, PermissionArticleSubmit,
+		PermissionTagManage, PermissionMediaUpload, PermissionCommentCreate,
+	},
+	RoleContributor: {
+		PermissionArticleRead, PermissionArticleCreate, PermissionArticleUpdate, PermissionArticleSubmit,
+		PermissionCommentCreate,
+	},
+	RoleReader: {
+		PermissionArticleRead, PermissionCommentCreate,
+	},
+}
+
+var (
+	ErrRoleEmpty   = errors.New("role cannot be empty")
+	ErrRoleInvalid = errors.New("role must be one of admin, editor, author, contributor, reader")
+)
+
+func NewRole(value string) (*Role, error) {
+	payload := strings.ToLower(strings.TrimSpace(value))
+
+	if payload == "" {
+		return nil, ErrRoleEmpty
+	}
+
+	if _, ok := rolePermissions[payload]; !ok {
+		return nil, ErrRoleInvalid
+	}
+
+	return &Role{value: payload}, nil
+}
+
+// DefaultRole adalah role untuk akun yang mendaftar sendiri.
+func DefaultRole() Role {
+	return Role{value: RoleReader}
+}
+
+func (r *Role) String() string {
+	return r.value
+}
+
+func (r *Role) Value() string {
+	return r.value
+}
+
+func (r *Role) Permissions() []Permission {
+	return rolePermissions[r.value]
+}
+
+func (r *Role) HasPermission(permission Permission) bool {
+	for _, p := range r.Permissions() {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// PermissionsForRoles menggabungkan permission dari beberapa role tanpa duplikasi.
+// Role yang tidak dikenal diabaikan.
+func PermissionsForRoles(roles []string) []string {
+	seen := make(map[Permission]bool)
+	var permissions []string
+
+	for _, name := range roles {
+		role, err := NewRole(name)
+		if err != nil {
+			continue
+		}
+		for _, p := range role.Permissions() {
+			if !seen[p] {
+				seen[p] = true
+				permissions = append(permissions, string(p))
+			}
+		}
+	}
+
+	return permissions
+}
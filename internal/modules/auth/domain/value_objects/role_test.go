@@ -0,0 +1,74 @@
+package valueobjects_test
+
+import (
+	"errors"
+	"testing"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+)
+
+func TestNewRole(t *testing.T) {
+	t.Run("should normalize a valid role", func(t *testing.T) {
+		role, err := vo.NewRole("  Editor ")
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if role.String() != vo.RoleEditor {
+			t.Errorf("Expected role to be 'editor', but got '%s'", role.String())
+		}
+	})
+
+	t.Run("should return an error for empty role", func(t *testing.T) {
+		_, err := vo.NewRole("")
+		if !errors.Is(err, vo.ErrRoleEmpty) {
+			t.Errorf("Expected error ErrRoleEmpty, but got %v", err)
+		}
+	})
+
+	t.Run("should return an error for unknown role", func(t *testing.T) {
+		_, err := vo.NewRole("superuser")
+		if !errors.Is(err, vo.ErrRoleInvalid) {
+			t.Errorf("Expected error ErrRoleInvalid, but got %v", err)
+		}
+	})
+}
+
+func TestRolePermissions(t *testing.T) {
+	t.Run("editors can publish but contributors cannot", func(t *testing.T) {
+		editor, _ := vo.NewRole(vo.RoleEditor)
+		contributor, _ := vo.NewRole(vo.RoleContributor)
+
+		if !editor.HasPermission(vo.PermissionArticlePublish) {
+			t.Error("Expected editor to have article:publish")
+		}
+		if contributor.HasPermission(vo.PermissionArticlePublish) {
+			t.Error("Expected contributor not to have article:publish")
+		}
+	})
+
+	t.Run("only admins can manage users", func(t *testing.T) {
+		for _, name := range []string{vo.RoleEditor, vo.RoleAuthor, vo.RoleContributor, vo.RoleReader} {
+			role, _ := vo.NewRole(name)
+			if role.HasPermission(vo.PermissionUserManage) {
+				t.Errorf("Expected %s not to have user:manage", name)
+			}
+		}
+
+		admin, _ := vo.NewRole(vo.RoleAdmin)
+		if !admin.HasPermission(vo.PermissionUserManage) {
+			t.Error("Expected admin to have user:manage")
+		}
+	})
+
+	t.Run("should merge permissions of multiple roles without duplicates", func(t *testing.T) {
+		permissions := vo.PermissionsForRoles([]string{vo.RoleReader, vo.RoleContributor, "unknown"})
+
+		seen := make(map[string]int)
+		for _, p := range permissions {
+			seen[p]++
+		}
+		if seen[string(vo.PermissionArticleCreate)] != 1 || seen[string(vo.PermissionArticleRead)] != 1 {
+			t.Errorf("Expected merged permissions without duplicates, but got %v", permissions)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package valueobjects
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	TokenTypeAccess            = "access"
+	TokenTypeRefresh           = "refresh"
+	TokenTypeEmailVerification = "email_verification"
+)
+
+var (
+	ErrTokenInvalid = errors.New("token is invalid")
+	ErrTokenExpired = errors.New("token has expired")
+)
+
+// Token adalah hasil penerbitan token beserta metadata-nya.
+type Token struct {
+	Value     string
+	ID        string
+	Type      string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// TokenClaims adalah isi access token yang sudah diverifikasi.
+type TokenClaims struct {
+	ID        string
+	UserID    string
+	Roles     []string
+	Type      string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+type TokenManager interface {
+	GenerateAccessToken(userID string, roles []string) (*Token, error)
+	// ParseAccessToken memverifikasi signature, exp, issuer dan audience access token.
+	ParseAccessToken(value string) (*TokenClaims, error)
+	// GenerateRefreshToken menghasilkan refresh token opaque (bukan JWT).
+	// Hanya hash-nya yang disimpan, lihat HashToken.
+	GenerateRefreshToken() (*Token, error)
+	HashToken(value string) string
+}
@@ -0,0 +1,57 @@
+package mail
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+)
+
+// asyncSendTimeout membatasi satu pengiriman di background, karena ctx request
+// sudah tidak berlaku setelah respons dikirim.
+const asyncSendTimeout = time.Minute
+
+// AsyncMailer mengirim email di goroutine terpisah dan langsung mengembalikan
+// nil. Dipakai untuk endpoint yang tidak boleh membocorkan apakah email
+// terdaftar lewat status code atau lamanya respons. Kegagalan hanya dicatat di log.
+type AsyncMailer struct {
+	next     vo.Mailer
+	inFlight sync.WaitGroup
+}
+
+func NewAsyncMailer(next vo.Mailer) *AsyncMailer {
+	return &AsyncMailer{next: next}
+}
+
+func (m *AsyncMailer) Send(ctx context.Context, message vo.MailMessage) error {
+	m.inFlight.Add(1)
+	go func() {
+		defer m.inFlight.Done()
+
+		sendCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), asyncSendTimeout)
+		defer cancel()
+
+		if err := m.next.Send(sendCtx, message); err != nil {
+			log.Printf("❌ Mail to %s failed: %v", message.To, err)
+		}
+	}()
+	return nil
+}
+
+// Wait menunggu semua email yang sedang dikirim, dipakai saat shutdown.
+func (m *AsyncMailer) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		m.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
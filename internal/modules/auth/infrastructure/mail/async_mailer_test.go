@@ -0,0 +1,69 @@
+package mail_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	mail "github.com/jokosaputro95/cms-news-api/internal/modules/auth/infrastructure/mail"
+)
+
+type blockingMailer struct {
+	release chan struct{}
+	sent    chan vo.MailMessage
+	err     error
+}
+
+func (m *blockingMailer) Send(ctx context.Context, message vo.MailMessage) error {
+	<-m.release
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	m.sent <- message
+	return m.err
+}
+
+func TestAsyncMailer(t *testing.T) {
+	t.Run("should return before delivery and hide delivery errors", func(t *testing.T) {
+		next := &blockingMailer{release: make(chan struct{}), sent: make(chan vo.MailMessage, 1), err: errors.New("smtp down")}
+		mailer := mail.NewAsyncMailer(next)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		err := mailer.Send(ctx, vo.MailMessage{To: "joko@test.com"})
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+
+		// ✅ Request sudah selesai, pengiriman tetap berjalan
+		cancel()
+		close(next.release)
+
+		if err := mailer.Wait(context.Background()); err != nil {
+			t.Fatalf("Expected Wait to finish, but got %v", err)
+		}
+		select {
+		case message := <-next.sent:
+			if message.To != "joko@test.com" {
+				t.Errorf("Expected mail to joko@test.com, but got %s", message.To)
+			}
+		default:
+			t.Errorf("Expected the message to be delivered after the request context was canceled")
+		}
+	})
+
+	t.Run("should stop waiting when the shutdown deadline passes", func(t *testing.T) {
+		next := &blockingMailer{release: make(chan struct{}), sent: make(chan vo.MailMessage, 1)}
+		mailer := mail.NewAsyncMailer(next)
+		defer close(next.release)
+
+		mailer.Send(context.Background(), vo.MailMessage{To: "joko@test.com"})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if err := mailer.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected %v, but got %v", context.DeadlineExceeded, err)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	netmail "net/mail"
+	"os"
+	"path/filepath"
+	"time"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+)
+
+// FileMailer menyimpan setiap email sebagai file .eml di dir, untuk development.
+type FileMailer struct {
+	dir  string
+	from string
+}
+
+func NewFileMailer(dir, from string) vo.Mailer {
+	return &FileMailer{dir: dir, from: from}
+}
+
+func (m *FileMailer) Send(ctx context.Context, message vo.MailMessage) error {
+	now := time.Now()
+	body, err := buildMessage(m.from, message, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	name := filepath.Join(m.dir, now.UTC().Format("20060102T150405.000000000")+"-"+hex.EncodeToString(suffix)+".eml")
+
+	if err := os.WriteFile(name, body, 0o600); err != nil {
+		return err
+	}
+
+	log.Printf("📧 Mail to %s saved to %s", message.To, name)
+	return nil
+}
+
+// LogMailer hanya menulis email ke log, untuk development tanpa SMTP.
+type LogMailer struct {
+	from string
+}
+
+func NewLogMailer(from string) vo.Mailer {
+	return &LogMailer{from: from}
+}
+
+func (m *LogMailer) Send(ctx context.Context, message vo.MailMessage) error {
+	if _, err := buildMessage(m.from, message, time.Now()); err != nil {
+		return err
+	}
+
+	log.Printf("📧 Mail to %s\nSubject: %s\n\n%s", message.To, message.Subject, message.Body)
+	return nil
+}
+
+// envelopeAddress mengambil alamat email dari "Nama <alamat>" untuk MAIL FROM/RCPT TO.
+func envelopeAddress(value string) string {
+	address, err := netmail.ParseAddress(value)
+	if err != nil {
+		return value
+	}
+	return address.Address
+}
@@ -0,0 +1,58 @@
+package mail_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	mail "github.com/jokosaputro95/cms-news-api/internal/modules/auth/infrastructure/mail"
+)
+
+func TestFileMailer(t *testing.T) {
+	t.Run("should write the message as an eml file", func(t *testing.T) {
+		dir := t.TempDir()
+		mailer := mail.NewFileMailer(dir, "CMS News <no-reply@example.com>")
+
+		err := mailer.Send(context.Background(), vo.MailMessage{
+			To:      "joko@test.com",
+			Subject: "Reset password ✅",
+			Body:    "line one\nline two",
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+
+		files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+		if len(files) != 1 {
+			t.Fatalf("Expected 1 eml file, but got %d", len(files))
+		}
+
+		content, _ := os.ReadFile(files[0])
+		message := string(content)
+		for _, expected := range []string{
+			"From: CMS News <no-reply@example.com>\r\n",
+			"To: joko@test.com\r\n",
+			"Subject: =?utf-8?q?Reset_password_=E2=9C=85?=\r\n",
+			"\r\n\r\nline one\r\nline two",
+		} {
+			if !strings.Contains(message, expected) {
+				t.Errorf("Expected message to contain %q, but got %q", expected, message)
+			}
+		}
+	})
+
+	t.Run("should reject header injection", func(t *testing.T) {
+		mailer := mail.NewFileMailer(t.TempDir(), "no-reply@example.com")
+
+		err := mailer.Send(context.Background(), vo.MailMessage{
+			To:      "joko@test.com",
+			Subject: "Hello\r\nBcc: victim@test.com",
+		})
+		if err != mail.ErrInvalidHeader {
+			t.Errorf("Expected %v, but got %v", mail.ErrInvalidHeader, err)
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"mime"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+)
+
+var ErrInvalidHeader = errors.New("mail header cannot contain line breaks")
+
+// buildMessage menyusun email RFC 5322 berisi teks UTF-8. Header ditolak
+// jika mengandung baris baru supaya tidak bisa disisipi header lain.
+func buildMessage(from string, message vo.MailMessage, now time.Time) ([]byte, error) {
+	for _, value := range []string{from, message.To, message.Subject} {
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, ErrInvalidHeader
+		}
+	}
+	if _, err := netmail.ParseAddress(message.To); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from + "\r\n")
+	buf.WriteString("To: " + message.To + "\r\n")
+	buf.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", message.Subject) + "\r\n")
+	buf.WriteString("Date: " + now.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+)
+
+// TokenRevocationStoreMemory adalah revocation store in-process.
+// Cocok untuk single instance atau development; entry dibuang oleh
+// sweeper setelah exp token terlewati.
+type TokenRevocationStoreMemory struct {
+	mu            sync.RWMutex
+	entries       map[string]time.Time
+	sweepInterval time.Duration
+}
+
+// defaultSweepInterval dipakai jika sweepInterval tidak positif, karena
+// time.NewTicker panic untuk interval <= 0.
+const defaultSweepInterval = time.Minute
+
+func NewTokenRevocationStoreMemory(sweepInterval time.Duration) *TokenRevocationStoreMemory {
+	if sweepInterval <= 0 {
+		sweepInterval = defaultSweepInterval
+	}
+	return &TokenRevocationStoreMemory{
+		entries:       make(map[string]time.Time),
+		sweepInterval: sweepInterval,
+	}
+}
+
+var _ repos.TokenRevocationStore = (*TokenRevocationStoreMemory)(nil)
+
+func (s *TokenRevocationStoreMemory) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[tokenID] = expiresAt
+	return nil
+}
+
+func (s *TokenRevocationStoreMemory) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	expiresAt, ok := s.entries[tokenID]
+	if !ok {
+		return false, nil
+	}
+	return time.Now().Before(expiresAt), nil
+}
+
+// Sweep membuang entry yang token-nya sudah kedaluwarsa.
+func (s *TokenRevocationStoreMemory) Sweep(now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed := 0
+	for tokenID, expiresAt := range s.entries {
+		if !now.Before(expiresAt) {
+			delete(s.entries, tokenID)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Run menjalankan sweeper secara periodik sampai ctx dibatalkan.
+func (s *TokenRevocationStoreMemory) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.Sweep(now)
+		}
+	}
+}
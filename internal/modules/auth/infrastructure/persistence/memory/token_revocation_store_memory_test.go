@@ -0,0 +1,51 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	memory "github.com/jokosaputro95/cms-news-api/internal/modules/auth/infrastructure/persistence/memory"
+)
+
+func TestTokenRevocationStoreMemory(t *testing.T) {
+	t.Run("should report revoked tokens until they expire", func(t *testing.T) {
+		store := memory.NewTokenRevocationStoreMemory(time.Minute)
+		ctx := context.Background()
+
+		_ = store.Revoke(ctx, "jti-active", time.Now().Add(time.Hour))
+		_ = store.Revoke(ctx, "jti-expired", time.Now().Add(-time.Second))
+
+		revoked, _ := store.IsRevoked(ctx, "jti-active")
+		assert.True(t, revoked)
+
+		revoked, _ = store.IsRevoked(ctx, "jti-expired")
+		assert.False(t, revoked)
+
+		revoked, _ = store.IsRevoked(ctx, "jti-unknown")
+		assert.False(t, revoked)
+	})
+
+	t.Run("should drop entries once exp has passed", func(t *testing.T) {
+		store := memory.NewTokenRevocationStoreMemory(time.Minute)
+		ctx := context.Background()
+		now := time.Now()
+
+		_ = store.Revoke(ctx, "jti-1", now.Add(time.Minute))
+		_ = store.Revoke(ctx, "jti-2", now.Add(-time.Minute))
+
+		assert.Equal(t, 1, store.Sweep(now))
+		assert.Equal(t, 1, store.Sweep(now.Add(2*time.Minute)))
+		assert.Equal(t, 0, store.Sweep(now.Add(2*time.Minute)))
+	})
+
+	t.Run("should fall back to the default interval instead of panicking", func(t *testing.T) {
+		store := memory.NewTokenRevocationStoreMemory(0)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.NotPanics(t, func() { store.Run(ctx) })
+	})
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_user_roles_role;
+DROP TABLE IF EXISTS user_roles;
+DROP TABLE IF EXISTS roles;
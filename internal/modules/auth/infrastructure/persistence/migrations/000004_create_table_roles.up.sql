@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(30) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO roles (name, description) VALUES
+    ('admin', 'Full access, including user management'),
+    ('editor', 'Newsroom editor: reviews, publishes and moderates'),
+    ('author', 'Staff writer: writes and submits own articles'),
+    ('contributor', 'Freelance contributor: drafts and submits own articles'),
+    ('reader', 'Registered reader: reads and comments')
+ON CONFLICT (name) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(30) NOT NULL REFERENCES roles(name),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, role)
+);
+
+-- Index untuk query user per role
+CREATE INDEX IF NOT EXISTS idx_user_roles_role ON user_roles(role);
+
+-- User yang sudah ada sebelum RBAC mendapat role reader
+INSERT INTO user_roles (user_id, role)
+SELECT id, 'reader' FROM users
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,7 @@
+-- Akun yang sudah ada dianggap terverifikasi (default mengisi baris lama),
+-- lalu default dihapus supaya akun baru harus verifikasi email dulu.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE users ALTER COLUMN email_verified_at DROP DEFAULT;
+
+-- Waktu pengiriman ulang email verifikasi terakhir, untuk rate limit resend
+ALTER TABLE users ADD COLUMN IF NOT EXISTS verification_sent_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type PasswordResetTokenRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewPasswordResetTokenRepositoryPostgres(db *sql.DB) repos.PasswordResetTokenRepository {
+	return &PasswordResetTokenRepositoryPostgres{db: db}
+}
+
+func (r *PasswordResetTokenRepositoryPostgres) Save(ctx context.Context, token *entities.PasswordResetToken) error {
+	query := `
+		INSERT INTO password_reset_tokens (id, user_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := shared.Executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		token.ID,
+		token.UserID,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	return err
+}
+
+func (r *PasswordResetTokenRepositoryPostgres) FindByHash(ctx context.Context, tokenHash string) (*entities.PasswordResetToken, error) {
+	query := `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		FROM password_reset_tokens
+		WHERE token_hash = $1
+	`
+
+	var token entities.PasswordResetToken
+	var usedAt sql.NullTime
+
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&usedAt,
+		&token.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+
+	return &token, nil
+}
+
+func (r *PasswordResetTokenRepositoryPostgres) MarkUsed(ctx context.Context, id string) (bool, error) {
+	// ✅ Conditional update supaya token tidak bisa dipakai dua kali oleh request paralel
+	query := `
+		UPDATE password_reset_tokens
+		SET used_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND used_at IS NULL
+	`
+	result, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+func (r *PasswordResetTokenRepositoryPostgres) InvalidateForUser(ctx context.Context, userID string) error {
+	query := `
+		UPDATE password_reset_tokens
+		SET used_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND used_at IS NULL
+	`
+	_, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, userID)
+	return err
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type RefreshTokenRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewRefreshTokenRepositoryPostgres(db *sql.DB) repos.RefreshTokenRepository {
+	return &RefreshTokenRepositoryPostgres{db: db}
+}
+
+func (r *RefreshTokenRepositoryPostgres) Save(ctx context.Context, token *entities.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (id, user_id, family_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := shared.Executor(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		token.ID,
+		token.UserID,
+		token.FamilyID,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	return err
+}
+
+func (r *RefreshTokenRepositoryPostgres) FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	query := `
+		SELECT id, user_id, family_id, token_hash, expires_at, rotated_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	var token entities.RefreshToken
+	var rotatedAt, revokedAt sql.NullTime
+
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&rotatedAt,
+		&revokedAt,
+		&token.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if rotatedAt.Valid {
+		token.RotatedAt = &rotatedAt.Time
+	}
+	if revokedAt.Valid {
+		token.RevokedAt = &revokedAt.Time
+	}
+
+	return &token, nil
+}
+
+func (r *RefreshTokenRepositoryPostgres) MarkRotated(ctx context.Context, id string) (bool, error) {
+	// ✅ Conditional update supaya dua request paralel tidak bisa merotasi token yang sama
+	query := `
+		UPDATE refresh_tokens
+		SET rotated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND rotated_at IS NULL AND revoked_at IS NULL
+	`
+	result, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+func (r *RefreshTokenRepositoryPostgres) RevokeFamily(ctx context.Context, familyID string) error {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = CURRENT_TIMESTAMP
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`
+	_, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, familyID)
+	return err
+}
+
+func (r *RefreshTokenRepositoryPostgres) RevokeAllForUser(ctx context.Context, userID string) error {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+	_, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, userID)
+	return err
+}
@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// TokenRevocationStorePostgres menyimpan jti di tabel revoked_tokens sehingga
+// berlaku untuk semua replika. Baris yang token-nya sudah kedaluwarsa dihapus
+// oleh sweeper, jadi store ini juga didaftarkan sebagai worker.
+type TokenRevocationStorePostgres struct {
+	db            *sql.DB
+	sweepInterval time.Duration
+}
+
+// defaultPostgresSweepInterval dipakai jika sweepInterval tidak positif, karena
+// time.NewTicker panic untuk interval <= 0.
+const defaultPostgresSweepInterval = time.Minute
+
+func NewTokenRevocationStorePostgres(db *sql.DB, sweepInterval time.Duration) *TokenRevocationStorePostgres {
+	if sweepInterval <= 0 {
+		sweepInterval = defaultPostgresSweepInterval
+	}
+	return &TokenRevocationStorePostgres{db: db, sweepInterval: sweepInterval}
+}
+
+var _ repos.TokenRevocationStore = (*TokenRevocationStorePostgres)(nil)
+var _ shared.Worker = (*TokenRevocationStorePostgres)(nil)
+
+func (s *TokenRevocationStorePostgres) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	query := `
+		INSERT INTO revoked_tokens (jti, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING
+	`
+	_, err := shared.Executor(ctx, s.db).ExecContext(ctx, query, tokenID, expiresAt)
+	return err
+}
+
+func (s *TokenRevocationStorePostgres) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1 AND expires_at > CURRENT_TIMESTAMP)"
+
+	var revoked bool
+	err := shared.Executor(ctx, s.db).QueryRowContext(ctx, query, tokenID).Scan(&revoked)
+	if err != nil {
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+// Sweep menghapus jti yang token-nya sudah kedaluwarsa pada waktu now.
+// Aman dijalankan bersamaan di beberapa replika.
+func (s *TokenRevocationStorePostgres) Sweep(ctx context.Context, now time.Time) (int64, error) {
+	result, err := shared.Executor(ctx, s.db).ExecContext(ctx, "DELETE FROM revoked_tokens WHERE expires_at <= $1", now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// Run menjalankan sweeper secara periodik sampai ctx dibatalkan.
+func (s *TokenRevocationStorePostgres) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := s.Sweep(ctx, now); err != nil && ctx.Err() == nil {
+				log.Printf("❌ Token revocation sweep failed: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	repositories "github.com/jokosaputro95/cms-news-api/internal/modules/auth/infrastructure/persistence/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+	"github.com/jokosaputro95/cms-news-api/internal/shared/testdb"
+)
+
+func TestTokenRevocationStorePostgresSweep(t *testing.T) {
+	t.Run("should delete only rows whose token has expired", func(t *testing.T) {
+		db := testdb.Open(t)
+		store := repositories.NewTokenRevocationStorePostgres(db, time.Minute)
+		ctx := context.Background()
+		uuidGen := &shared.DefaultUUIDGenerator{}
+		now := time.Now()
+
+		expired, active := uuidGen.NewUUID(), uuidGen.NewUUID()
+		t.Cleanup(func() {
+			db.Exec("DELETE FROM revoked_tokens WHERE jti IN ($1, $2)", expired, active)
+		})
+		if err := store.Revoke(ctx, expired, now.Add(-time.Minute)); err != nil {
+			t.Fatalf("Error revoking token: %v", err)
+		}
+		if err := store.Revoke(ctx, active, now.Add(time.Hour)); err != nil {
+			t.Fatalf("Error revoking token: %v", err)
+		}
+
+		if _, err := store.Sweep(ctx, now); err != nil {
+			t.Fatalf("Error sweeping: %v", err)
+		}
+
+		var remaining []string
+		rows, err := db.Query("SELECT jti FROM revoked_tokens WHERE jti IN ($1, $2)", expired, active)
+		if err != nil {
+			t.Fatalf("Error querying revoked tokens: %v", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var jti string
+			rows.Scan(&jti)
+			remaining = append(remaining, jti)
+		}
+		if len(remaining) != 1 || remaining[0] != active {
+			t.Errorf("Expected only %s to remain, but got %v", active, remaining)
+		}
+	})
+}
@@ -5,34 +5,54 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/lib/pq"
+
 	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
 	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
 	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
 )
 
 type UserRepositoryPostgres struct {
-	db *sql.DB
+	db     *sql.DB
+	outbox shared.EventOutbox
 }
 
-func NewUserRepositoryPostgres(db *sql.DB) repos.UserRepository {
-	return &UserRepositoryPostgres{db: db}
+// userSelectQuery memuat user beserta role-nya dalam satu query.
+const userSelectQuery = `
+	SELECT u.id, u.username, u.email, u.hashed_password, u.email_verified_at, u.created_at, u.updated_at,
+		COALESCE(array_agg(ur.role ORDER BY ur.role) FILTER (WHERE ur.role IS NOT NULL), '{}') AS roles
+	FROM users u
+	LEFT JOIN user_roles ur ON ur.user_id = u.id
+`
+
+func NewUserRepositoryPostgres(db *sql.DB, outbox shared.EventOutbox) repos.UserRepository {
+	return &UserRepositoryPostgres{db: db, outbox: outbox}
 }
 
 func (r *UserRepositoryPostgres) Save(ctx context.Context, user *entities.User) (*entities.User, error) {
 	query := `
-		INSERT INTO users (id, username, email, hashed_password, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO users (id, username, email, hashed_password, email_verified_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING created_at, updated_at
 	`
 	var createdAt, updatedAt time.Time
+
+	// ✅ User dan role-nya disimpan dalam satu transaksi
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 	
-	err := r.db.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx,
 		query,
 		user.ID,
 		user.Username.String(),
 		user.Email.String(),
 		user.HashedPassword,
+		user.EmailVerifiedAt,
 		user.CreatedAt,
 		user.UpdatedAt,
 	).Scan(&createdAt, &updatedAt)
@@ -40,6 +60,19 @@ func (r *UserRepositoryPostgres) Save(ctx context.Context, user *entities.User)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := r.replaceRoles(ctx, tx, user); err != nil {
+		return nil, err
+	}
+
+	// ✅ Event ditulis ke outbox di transaksi yang sama, tidak hilang jika proses mati setelah commit
+	if err := r.outbox.Append(ctx, tx, user.PullEvents()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	
 	user.CreatedAt = createdAt
 	user.UpdatedAt = updatedAt
@@ -50,44 +83,63 @@ func (r *UserRepositoryPostgres) Save(ctx context.Context, user *entities.User)
 func (r *UserRepositoryPostgres) Update(ctx context.Context, user *entities.User) (*entities.User, error) {
 	query := `
 		UPDATE users
-		SET username = $2, email = $3, hashed_password = $4, updated_at = $5
+		SET username = $2, email = $3, hashed_password = $4, email_verified_at = $5, updated_at = $6
 		WHERE id = $1
 		RETURNING updated_at
 	`
 	var updatedAt time.Time
+
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 	
-	err := r.db.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx,
 		query,
 		user.ID,
 		user.Username.String(),
 		user.Email.String(),
 		user.HashedPassword,
+		user.EmailVerifiedAt,
 		time.Now(),
 	).Scan(&updatedAt)
 	
 	if err != nil {
 		return nil, err
 	}
+
+	if err := r.replaceRoles(ctx, tx, user); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	
 	user.UpdatedAt = updatedAt
 	return user, nil
 }
 
 func (r *UserRepositoryPostgres) FindByID(ctx context.Context, id string) (*entities.User, error) {
-	query := "SELECT id, username, email, hashed_password, created_at, updated_at FROM users WHERE id = $1"
+	query := userSelectQuery + "WHERE u.id = $1 GROUP BY u.id"
 	
 	var user entities.User
 	var username, email, password string
+	var emailVerifiedAt sql.NullTime
 	var createdAt, updatedAt time.Time
+	var roles pq.StringArray
 	
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&user.ID,
 		&username,
 		&email,
 		&password,
+		&emailVerifiedAt,
 		&createdAt,
 		&updatedAt,
+		&roles,
 	)
 	
 	if err == sql.ErrNoRows {
@@ -112,6 +164,13 @@ func (r *UserRepositoryPostgres) FindByID(ctx context.Context, id string) (*enti
 
 	// ✅ Hashed password langsung sebagai string
 	user.HashedPassword = password
+	user.Roles, err = toRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+	if emailVerifiedAt.Valid {
+		user.EmailVerifiedAt = &emailVerifiedAt.Time
+	}
 	user.CreatedAt = createdAt
 	user.UpdatedAt = updatedAt
 	
@@ -119,19 +178,23 @@ func (r *UserRepositoryPostgres) FindByID(ctx context.Context, id string) (*enti
 }
 
 func (r *UserRepositoryPostgres) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
-	query := "SELECT id, username, email, hashed_password, created_at, updated_at FROM users WHERE email = $1"
+	query := userSelectQuery + "WHERE u.email = $1 GROUP BY u.id"
 	
 	var user entities.User
 	var username, emailStr, password string // ✅ Fix: scan email ke string dulu
+	var emailVerifiedAt sql.NullTime
 	var createdAt, updatedAt time.Time
+	var roles pq.StringArray
 	
-	err := r.db.QueryRowContext(ctx, query, email).Scan(
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, email).Scan(
 		&user.ID,
 		&username,
 		&emailStr,
 		&password,
+		&emailVerifiedAt,
 		&createdAt,
 		&updatedAt,
+		&roles,
 	)
 	
 	if err == sql.ErrNoRows {
@@ -156,6 +219,13 @@ func (r *UserRepositoryPostgres) FindByEmail(ctx context.Context, email string)
 
 	// ✅ Hashed password langsung sebagai string
 	user.HashedPassword = password
+	user.Roles, err = toRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+	if emailVerifiedAt.Valid {
+		user.EmailVerifiedAt = &emailVerifiedAt.Time
+	}
 	user.CreatedAt = createdAt
 	user.UpdatedAt = updatedAt
 	
@@ -163,9 +233,9 @@ func (r *UserRepositoryPostgres) FindByEmail(ctx context.Context, email string)
 }
 
 func (r *UserRepositoryPostgres) FindAll(ctx context.Context) ([]*entities.User, error) {
-	query := "SELECT id, username, email, hashed_password, created_at, updated_at FROM users"
+	query := userSelectQuery + "GROUP BY u.id ORDER BY u.created_at"
 	
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -175,15 +245,19 @@ func (r *UserRepositoryPostgres) FindAll(ctx context.Context) ([]*entities.User,
 	for rows.Next() {
 		var user entities.User
 		var username, email, password string
+		var emailVerifiedAt sql.NullTime
 		var createdAt, updatedAt time.Time
+		var roles pq.StringArray
 		
 		if err := rows.Scan(
 			&user.ID,
 			&username,
 			&email,
 			&password,
+			&emailVerifiedAt,
 			&createdAt,
 			&updatedAt,
+			&roles,
 		); err != nil {
 			return nil, err
 		}
@@ -203,6 +277,13 @@ func (r *UserRepositoryPostgres) FindAll(ctx context.Context) ([]*entities.User,
 
 		// ✅ Hashed password sebagai string
 		user.HashedPassword = password
+		user.Roles, err = toRoles(roles)
+		if err != nil {
+			return nil, err
+		}
+		if emailVerifiedAt.Valid {
+			user.EmailVerifiedAt = &emailVerifiedAt.Time
+		}
 		user.CreatedAt = createdAt
 		user.UpdatedAt = updatedAt
 		
@@ -220,7 +301,7 @@ func (r *UserRepositoryPostgres) ExistsByEmail(ctx context.Context, email string
 	query := "SELECT EXISTS (SELECT 1 FROM users WHERE email = $1)"
 	
 	var exists bool
-	err := r.db.QueryRowContext(ctx, query, email).Scan(&exists)
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, email).Scan(&exists)
 	if err != nil {
 		return false, err
 	}
@@ -230,6 +311,77 @@ func (r *UserRepositoryPostgres) ExistsByEmail(ctx context.Context, email string
 
 func (r *UserRepositoryPostgres) Delete(ctx context.Context, id string) error {
 	query := "DELETE FROM users WHERE id = $1"
-	_, err := r.db.ExecContext(ctx, query, id)
+	_, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, id)
 	return err
-}
\ No newline at end of file
+}
+
+func (r *UserRepositoryPostgres) LockAdminIDs(ctx context.Context) ([]string, error) {
+	// ✅ FOR UPDATE: dua penghapusan admin paralel tidak bisa sama-sama melihat admin lain
+	query := "SELECT user_id FROM user_roles WHERE role = $1 ORDER BY user_id FOR UPDATE"
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, vo.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func (r *UserRepositoryPostgres) MarkVerificationSent(ctx context.Context, id string, sentBefore time.Time) (bool, error) {
+	// ✅ Conditional update: dua request resend paralel hanya lolos satu
+	query := `
+		UPDATE users
+		SET verification_sent_at = NOW()
+		WHERE id = $1 AND (verification_sent_at IS NULL OR verification_sent_at < $2)
+	`
+
+	result, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, id, sentBefore)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+// replaceRoles menulis ulang isi user_roles untuk user di dalam transaksi yang sama.
+func (r *UserRepositoryPostgres) replaceRoles(ctx context.Context, tx shared.DBTX, user *entities.User) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_roles WHERE user_id = $1", user.ID); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO user_roles (user_id, role) SELECT $1, unnest($2::varchar[])",
+		user.ID,
+		pq.Array(user.RoleNames()),
+	)
+	return err
+}
+
+// toRoles membuat ulang value object Role dari data yang diambil.
+func toRoles(names []string) ([]vo.Role, error) {
+	roles := make([]vo.Role, 0, len(names))
+	for _, name := range names {
+		role, err := vo.NewRole(name)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, *role)
+	}
+	return roles, nil
+}
@@ -0,0 +1,90 @@
+package security
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+)
+
+type JWTEmailVerificationSigner struct {
+	secretKey []byte
+	issuer    string
+	audience  string
+	ttl       time.Duration
+}
+
+// emailVerificationClaims memakai typ tersendiri supaya tidak bisa dipakai sebagai access token.
+type emailVerificationClaims struct {
+	TokenType string `json:"typ"`
+	Email     string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+func NewJWTEmailVerificationSigner(secretKey, issuer, audience string, ttl time.Duration) vo.EmailVerificationSigner {
+	return &JWTEmailVerificationSigner{
+		secretKey: []byte(secretKey),
+		issuer:    issuer,
+		audience:  audience,
+		ttl:       ttl,
+	}
+}
+
+func (s *JWTEmailVerificationSigner) SignEmailVerification(userID, email string) (*vo.Token, error) {
+	now := time.Now()
+	token := &vo.Token{
+		Type:      vo.TokenTypeEmailVerification,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(s.ttl),
+	}
+
+	claims := emailVerificationClaims{
+		TokenType: vo.TokenTypeEmailVerification,
+		Email:     email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.issuer,
+			Audience:  jwt.ClaimStrings{s.audience},
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(token.IssuedAt),
+			ExpiresAt: jwt.NewNumericDate(token.ExpiresAt),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secretKey)
+	if err != nil {
+		return nil, err
+	}
+	token.Value = signed
+
+	return token, nil
+}
+
+func (s *JWTEmailVerificationSigner) ParseEmailVerification(value string) (*vo.EmailVerificationClaims, error) {
+	var claims emailVerificationClaims
+	_, err := jwt.ParseWithClaims(value, &claims, func(token *jwt.Token) (interface{}, error) {
+		return s.secretKey, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(s.audience),
+		jwt.WithExpirationRequired(),
+	)
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, vo.ErrTokenExpired
+	}
+	if err != nil {
+		return nil, vo.ErrTokenInvalid
+	}
+
+	if claims.TokenType != vo.TokenTypeEmailVerification || claims.Subject == "" || claims.Email == "" {
+		return nil, vo.ErrTokenInvalid
+	}
+
+	return &vo.EmailVerificationClaims{
+		UserID:    claims.Subject,
+		Email:     claims.Email,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
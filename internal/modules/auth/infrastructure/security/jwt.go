@@ -0,0 +1,134 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type JWTTokenManager struct {
+	secretKey     []byte
+	issuer        string
+	audience      string
+	accessTTL     time.Duration
+	refreshTTL    time.Duration
+	uuidGenerator shared.UUIDGenerator
+}
+
+// jwtClaims adalah payload JWT yang ditandatangani dengan HS256.
+type jwtClaims struct {
+	TokenType string   `json:"typ"`
+	Roles     []string `json:"roles,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func NewJWTTokenManager(
+	secretKey, issuer, audience string,
+	accessTTL, refreshTTL time.Duration,
+	uuidGen shared.UUIDGenerator) vo.TokenManager {
+	return &JWTTokenManager{
+		secretKey:     []byte(secretKey),
+		issuer:        issuer,
+		audience:      audience,
+		accessTTL:     accessTTL,
+		refreshTTL:    refreshTTL,
+		uuidGenerator: uuidGen,
+	}
+}
+
+func (m *JWTTokenManager) GenerateAccessToken(userID string, roles []string) (*vo.Token, error) {
+	return m.generate(userID, roles, vo.TokenTypeAccess, m.accessTTL)
+}
+
+func (m *JWTTokenManager) ParseAccessToken(value string) (*vo.TokenClaims, error) {
+	var claims jwtClaims
+	_, err := jwt.ParseWithClaims(value, &claims, func(token *jwt.Token) (interface{}, error) {
+		return m.secretKey, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithAudience(m.audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, vo.ErrTokenExpired
+	}
+	if err != nil {
+		return nil, vo.ErrTokenInvalid
+	}
+
+	if claims.TokenType != vo.TokenTypeAccess || claims.ID == "" || claims.Subject == "" {
+		return nil, vo.ErrTokenInvalid
+	}
+
+	return &vo.TokenClaims{
+		ID:        claims.ID,
+		UserID:    claims.Subject,
+		Roles:     claims.Roles,
+		Type:      claims.TokenType,
+		IssuedAt:  claims.IssuedAt.Time,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+func (m *JWTTokenManager) GenerateRefreshToken() (*vo.Token, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &vo.Token{
+		Value:     base64.RawURLEncoding.EncodeToString(buf),
+		ID:        m.uuidGenerator.NewUUID(),
+		Type:      vo.TokenTypeRefresh,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(m.refreshTTL),
+	}, nil
+}
+
+func (m *JWTTokenManager) HashToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func (m *JWTTokenManager) generate(userID string, roles []string, tokenType string, ttl time.Duration) (*vo.Token, error) {
+	now := time.Now()
+	token := &vo.Token{
+		ID:        m.uuidGenerator.NewUUID(),
+		Type:      tokenType,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	claims := jwtClaims{
+		TokenType: tokenType,
+		Roles:     roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        token.ID,
+			Issuer:    m.issuer,
+			Audience:  jwt.ClaimStrings{m.audience},
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(token.IssuedAt),
+			NotBefore: jwt.NewNumericDate(token.IssuedAt),
+			ExpiresAt: jwt.NewNumericDate(token.ExpiresAt),
+		},
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secretKey)
+	if err != nil {
+		return nil, err
+	}
+	token.Value = signed
+
+	return token, nil
+}
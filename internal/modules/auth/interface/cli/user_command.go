@@ -0,0 +1,301 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const userUsage = `usage:
+  user create -username U -email E [-password P] [-role admin,editor]
+  user reset-password -email E [-password P]
+  user list
+  user delete -email E -yes
+
+Jika -password kosong, password dibaca dari stdin.`
+
+// UserCommand adalah subcommand "user" untuk operator, mis. membuat admin
+// pertama di deployment baru tanpa menulis SQL.
+type UserCommand struct {
+	registerUser           *usecases.RegisterUser
+	userRepository         repos.UserRepository
+	refreshTokenRepository repos.RefreshTokenRepository
+	hasher                 vo.Hasher
+	txManager              shared.TxManager
+	in                     *bufio.Reader
+	out                    io.Writer
+}
+
+func NewUserCommand(
+	registerUser *usecases.RegisterUser,
+	userRepo repos.UserRepository,
+	refreshTokenRepo repos.RefreshTokenRepository,
+	hasher vo.Hasher,
+	txManager shared.TxManager,
+	in io.Reader,
+	out io.Writer) *UserCommand {
+	return &UserCommand{
+		registerUser:           registerUser,
+		userRepository:         userRepo,
+		refreshTokenRepository: refreshTokenRepo,
+		hasher:                 hasher,
+		txManager:              txManager,
+		in:                     bufio.NewReader(in),
+		out:                    out,
+	}
+}
+
+// Run menjalankan subcommand sesuai args[0].
+func (c *UserCommand) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(userUsage)
+	}
+
+	switch args[0] {
+	case "create":
+		return c.create(ctx, args[1:])
+	case "reset-password":
+		return c.resetPassword(ctx, args[1:])
+	case "list":
+		return c.list(ctx)
+	case "delete":
+		return c.delete(ctx, args[1:])
+	default:
+		return errors.New(userUsage)
+	}
+}
+
+func (c *UserCommand) create(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("user create", flag.ContinueOnError)
+	username := flags.String("username", "", "username")
+	email := flags.String("email", "", "alamat email")
+	password := flags.String("password", "", "password (kosong = baca dari stdin)")
+	roleList := flags.String("role", vo.RoleReader, "daftar role dipisah koma")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	// 1. Validasi role sebelum membuat akun
+	roles, err := parseRoles(*roleList)
+	if err != nil {
+		return err
+	}
+
+	if *password == "" {
+		if *password, err = c.readPassword(); err != nil {
+			return err
+		}
+	}
+
+	var user *entities.User
+	err = c.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		// 2. Registrasi lewat use case yang sama dengan endpoint register
+		// tanpa email verifikasi; event UserRegistered menunggu commit
+		output, err := c.registerUser.Execute(ctx, &dto.RegisterUserInput{
+			Username:              *username,
+			Email:                 *email,
+			Password:              *password,
+			SkipVerificationEmail: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		// 3. Memasang role dan menandai email terverifikasi, karena akun
+		// dibuat operator dan login mensyaratkan email terverifikasi
+		user, err = c.userRepository.FindByID(ctx, output.ID)
+		if err != nil {
+			return shared.NewDatabaseError(err)
+		}
+		if err := user.AssignRoles(roles); err != nil {
+			return shared.NewValidationError(err.Error())
+		}
+		user.VerifyEmail(time.Now())
+
+		if _, err := c.userRepository.Update(ctx, user); err != nil {
+			return shared.NewDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "✅ Created user %s (%s) with roles %v\n", user.ID, user.Email.String(), user.RoleNames())
+	return nil
+}
+
+func (c *UserCommand) resetPassword(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("user reset-password", flag.ContinueOnError)
+	email := flags.String("email", "", "alamat email")
+	password := flags.String("password", "", "password baru (kosong = baca dari stdin)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var err error
+	if *password == "" {
+		if *password, err = c.readPassword(); err != nil {
+			return err
+		}
+	}
+	if _, err := vo.NewPassword(*password); err != nil {
+		return shared.NewValidationError(err.Error())
+	}
+
+	err = c.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		user, err := c.findByEmail(ctx, *email)
+		if err != nil {
+			return err
+		}
+
+		hashedPassword, err := c.hasher.Hash(*password)
+		if err != nil {
+			return err
+		}
+
+		user.ChangePassword(hashedPassword)
+		if _, err := c.userRepository.Update(ctx, user); err != nil {
+			return shared.NewDatabaseError(err)
+		}
+
+		// ✅ Sama seperti reset lewat email: seluruh sesi lama dicabut
+		if err := c.refreshTokenRepository.RevokeAllForUser(ctx, user.ID); err != nil {
+			return shared.NewDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "✅ Password of %s has been reset\n", *email)
+	return nil
+}
+
+func (c *UserCommand) list(ctx context.Context) error {
+	users, err := c.userRepository.FindAll(ctx)
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+
+	writer := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tUSERNAME\tEMAIL\tROLES\tVERIFIED\tCREATED AT")
+	for _, user := range users {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%t\t%s\n",
+			user.ID,
+			user.Username.String(),
+			user.Email.String(),
+			strings.Join(user.RoleNames(), ","),
+			user.IsEmailVerified(),
+			user.CreatedAt.Format(time.RFC3339),
+		)
+	}
+
+	return writer.Flush()
+}
+
+func (c *UserCommand) delete(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("user delete", flag.ContinueOnError)
+	email := flags.String("email", "", "alamat email")
+	confirmed := flags.Bool("yes", false, "konfirmasi penghapusan")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if !*confirmed {
+		return errors.New("refusing to delete without -yes")
+	}
+
+	err := c.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		user, err := c.findByEmail(ctx, *email)
+		if err != nil {
+			return err
+		}
+
+		// ✅ Admin terakhir tidak boleh dihapus supaya deployment tidak terkunci.
+		// Baris admin dikunci sampai commit agar dua penghapusan paralel tidak
+		// sama-sama lolos pengecekan ini
+		if user.HasRole(vo.RoleAdmin) {
+			adminIDs, err := c.userRepository.LockAdminIDs(ctx)
+			if err != nil {
+				return shared.NewDatabaseError(err)
+			}
+			if len(adminIDs) <= 1 {
+				return shared.NewForbiddenError("Cannot delete the last admin")
+			}
+		}
+
+		if err := c.userRepository.Delete(ctx, user.ID); err != nil {
+			// Artikel, revisi, transisi dan media menyimpan referensi ke penulisnya
+			if shared.IsForeignKeyViolation(err) {
+				return shared.NewConflictError("User still owns content (articles, revisions or media) and cannot be deleted")
+			}
+			return shared.NewDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "✅ Deleted user %s\n", *email)
+	return nil
+}
+
+func (c *UserCommand) findByEmail(ctx context.Context, email string) (*entities.User, error) {
+	if strings.TrimSpace(email) == "" {
+		return nil, shared.NewValidationError("email is required")
+	}
+
+	user, err := c.userRepository.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if user == nil {
+		return nil, shared.NewNotFoundError("User not found")
+	}
+	return user, nil
+}
+
+// readPassword membaca satu baris dari stdin, supaya password tidak perlu
+// muncul di argumen (riwayat shell, daftar proses).
+func (c *UserCommand) readPassword() (string, error) {
+	fmt.Fprint(c.out, "Password: ")
+
+	line, err := c.in.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func parseRoles(list string) ([]vo.Role, error) {
+	var roles []vo.Role
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		role, err := vo.NewRole(name)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		roles = append(roles, *role)
+	}
+	if len(roles) == 0 {
+		return nil, shared.NewValidationError(vo.ErrRoleEmpty.Error())
+	}
+	return roles, nil
+}
@@ -11,24 +11,38 @@ import (
 )
 
 type AuthHandler struct {
-	registerUseCase *usecases.RegisterUser
-}
+	registerUseCase     *usecases.RegisterUser
+	loginUseCase        *usecases.LoginUser
+	refreshTokenUseCase *usecases.RefreshToken
+	logoutUseCase       *usecases.LogoutUser
 
-type Response struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data,omitempty"`
-	Error   *ErrorInfo  `json:"error,omitempty"`
-}
+	requestPasswordResetUseCase *usecases.RequestPasswordReset
+	resetPasswordUseCase        *usecases.ResetPassword
 
-type ErrorInfo struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	verifyEmailUseCase             *usecases.VerifyEmail
+	resendEmailVerificationUseCase *usecases.ResendEmailVerification
 }
 
-func NewAuthHandler(registerUseCase *usecases.RegisterUser) *AuthHandler {
+func NewAuthHandler(
+	registerUseCase *usecases.RegisterUser,
+	loginUseCase *usecases.LoginUser,
+	refreshTokenUseCase *usecases.RefreshToken,
+	logoutUseCase *usecases.LogoutUser,
+	requestPasswordResetUseCase *usecases.RequestPasswordReset,
+	resetPasswordUseCase *usecases.ResetPassword,
+	verifyEmailUseCase *usecases.VerifyEmail,
+	resendEmailVerificationUseCase *usecases.ResendEmailVerification) *AuthHandler {
 	return &AuthHandler{
-		registerUseCase: registerUseCase,
+		registerUseCase:     registerUseCase,
+		loginUseCase:        loginUseCase,
+		refreshTokenUseCase: refreshTokenUseCase,
+		logoutUseCase:       logoutUseCase,
+
+		requestPasswordResetUseCase: requestPasswordResetUseCase,
+		resetPasswordUseCase:        resetPasswordUseCase,
+
+		verifyEmailUseCase:             verifyEmailUseCase,
+		resendEmailVerificationUseCase: resendEmailVerificationUseCase,
 	}
 }
 
@@ -75,42 +89,272 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	h.writeSuccessResponse(w, result, "User registered successfully", http.StatusCreated)
 }
 
-func (h *AuthHandler) getStatusCodeFromErrorCode(errorCode string) int {
-	switch errorCode {
-	case "VALIDATION_ERROR":
-		return http.StatusBadRequest
-	case "CONFLICT_ERROR":
-		return http.StatusConflict
-	case "DATABASE_ERROR":
-		return http.StatusInternalServerError
-	case "NOT_FOUND":
-		return http.StatusNotFound
-	default:
-		return http.StatusInternalServerError
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, "METHOD_NOT_ALLOWED", "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var input dto.LoginUserInput
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		h.writeErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.Email) == "" {
+		h.writeErrorResponse(w, "VALIDATION_ERROR", "Email is required", http.StatusBadRequest)
+		return
 	}
+	if strings.TrimSpace(input.Password) == "" {
+		h.writeErrorResponse(w, "VALIDATION_ERROR", "Password is required", http.StatusBadRequest)
+		return
+	}
+
+	// Execute use case
+	result, err := h.loginUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		errorCode := shared.GetErrorCode(err)
+		userMessage := shared.GetUserMessage(err)
+		statusCode := h.getStatusCodeFromErrorCode(errorCode)
+
+		h.writeErrorResponse(w, errorCode, userMessage, statusCode)
+		return
+	}
+
+	h.writeSuccessResponse(w, result, "Login successful", http.StatusOK)
 }
 
-func (h *AuthHandler) writeSuccessResponse(w http.ResponseWriter, data interface{}, message string, statusCode int) {
-	response := Response{
-		Success: true,
-		Message: message,
-		Data:    data,
+func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, "METHOD_NOT_ALLOWED", "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var input dto.RefreshTokenInput
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		h.writeErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.RefreshToken) == "" {
+		h.writeErrorResponse(w, "VALIDATION_ERROR", "Refresh token is required", http.StatusBadRequest)
+		return
+	}
+
+	// Execute use case
+	result, err := h.refreshTokenUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		errorCode := shared.GetErrorCode(err)
+		userMessage := shared.GetUserMessage(err)
+		statusCode := h.getStatusCodeFromErrorCode(errorCode)
+
+		h.writeErrorResponse(w, errorCode, userMessage, statusCode)
+		return
+	}
+
+	h.writeSuccessResponse(w, result, "Token refreshed successfully", http.StatusOK)
+}
+
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, "METHOD_NOT_ALLOWED", "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	// Principal di-inject oleh JWT middleware
+	principal, ok := shared.PrincipalFromContext(r.Context())
+	if !ok {
+		h.writeErrorResponse(w, "UNAUTHORIZED", "Authentication required", http.StatusUnauthorized)
+		return
+	}
+
+	// ✅ Body kosong atau rusak tetap diteruskan supaya access token selalu
+	// dicabut; use case menolak refresh token kosong setelah pencabutan
+	var input dto.LogoutUserInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		input = dto.LogoutUserInput{}
+	}
+	input.UserID = principal.UserID
+	input.AccessTokenID = principal.TokenID
+	input.AccessTokenExpiresAt = principal.ExpiresAt
+
+	// Execute use case
+	err := h.logoutUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		errorCode := shared.GetErrorCode(err)
+		userMessage := shared.GetUserMessage(err)
+		statusCode := h.getStatusCodeFromErrorCode(errorCode)
+
+		h.writeErrorResponse(w, errorCode, userMessage, statusCode)
+		return
+	}
+
+	h.writeSuccessResponse(w, nil, "Logout successful", http.StatusOK)
+}
+
+// ForgotPassword selalu menjawab dengan pesan yang sama, terdaftar atau tidak emailnya.
+func (h *AuthHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, "METHOD_NOT_ALLOWED", "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var input dto.ForgotPasswordInput
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		h.writeErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.Email) == "" {
+		h.writeErrorResponse(w, "VALIDATION_ERROR", "Email is required", http.StatusBadRequest)
+		return
+	}
+
+	// Execute use case
+	err = h.requestPasswordResetUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		errorCode := shared.GetErrorCode(err)
+		userMessage := shared.GetUserMessage(err)
+		statusCode := h.getStatusCodeFromErrorCode(errorCode)
+
+		h.writeErrorResponse(w, errorCode, userMessage, statusCode)
+		return
 	}
 
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(response)
+	h.writeSuccessResponse(w, nil, "If the email is registered, a password reset link has been sent", http.StatusOK)
+}
+
+func (h *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, "METHOD_NOT_ALLOWED", "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var input dto.ResetPasswordInput
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		h.writeErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.Token) == "" {
+		h.writeErrorResponse(w, "VALIDATION_ERROR", "Token is required", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(input.Password) == "" {
+		h.writeErrorResponse(w, "VALIDATION_ERROR", "Password is required", http.StatusBadRequest)
+		return
+	}
+
+	// Execute use case
+	err = h.resetPasswordUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		errorCode := shared.GetErrorCode(err)
+		userMessage := shared.GetUserMessage(err)
+		statusCode := h.getStatusCodeFromErrorCode(errorCode)
+
+		h.writeErrorResponse(w, errorCode, userMessage, statusCode)
+		return
+	}
+
+	h.writeSuccessResponse(w, nil, "Password has been reset successfully", http.StatusOK)
+}
+
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, "METHOD_NOT_ALLOWED", "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var input dto.VerifyEmailInput
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		h.writeErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.Token) == "" {
+		h.writeErrorResponse(w, "VALIDATION_ERROR", "Token is required", http.StatusBadRequest)
+		return
+	}
+
+	// Execute use case
+	err = h.verifyEmailUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		errorCode := shared.GetErrorCode(err)
+		userMessage := shared.GetUserMessage(err)
+		statusCode := h.getStatusCodeFromErrorCode(errorCode)
+
+		h.writeErrorResponse(w, errorCode, userMessage, statusCode)
+		return
+	}
+
+	h.writeSuccessResponse(w, nil, "Email verified successfully", http.StatusOK)
+}
+
+func (h *AuthHandler) ResendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, "METHOD_NOT_ALLOWED", "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var input dto.ResendEmailVerificationInput
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		h.writeErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.Email) == "" {
+		h.writeErrorResponse(w, "VALIDATION_ERROR", "Email is required", http.StatusBadRequest)
+		return
+	}
+
+	// Execute use case
+	err = h.resendEmailVerificationUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		errorCode := shared.GetErrorCode(err)
+		userMessage := shared.GetUserMessage(err)
+		statusCode := h.getStatusCodeFromErrorCode(errorCode)
+
+		h.writeErrorResponse(w, errorCode, userMessage, statusCode)
+		return
+	}
+
+	h.writeSuccessResponse(w, nil, "If the email is registered and not yet verified, a verification link has been sent", http.StatusOK)
+}
+
+func (h *AuthHandler) getStatusCodeFromErrorCode(errorCode string) int {
+	return shared.GetStatusCode(errorCode)
+}
+
+func (h *AuthHandler) writeSuccessResponse(w http.ResponseWriter, data interface{}, message string, statusCode int) {
+	shared.WriteSuccessResponse(w, data, message, statusCode)
 }
 
 func (h *AuthHandler) writeErrorResponse(w http.ResponseWriter, code, message string, statusCode int) {
-	response := Response{
-		Success: false,
-		Message: "Request failed",
-		Error: &ErrorInfo{
-			Code:    code,
-			Message: message,
-		},
-	}
-
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(response)
-}
\ No newline at end of file
+	shared.WriteErrorResponse(w, code, message, statusCode)
+}
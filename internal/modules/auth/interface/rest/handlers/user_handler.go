@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/auth/application/usecases"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type UserHandler struct {
+	assignRolesUseCase *usecases.AssignUserRoles
+}
+
+func NewUserHandler(assignRolesUseCase *usecases.AssignUserRoles) *UserHandler {
+	return &UserHandler{
+		assignRolesUseCase: assignRolesUseCase,
+	}
+}
+
+func (h *UserHandler) AssignRoles(w http.ResponseWriter, r *http.Request) {
+	principal, _ := shared.PrincipalFromContext(r.Context())
+
+	var input dto.AssignUserRolesInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.UserID = r.PathValue("id")
+	input.ActorID = principal.UserID
+
+	result, err := h.assignRolesUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "User roles updated successfully", http.StatusOK)
+}
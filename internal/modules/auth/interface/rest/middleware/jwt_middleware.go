@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// JWTMiddleware memverifikasi bearer token dan meng-inject shared.Principal ke context.
+type JWTMiddleware struct {
+	tokenManager    vo.TokenManager
+	revocationStore repos.TokenRevocationStore
+}
+
+func NewJWTMiddleware(tokenManager vo.TokenManager, revocationStore repos.TokenRevocationStore) *JWTMiddleware {
+	return &JWTMiddleware{
+		tokenManager:    tokenManager,
+		revocationStore: revocationStore,
+	}
+}
+
+// Authenticate menolak request tanpa access token yang valid dengan 401.
+func (m *JWTMiddleware) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := shared.BearerToken(r)
+		if token == "" {
+			m.unauthorized(w, "Bearer token is required")
+			return
+		}
+
+		// Signature, exp, iss dan aud diverifikasi oleh token manager
+		claims, err := m.tokenManager.ParseAccessToken(token)
+		if errors.Is(err, vo.ErrTokenExpired) {
+			m.unauthorized(w, "Access token has expired")
+			return
+		}
+		if err != nil {
+			m.unauthorized(w, "Invalid access token")
+			return
+		}
+
+		revoked, err := m.revocationStore.IsRevoked(r.Context(), claims.ID)
+		if err != nil {
+			shared.WriteError(w, shared.NewDatabaseError(err))
+			return
+		}
+		if revoked {
+			m.unauthorized(w, "Access token has been revoked")
+			return
+		}
+
+		principal := &shared.Principal{
+			UserID:      claims.UserID,
+			Roles:       claims.Roles,
+			Permissions: vo.PermissionsForRoles(claims.Roles),
+			TokenID:     claims.ID,
+			ExpiresAt:   claims.ExpiresAt,
+		}
+
+		next.ServeHTTP(w, r.WithContext(shared.WithPrincipal(r.Context(), principal)))
+	})
+}
+
+// OptionalAuthenticate meneruskan request anonim apa adanya, tetapi tetap
+// memverifikasi token jika header Authorization dikirim.
+func (m *JWTMiddleware) OptionalAuthenticate(next http.Handler) http.Handler {
+	authenticated := m.Authenticate(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		authenticated.ServeHTTP(w, r)
+	})
+}
+
+// AuthenticateFunc adalah varian Authenticate untuk http.HandlerFunc.
+func (m *JWTMiddleware) AuthenticateFunc(next http.HandlerFunc) http.Handler {
+	return m.Authenticate(next)
+}
+
+// Protect menggabungkan autentikasi dan RequirePermission untuk satu handler.
+func (m *JWTMiddleware) Protect(permission string, next http.HandlerFunc) http.Handler {
+	return m.Authenticate(RequirePermission(permission)(next))
+}
+
+func (m *JWTMiddleware) unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	shared.WriteErrorResponse(w, "UNAUTHORIZED", message, http.StatusUnauthorized)
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// RequirePermission adalah route-level guard, dipasang setelah JWTMiddleware.Authenticate:
+//
+//	jwtMiddleware.Authenticate(middleware.RequirePermission("article:publish")(handler))
+func RequirePermission(permission string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := shared.PrincipalFromContext(r.Context())
+			if !ok {
+				shared.WriteErrorResponse(w, "UNAUTHORIZED", "Authentication required", http.StatusUnauthorized)
+				return
+			}
+
+			if !principal.HasPermission(permission) {
+				shared.WriteErrorResponse(w, "FORBIDDEN", "You do not have permission to perform this action", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -4,14 +4,19 @@ import (
 	"net/http"
 
 	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/handlers"
+	middleware "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/middleware"
 )
 
-func SetupAuthRoutes(mux *http.ServeMux, authHandler *handlers.AuthHandler) {
+func SetupAuthRoutes(mux *http.ServeMux, authHandler *handlers.AuthHandler, jwtMiddleware *middleware.JWTMiddleware) {
 	// Auth endpoints
 	mux.HandleFunc("/api/v1/auth/register", authHandler.Register)
-	
-	// Future auth endpoints
-	// mux.HandleFunc("/api/auth/login", authHandler.Login)
-	// mux.HandleFunc("/api/auth/refresh", authHandler.RefreshToken)
-	// mux.HandleFunc("/api/auth/logout", authHandler.Logout)
+	mux.HandleFunc("/api/v1/auth/login", authHandler.Login)
+	mux.HandleFunc("/api/v1/auth/refresh", authHandler.RefreshToken)
+	mux.HandleFunc("/api/v1/auth/password/forgot", authHandler.ForgotPassword)
+	mux.HandleFunc("/api/v1/auth/password/reset", authHandler.ResetPassword)
+	mux.HandleFunc("/api/v1/auth/email/verify", authHandler.VerifyEmail)
+	mux.HandleFunc("/api/v1/auth/email/resend", authHandler.ResendEmailVerification)
+
+	// Protected auth endpoints
+	mux.Handle("/api/v1/auth/logout", jwtMiddleware.AuthenticateFunc(authHandler.Logout))
 }
\ No newline at end of file
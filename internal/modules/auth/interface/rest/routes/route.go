@@ -7,12 +7,22 @@ import (
 
 	"github.com/jokosaputro95/cms-news-api/configs"
 	"github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/handlers"
+	"github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/middleware"
 )
 
 // SetupRoutes configures all application routes
-func SetupRoutes(mux *http.ServeMux, config *configs.Configs, db *sql.DB, authHandler *handlers.AuthHandler) {
+func SetupRoutes(
+	mux *http.ServeMux,
+	config *configs.Configs,
+	db *sql.DB,
+	authHandler *handlers.AuthHandler,
+	userHandler *handlers.UserHandler,
+	jwtMiddleware *middleware.JWTMiddleware) {
 	// Setup Auth routes
-	SetupAuthRoutes(mux, authHandler)
+	SetupAuthRoutes(mux, authHandler, jwtMiddleware)
+
+	// Setup User routes
+	SetupUserRoutes(mux, userHandler, jwtMiddleware)
 
 	// Setup Health routes
 	SetupHealthRoutes(mux, config, db)
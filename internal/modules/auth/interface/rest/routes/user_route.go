@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"net/http"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/handlers"
+	middleware "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/middleware"
+)
+
+func SetupUserRoutes(mux *http.ServeMux, userHandler *handlers.UserHandler, jwtMiddleware *middleware.JWTMiddleware) {
+	// User management endpoints (admin only)
+	mux.Handle("PUT /api/v1/users/{id}/roles", jwtMiddleware.Protect(string(vo.PermissionUserManage), userHandler.AssignRoles))
+}
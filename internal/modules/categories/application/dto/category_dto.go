@@ -0,0 +1,61 @@
+package dto
+
+import "time"
+
+type CreateCategoryInput struct {
+	Name        string `json:"name" validate:"required,min=2,max=100"`
+	Slug        string `json:"slug,omitempty"`
+	Description string `json:"description,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
+}
+
+type GetCategoryInput struct {
+	ID string
+}
+
+// UpdateCategoryInput memakai pointer: field nil berarti tidak diubah.
+type UpdateCategoryInput struct {
+	ID          string  `json:"-"`
+	Name        *string `json:"name,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// MoveCategoryInput: ParentID kosong berarti dipindah menjadi root.
+// Kategori ditempatkan di urutan terakhir di bawah parent barunya.
+type MoveCategoryInput struct {
+	ID       string `json:"-"`
+	ParentID string `json:"parent_id"`
+}
+
+// ReorderCategoryInput: Position dimulai dari 0 di antara saudara kandungnya.
+type ReorderCategoryInput struct {
+	ID       string `json:"-"`
+	Position int    `json:"position" validate:"min=0"`
+}
+
+type DeleteCategoryInput struct {
+	ID string
+}
+
+type CategoryOutput struct {
+	ID          string    `json:"id"`
+	ParentID    string    `json:"parent_id,omitempty"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	Path        string    `json:"path"`
+	Depth       int       `json:"depth"`
+	Position    int       `json:"position"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type CategoryTreeNodeOutput struct {
+	ID       string                    `json:"id"`
+	Name     string                    `json:"name"`
+	Slug     string                    `json:"slug"`
+	Path     string                    `json:"path"`
+	Position int                       `json:"position"`
+	Children []*CategoryTreeNodeOutput `json:"children"`
+}
@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"regexp"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/value_objects"
+)
+
+var slugInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// categorySlugFrom memakai slug eksplisit jika diisi, selain itu dibuat dari nama.
+func categorySlugFrom(slug, name string) (*vo.CategorySlug, error) {
+	if strings.TrimSpace(slug) == "" {
+		slug = strings.Trim(slugInvalidChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+		if len(slug) > vo.MaxCategorySlugLength {
+			slug = strings.TrimRight(slug[:vo.MaxCategorySlugLength], "-")
+		}
+	}
+	return vo.NewCategorySlug(slug)
+}
+
+func toCategoryOutput(category *entities.Category) *dto.CategoryOutput {
+	return &dto.CategoryOutput{
+		ID:          category.ID,
+		ParentID:    category.ParentID,
+		Name:        category.Name.String(),
+		Slug:        category.Slug.String(),
+		Description: category.Description,
+		Path:        category.Path,
+		Depth:       category.Depth,
+		Position:    category.Position,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+}
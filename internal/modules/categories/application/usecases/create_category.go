@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// CreateCategory adalah use case untuk membuat section baru.
+type CreateCategory struct {
+	categoryRepository repos.CategoryRepository
+	uuidGenerator      shared.UUIDGenerator
+}
+
+// NewCreateCategory adalah konstruktor untuk use case ini.
+func NewCreateCategory(categoryRepo repos.CategoryRepository, uuidGen shared.UUIDGenerator) *CreateCategory {
+	return &CreateCategory{
+		categoryRepository: categoryRepo,
+		uuidGenerator:      uuidGen,
+	}
+}
+
+// Execute membuat kategori di urutan terakhir di bawah parent-nya.
+func (c *CreateCategory) Execute(ctx context.Context, input *dto.CreateCategoryInput) (*dto.CategoryOutput, error) {
+	// 1. Validasi Input
+	nameVO, err := vo.NewCategoryName(input.Name)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	slugVO, err := categorySlugFrom(input.Slug, nameVO.String())
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 2. Mencari parent
+	var parent *entities.Category
+	if input.ParentID != "" {
+		parent, err = c.categoryRepository.FindByID(ctx, input.ParentID)
+		if err != nil {
+			return nil, shared.NewDatabaseError(err)
+		}
+		if parent == nil {
+			return nil, shared.NewNotFoundError("Parent category not found")
+		}
+	}
+
+	siblings, err := c.categoryRepository.FindChildren(ctx, input.ParentID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	// 3. Membuat Entity Category baru
+	category, err := entities.NewCategory(
+		c.uuidGenerator.NewUUID(),
+		*nameVO,
+		*slugVO,
+		input.Description,
+		parent,
+		len(siblings),
+	)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 4. Memeriksa slug unik di level yang sama
+	isExist, err := c.categoryRepository.ExistsByPath(ctx, category.Path)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if isExist {
+		return nil, shared.NewConflictError("Slug already used by another category at this level")
+	}
+
+	// 5. Menyimpan Category ke repository
+	savedCategory, err := c.categoryRepository.Save(ctx, category)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	return toCategoryOutput(savedCategory), nil
+}
@@ -0,0 +1,174 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// --- Mock Implementations ---
+
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockCategoryRepository) Save(ctx context.Context, category *entities.Category) (*entities.Category, error) {
+	args := m.Called(ctx, category)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) FindByID(ctx context.Context, id string) (*entities.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) FindByPath(ctx context.Context, path string) (*entities.Category, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) FindAll(ctx context.Context) ([]*entities.Category, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) FindChildren(ctx context.Context, parentID string) ([]*entities.Category, error) {
+	args := m.Called(ctx, parentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) SubtreeHeight(ctx context.Context, category *entities.Category) (int, error) {
+	args := m.Called(ctx, category)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) ExistsByPath(ctx context.Context, path string) (bool, error) {
+	args := m.Called(ctx, path)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) LockAncestors(ctx context.Context, paths ...string) error {
+	args := m.Called(ctx, paths)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) UpdateWithSubtree(ctx context.Context, category *entities.Category, oldPath string, oldDepth int) (bool, error) {
+	args := m.Called(ctx, category, oldPath, oldDepth)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) UpdatePositions(ctx context.Context, categories []*entities.Category) error {
+	args := m.Called(ctx, categories)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) IsInUse(ctx context.Context, id string) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCategoryRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockUUIDGenerator struct {
+	mock.Mock
+}
+
+func (m *MockUUIDGenerator) NewUUID() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+// --- Helpers ---
+
+func newStoredCategory(t *testing.T, id, slug string, parent *entities.Category, position int) *entities.Category {
+	t.Helper()
+	nameVO, _ := vo.NewCategoryName(slug)
+	slugVO, _ := vo.NewCategorySlug(slug)
+	category, err := entities.NewCategory(id, *nameVO, *slugVO, "", parent, position)
+	if err != nil {
+		t.Fatalf("Error creating category: %v", err)
+	}
+	return category
+}
+
+// --- Test Suite ---
+
+func TestCreateCategory(t *testing.T) {
+	t.Run("should create a child category at the end of its siblings", func(t *testing.T) {
+		categoryRepoMock := new(MockCategoryRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		createCategoryUsecase := usecases.NewCreateCategory(categoryRepoMock, uuidGenMock)
+
+		news := newStoredCategory(t, "news-uuid", "berita", nil, 0)
+		existing := newStoredCategory(t, "economy-uuid", "ekonomi", news, 0)
+
+		categoryRepoMock.On("FindByID", mock.Anything, "news-uuid").Return(news, nil).Once()
+		categoryRepoMock.On("FindChildren", mock.Anything, "news-uuid").Return([]*entities.Category{existing}, nil).Once()
+		uuidGenMock.On("NewUUID").Return("politics-uuid").Once()
+		categoryRepoMock.On("ExistsByPath", mock.Anything, "/berita/politik-hukum/").Return(false, nil).Once()
+		categoryRepoMock.On("Save", mock.Anything, mock.MatchedBy(func(c *entities.Category) bool {
+			return c.Path == "/berita/politik-hukum/" && c.Position == 1
+		})).Return(newStoredCategory(t, "politics-uuid", "politik-hukum", news, 1), nil).Once()
+
+		output, err := createCategoryUsecase.Execute(context.Background(), &dto.CreateCategoryInput{
+			Name: "Politik & Hukum", ParentID: "news-uuid",
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "politik-hukum", output.Slug)
+		assert.Equal(t, "/berita/politik-hukum/", output.Path)
+		categoryRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should return a conflict when the slug exists at the same level", func(t *testing.T) {
+		categoryRepoMock := new(MockCategoryRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		createCategoryUsecase := usecases.NewCreateCategory(categoryRepoMock, uuidGenMock)
+
+		categoryRepoMock.On("FindChildren", mock.Anything, "").Return([]*entities.Category{}, nil).Once()
+		uuidGenMock.On("NewUUID").Return("news-uuid").Once()
+		categoryRepoMock.On("ExistsByPath", mock.Anything, "/berita/").Return(true, nil).Once()
+
+		_, err := createCategoryUsecase.Execute(context.Background(), &dto.CreateCategoryInput{Name: "Berita"})
+
+		assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
+		categoryRepoMock.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return not found for an unknown parent", func(t *testing.T) {
+		categoryRepoMock := new(MockCategoryRepository)
+		createCategoryUsecase := usecases.NewCreateCategory(categoryRepoMock, new(MockUUIDGenerator))
+
+		categoryRepoMock.On("FindByID", mock.Anything, "missing").Return(nil, nil).Once()
+
+		_, err := createCategoryUsecase.Execute(context.Background(), &dto.CreateCategoryInput{Name: "Politik", ParentID: "missing"})
+
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+	})
+}
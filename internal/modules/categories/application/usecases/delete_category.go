@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// DeleteCategory adalah use case untuk menghapus kategori yang sudah tidak dipakai.
+type DeleteCategory struct {
+	categoryRepository repos.CategoryRepository
+}
+
+// NewDeleteCategory adalah konstruktor untuk use case ini.
+func NewDeleteCategory(categoryRepo repos.CategoryRepository) *DeleteCategory {
+	return &DeleteCategory{
+		categoryRepository: categoryRepo,
+	}
+}
+
+// Execute menolak menghapus kategori yang masih punya anak atau artikel.
+func (d *DeleteCategory) Execute(ctx context.Context, input *dto.DeleteCategoryInput) error {
+	category, err := d.categoryRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if category == nil {
+		return shared.NewNotFoundError("Category not found")
+	}
+
+	inUse, err := d.categoryRepository.IsInUse(ctx, category.ID)
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if inUse {
+		return shared.NewConflictError("Category still has subcategories or articles")
+	}
+
+	if err := d.categoryRepository.Delete(ctx, category.ID); err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	return nil
+}
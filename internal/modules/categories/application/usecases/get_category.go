@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// GetCategory adalah use case untuk mengambil satu kategori.
+type GetCategory struct {
+	categoryRepository repos.CategoryRepository
+}
+
+// NewGetCategory adalah konstruktor untuk use case ini.
+func NewGetCategory(categoryRepo repos.CategoryRepository) *GetCategory {
+	return &GetCategory{
+		categoryRepository: categoryRepo,
+	}
+}
+
+// Execute mengembalikan kategori berdasarkan ID.
+func (g *GetCategory) Execute(ctx context.Context, input *dto.GetCategoryInput) (*dto.CategoryOutput, error) {
+	category, err := g.categoryRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if category == nil {
+		return nil, shared.NewNotFoundError("Category not found")
+	}
+
+	return toCategoryOutput(category), nil
+}
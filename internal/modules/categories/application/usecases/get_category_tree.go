@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// GetCategoryTree adalah use case untuk menyusun seluruh kategori menjadi pohon navigasi.
+type GetCategoryTree struct {
+	categoryRepository repos.CategoryRepository
+}
+
+// NewGetCategoryTree adalah konstruktor untuk use case ini.
+func NewGetCategoryTree(categoryRepo repos.CategoryRepository) *GetCategoryTree {
+	return &GetCategoryTree{
+		categoryRepository: categoryRepo,
+	}
+}
+
+// Execute mengembalikan kategori root beserta anak-anaknya secara rekursif.
+func (g *GetCategoryTree) Execute(ctx context.Context) ([]*dto.CategoryTreeNodeOutput, error) {
+	categories, err := g.categoryRepository.FindAll(ctx)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	// ✅ FindAll urut berdasarkan depth, jadi parent selalu diproses sebelum anaknya
+	nodes := make(map[string]*dto.CategoryTreeNodeOutput, len(categories))
+	roots := []*dto.CategoryTreeNodeOutput{}
+
+	for _, category := range categories {
+		node := &dto.CategoryTreeNodeOutput{
+			ID:       category.ID,
+			Name:     category.Name.String(),
+			Slug:     category.Slug.String(),
+			Path:     category.Path,
+			Position: category.Position,
+			Children: []*dto.CategoryTreeNodeOutput{},
+		}
+		nodes[category.ID] = node
+
+		if parent, ok := nodes[category.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	return roots, nil
+}
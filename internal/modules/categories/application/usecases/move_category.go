@@ -0,0 +1,140 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// MoveCategory adalah use case untuk memindahkan kategori beserta subtree-nya ke parent lain.
+// Seluruh langkah berjalan dalam satu transaksi dengan kategori, parent tujuan
+// dan ancestor keduanya terkunci, sehingga dua move yang saling silang tidak
+// bisa membentuk siklus dan path turunan tidak ditulis dari path yang basi.
+type MoveCategory struct {
+	categoryRepository repos.CategoryRepository
+	txManager          shared.TxManager
+}
+
+// NewMoveCategory adalah konstruktor untuk use case ini.
+func NewMoveCategory(categoryRepo repos.CategoryRepository, txManager shared.TxManager) *MoveCategory {
+	return &MoveCategory{
+		categoryRepository: categoryRepo,
+		txManager:          txManager,
+	}
+}
+
+// Execute memindahkan kategori ke urutan terakhir di bawah input.ParentID (kosong = root).
+func (m *MoveCategory) Execute(ctx context.Context, input *dto.MoveCategoryInput) (*dto.CategoryOutput, error) {
+	var category *entities.Category
+	err := m.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		category, err = m.move(ctx, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCategoryOutput(category), nil
+}
+
+func (m *MoveCategory) move(ctx context.Context, input *dto.MoveCategoryInput) (*entities.Category, error) {
+	// 1. Mencari kategori dan parent tujuan
+	category, parent, err := m.findCategoryAndParent(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Mengunci kategori, parent tujuan dan ancestor keduanya, lalu membaca
+	// ulang supaya pemeriksaan di bawah memakai data terbaru
+	lockPaths := []string{category.Path}
+	if parent != nil {
+		lockPaths = append(lockPaths, parent.Path)
+	}
+	if err := m.categoryRepository.LockAncestors(ctx, lockPaths...); err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	category, parent, err = m.findCategoryAndParent(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if category.Path != lockPaths[0] || (parent != nil && parent.Path != lockPaths[1]) {
+		return nil, shared.NewConflictError("Category tree was changed by another request, please retry")
+	}
+
+	if category.ParentID == input.ParentID {
+		return category, nil
+	}
+
+	// 3. Memindahkan ke urutan terakhir di bawah parent baru
+	height, err := m.categoryRepository.SubtreeHeight(ctx, category)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	siblings, err := m.categoryRepository.FindChildren(ctx, input.ParentID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	oldPath, oldDepth := category.Path, category.Depth
+	if err := category.MoveTo(parent, len(siblings), height); err != nil {
+		if errors.Is(err, entities.ErrCategoryMoveIntoItself) {
+			return nil, shared.NewConflictError(err.Error())
+		}
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 4. Memeriksa slug unik di level tujuan
+	isExist, err := m.categoryRepository.ExistsByPath(ctx, category.Path)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if isExist {
+		return nil, shared.NewConflictError("Slug already used by another category at the destination")
+	}
+
+	// 5. Menyimpan kategori beserta path subtree-nya
+	saved, err := m.categoryRepository.UpdateWithSubtree(ctx, category, oldPath, oldDepth)
+	if err != nil {
+		if shared.IsUniqueViolation(err) {
+			return nil, shared.NewConflictError("Slug already used by another category at the destination")
+		}
+		return nil, shared.NewDatabaseError(err)
+	}
+	if !saved {
+		return nil, shared.NewConflictError("Category tree was changed by another request, please retry")
+	}
+
+	return category, nil
+}
+
+// findCategoryAndParent mencari kategori yang dipindah dan parent tujuannya
+// (nil untuk root).
+func (m *MoveCategory) findCategoryAndParent(ctx context.Context, input *dto.MoveCategoryInput) (*entities.Category, *entities.Category, error) {
+	category, err := m.categoryRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return nil, nil, shared.NewDatabaseError(err)
+	}
+	if category == nil {
+		return nil, nil, shared.NewNotFoundError("Category not found")
+	}
+
+	if input.ParentID == "" {
+		return category, nil, nil
+	}
+
+	parent, err := m.categoryRepository.FindByID(ctx, input.ParentID)
+	if err != nil {
+		return nil, nil, shared.NewDatabaseError(err)
+	}
+	if parent == nil {
+		return nil, nil, shared.NewNotFoundError("Parent category not found")
+	}
+	return category, parent, nil
+}
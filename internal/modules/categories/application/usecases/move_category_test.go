@@ -0,0 +1,172 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/entities"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestMoveCategory(t *testing.T) {
+	t.Run("should move a subtree and pass the old path for rewriting", func(t *testing.T) {
+		categoryRepoMock := new(MockCategoryRepository)
+		moveCategoryUsecase := usecases.NewMoveCategory(categoryRepoMock, shared.NewInMemoryTxManager())
+
+		news := newStoredCategory(t, "news-uuid", "berita", nil, 0)
+		sport := newStoredCategory(t, "sport-uuid", "olahraga", nil, 1)
+		football := newStoredCategory(t, "football-uuid", "sepak-bola", sport, 0)
+
+		categoryRepoMock.On("FindByID", mock.Anything, "football-uuid").Return(football, nil).Twice()
+		categoryRepoMock.On("FindByID", mock.Anything, "news-uuid").Return(news, nil).Twice()
+		categoryRepoMock.On("LockAncestors", mock.Anything, []string{"/olahraga/sepak-bola/", "/berita/"}).Return(nil).Once()
+		categoryRepoMock.On("SubtreeHeight", mock.Anything, football).Return(1, nil).Once()
+		categoryRepoMock.On("FindChildren", mock.Anything, "news-uuid").Return([]*entities.Category{}, nil).Once()
+		categoryRepoMock.On("ExistsByPath", mock.Anything, "/berita/sepak-bola/").Return(false, nil).Once()
+		categoryRepoMock.On("UpdateWithSubtree", mock.Anything, football, "/olahraga/sepak-bola/", 1).Return(true, nil).Once()
+
+		output, err := moveCategoryUsecase.Execute(context.Background(), &dto.MoveCategoryInput{ID: "football-uuid", ParentID: "news-uuid"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "/berita/sepak-bola/", output.Path)
+		assert.Equal(t, "news-uuid", output.ParentID)
+		categoryRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should refuse to move a category under its descendant", func(t *testing.T) {
+		categoryRepoMock := new(MockCategoryRepository)
+		moveCategoryUsecase := usecases.NewMoveCategory(categoryRepoMock, shared.NewInMemoryTxManager())
+
+		news := newStoredCategory(t, "news-uuid", "berita", nil, 0)
+		politics := newStoredCategory(t, "politics-uuid", "politik", news, 0)
+
+		categoryRepoMock.On("FindByID", mock.Anything, "news-uuid").Return(news, nil).Twice()
+		categoryRepoMock.On("FindByID", mock.Anything, "politics-uuid").Return(politics, nil).Twice()
+		categoryRepoMock.On("LockAncestors", mock.Anything, mock.Anything).Return(nil).Once()
+		categoryRepoMock.On("SubtreeHeight", mock.Anything, news).Return(1, nil).Once()
+		categoryRepoMock.On("FindChildren", mock.Anything, "politics-uuid").Return([]*entities.Category{}, nil).Once()
+
+		_, err := moveCategoryUsecase.Execute(context.Background(), &dto.MoveCategoryInput{ID: "news-uuid", ParentID: "politics-uuid"})
+
+		assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
+		categoryRepoMock.AssertNotCalled(t, "UpdateWithSubtree", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return a conflict when the tree changed before the lock was taken", func(t *testing.T) {
+		categoryRepoMock := new(MockCategoryRepository)
+		moveCategoryUsecase := usecases.NewMoveCategory(categoryRepoMock, shared.NewInMemoryTxManager())
+
+		news := newStoredCategory(t, "news-uuid", "berita", nil, 0)
+		sport := newStoredCategory(t, "sport-uuid", "olahraga", nil, 1)
+		movedSport := newStoredCategory(t, "sport-uuid", "olahraga", news, 0)
+
+		// Request lain memindahkan sport ke bawah news sebelum kunci didapat
+		categoryRepoMock.On("FindByID", mock.Anything, "news-uuid").Return(news, nil).Twice()
+		categoryRepoMock.On("FindByID", mock.Anything, "sport-uuid").Return(sport, nil).Once()
+		categoryRepoMock.On("LockAncestors", mock.Anything, []string{"/berita/", "/olahraga/"}).Return(nil).Once()
+		categoryRepoMock.On("FindByID", mock.Anything, "sport-uuid").Return(movedSport, nil).Once()
+
+		_, err := moveCategoryUsecase.Execute(context.Background(), &dto.MoveCategoryInput{ID: "news-uuid", ParentID: "sport-uuid"})
+
+		assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
+		categoryRepoMock.AssertNotCalled(t, "UpdateWithSubtree", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return a conflict when the guarded update or unique index fails", func(t *testing.T) {
+		for _, result := range []struct {
+			saved bool
+			err   error
+		}{
+			{saved: false, err: nil},
+			{saved: false, err: &pq.Error{Code: "23505"}},
+		} {
+			categoryRepoMock := new(MockCategoryRepository)
+			txManager := shared.NewInMemoryTxManager()
+			moveCategoryUsecase := usecases.NewMoveCategory(categoryRepoMock, txManager)
+
+			news := newStoredCategory(t, "news-uuid", "berita", nil, 0)
+			sport := newStoredCategory(t, "sport-uuid", "olahraga", nil, 1)
+
+			categoryRepoMock.On("FindByID", mock.Anything, "sport-uuid").Return(sport, nil).Twice()
+			categoryRepoMock.On("FindByID", mock.Anything, "news-uuid").Return(news, nil).Twice()
+			categoryRepoMock.On("LockAncestors", mock.Anything, mock.Anything).Return(nil).Once()
+			categoryRepoMock.On("SubtreeHeight", mock.Anything, sport).Return(0, nil).Once()
+			categoryRepoMock.On("FindChildren", mock.Anything, "news-uuid").Return([]*entities.Category{}, nil).Once()
+			categoryRepoMock.On("ExistsByPath", mock.Anything, "/berita/olahraga/").Return(false, nil).Once()
+			categoryRepoMock.On("UpdateWithSubtree", mock.Anything, sport, "/olahraga/", 0).Return(result.saved, result.err).Once()
+
+			_, err := moveCategoryUsecase.Execute(context.Background(), &dto.MoveCategoryInput{ID: "sport-uuid", ParentID: "news-uuid"})
+
+			assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
+			assert.Equal(t, 1, txManager.Rollbacks)
+		}
+	})
+}
+
+func TestReorderCategory(t *testing.T) {
+	t.Run("should place the category at the requested position and renumber siblings", func(t *testing.T) {
+		categoryRepoMock := new(MockCategoryRepository)
+		reorderCategoryUsecase := usecases.NewReorderCategory(categoryRepoMock)
+
+		news := newStoredCategory(t, "news-uuid", "berita", nil, 0)
+		sport := newStoredCategory(t, "sport-uuid", "olahraga", nil, 1)
+		tech := newStoredCategory(t, "tech-uuid", "teknologi", nil, 2)
+
+		categoryRepoMock.On("FindByID", mock.Anything, "tech-uuid").Return(tech, nil).Once()
+		categoryRepoMock.On("FindChildren", mock.Anything, "").Return([]*entities.Category{news, sport, tech}, nil).Once()
+		categoryRepoMock.On("UpdatePositions", mock.Anything, mock.Anything).Return(nil).Once()
+
+		output, err := reorderCategoryUsecase.Execute(context.Background(), &dto.ReorderCategoryInput{ID: "tech-uuid", Position: 0})
+
+		assert.Nil(t, err)
+		if assert.Len(t, output, 3) {
+			assert.Equal(t, []string{"tech-uuid", "news-uuid", "sport-uuid"}, []string{output[0].ID, output[1].ID, output[2].ID})
+			assert.Equal(t, []int{0, 1, 2}, []int{output[0].Position, output[1].Position, output[2].Position})
+		}
+	})
+}
+
+func TestGetCategoryTree(t *testing.T) {
+	t.Run("should nest categories under their parents", func(t *testing.T) {
+		categoryRepoMock := new(MockCategoryRepository)
+		getCategoryTreeUsecase := usecases.NewGetCategoryTree(categoryRepoMock)
+
+		news := newStoredCategory(t, "news-uuid", "berita", nil, 0)
+		sport := newStoredCategory(t, "sport-uuid", "olahraga", nil, 1)
+		politics := newStoredCategory(t, "politics-uuid", "politik", news, 0)
+		elections := newStoredCategory(t, "elections-uuid", "pemilu", politics, 0)
+
+		categoryRepoMock.On("FindAll", mock.Anything).Return([]*entities.Category{news, sport, politics, elections}, nil).Once()
+
+		tree, err := getCategoryTreeUsecase.Execute(context.Background())
+
+		assert.Nil(t, err)
+		if assert.Len(t, tree, 2) {
+			assert.Equal(t, "news-uuid", tree[0].ID)
+			assert.Equal(t, "elections-uuid", tree[0].Children[0].Children[0].ID)
+			assert.Empty(t, tree[1].Children)
+		}
+	})
+}
+
+func TestDeleteCategory(t *testing.T) {
+	t.Run("should refuse to delete a category that is still in use", func(t *testing.T) {
+		categoryRepoMock := new(MockCategoryRepository)
+		deleteCategoryUsecase := usecases.NewDeleteCategory(categoryRepoMock)
+
+		news := newStoredCategory(t, "news-uuid", "berita", nil, 0)
+		categoryRepoMock.On("FindByID", mock.Anything, "news-uuid").Return(news, nil).Once()
+		categoryRepoMock.On("IsInUse", mock.Anything, "news-uuid").Return(true, nil).Once()
+
+		err := deleteCategoryUsecase.Execute(context.Background(), &dto.DeleteCategoryInput{ID: "news-uuid"})
+
+		assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
+		categoryRepoMock.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
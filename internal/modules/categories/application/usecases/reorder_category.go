@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// ReorderCategory adalah use case untuk mengubah urutan kategori di antara saudaranya.
+type ReorderCategory struct {
+	categoryRepository repos.CategoryRepository
+}
+
+// NewReorderCategory adalah konstruktor untuk use case ini.
+func NewReorderCategory(categoryRepo repos.CategoryRepository) *ReorderCategory {
+	return &ReorderCategory{
+		categoryRepository: categoryRepo,
+	}
+}
+
+// Execute menempatkan kategori di input.Position lalu menomori ulang saudaranya 0..n-1.
+func (r *ReorderCategory) Execute(ctx context.Context, input *dto.ReorderCategoryInput) ([]*dto.CategoryOutput, error) {
+	// 1. Validasi Input
+	if input.Position < 0 {
+		return nil, shared.NewValidationError("position cannot be negative")
+	}
+
+	// 2. Mencari kategori beserta saudaranya
+	category, err := r.categoryRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if category == nil {
+		return nil, shared.NewNotFoundError("Category not found")
+	}
+
+	siblings, err := r.categoryRepository.FindChildren(ctx, category.ParentID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	// 3. Menyusun ulang urutan
+	ordered := make([]*entities.Category, 0, len(siblings))
+	for _, sibling := range siblings {
+		if sibling.ID != category.ID {
+			ordered = append(ordered, sibling)
+		}
+	}
+
+	position := min(input.Position, len(ordered))
+	ordered = append(ordered[:position], append([]*entities.Category{category}, ordered[position:]...)...)
+
+	for i, sibling := range ordered {
+		sibling.Position = i
+	}
+
+	// 4. Menyimpan urutan baru
+	if err := r.categoryRepository.UpdatePositions(ctx, ordered); err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output := make([]*dto.CategoryOutput, 0, len(ordered))
+	for _, sibling := range ordered {
+		output = append(output, toCategoryOutput(sibling))
+	}
+	return output, nil
+}
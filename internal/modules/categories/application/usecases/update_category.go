@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// UpdateCategory adalah use case untuk mengubah nama, slug atau deskripsi kategori.
+type UpdateCategory struct {
+	categoryRepository repos.CategoryRepository
+}
+
+// NewUpdateCategory adalah konstruktor untuk use case ini.
+func NewUpdateCategory(categoryRepo repos.CategoryRepository) *UpdateCategory {
+	return &UpdateCategory{
+		categoryRepository: categoryRepo,
+	}
+}
+
+// Execute menerapkan perubahan parsial. Mengganti slug ikut menulis ulang path turunannya.
+func (u *UpdateCategory) Execute(ctx context.Context, input *dto.UpdateCategoryInput) (*dto.CategoryOutput, error) {
+	// 1. Mencari kategori
+	category, err := u.categoryRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if category == nil {
+		return nil, shared.NewNotFoundError("Category not found")
+	}
+
+	// 2. Validasi Input
+	name := category.Name
+	if input.Name != nil {
+		nameVO, err := vo.NewCategoryName(*input.Name)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		name = *nameVO
+	}
+
+	slug := category.Slug
+	if input.Slug != nil {
+		slugVO, err := vo.NewCategorySlug(*input.Slug)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		slug = *slugVO
+	}
+
+	description := category.Description
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	// 3. Menerapkan perubahan
+	oldPath, oldDepth := category.Path, category.Depth
+	category.Rename(name, slug, description)
+
+	// 4. Memeriksa slug unik di level yang sama
+	if category.Path != oldPath {
+		isExist, err := u.categoryRepository.ExistsByPath(ctx, category.Path)
+		if err != nil {
+			return nil, shared.NewDatabaseError(err)
+		}
+		if isExist {
+			return nil, shared.NewConflictError("Slug already used by another category at this level")
+		}
+	}
+
+	// 5. Menyimpan perubahan
+	saved, err := u.categoryRepository.UpdateWithSubtree(ctx, category, oldPath, oldDepth)
+	if err != nil {
+		if shared.IsUniqueViolation(err) {
+			return nil, shared.NewConflictError("Slug already used by another category at this level")
+		}
+		return nil, shared.NewDatabaseError(err)
+	}
+	if !saved {
+		return nil, shared.NewConflictError("Category was moved or renamed by another request, please retry")
+	}
+
+	return toCategoryOutput(category), nil
+}
@@ -0,0 +1,110 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/value_objects"
+)
+
+// MaxCategoryDepth adalah kedalaman maksimum pohon (root = 0),
+// mis. Berita › Politik › Pemilu › Daerah › Jawa Barat.
+const MaxCategoryDepth = 4
+
+var (
+	ErrCategoryTooDeep        = errors.New("category tree can be at most 5 levels deep")
+	ErrCategoryMoveIntoItself = errors.New("category cannot be moved under itself or one of its descendants")
+)
+
+// Category adalah section dalam pohon kategori. Path adalah materialized path
+// berisi slug dari root sampai kategori ini, mis. "/berita/politik/pemilu/".
+// Path yang unik sekaligus menjamin slug unik per level.
+type Category struct {
+	ID          string
+	ParentID    string // kosong untuk kategori root
+	Name        vo.CategoryName
+	Slug        vo.CategorySlug
+	Description string
+	Path        string
+	Depth       int
+	Position    int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewCategory membuat kategori baru di bawah parent (nil untuk root).
+func NewCategory(id string, name vo.CategoryName, slug vo.CategorySlug, description string, parent *Category, position int) (*Category, error) {
+	now := time.Now()
+	category := &Category{
+		ID:          id,
+		Name:        name,
+		Slug:        slug,
+		Description: strings.TrimSpace(description),
+		Position:    position,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := category.attachTo(parent, 0); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// Rename mengganti nama, slug dan deskripsi. Mengganti slug mengubah Path,
+// sehingga path seluruh turunannya juga harus ditulis ulang.
+func (c *Category) Rename(name vo.CategoryName, slug vo.CategorySlug, description string) {
+	c.Name = name
+	c.Slug = slug
+	c.Description = strings.TrimSpace(description)
+	c.Path = c.parentPath() + slug.String() + "/"
+	c.UpdatedAt = time.Now()
+}
+
+// MoveTo memindahkan kategori ke bawah parent baru (nil untuk root).
+// subtreeHeight adalah selisih depth turunan terdalam dengan kategori ini.
+func (c *Category) MoveTo(parent *Category, position, subtreeHeight int) error {
+	if parent != nil && (parent.ID == c.ID || c.IsAncestorOf(parent)) {
+		return ErrCategoryMoveIntoItself
+	}
+
+	if err := c.attachTo(parent, subtreeHeight); err != nil {
+		return err
+	}
+
+	c.Position = position
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsAncestorOf mengecek apakah other berada di subtree kategori ini.
+func (c *Category) IsAncestorOf(other *Category) bool {
+	return other.ID != c.ID && strings.HasPrefix(other.Path, c.Path)
+}
+
+func (c *Category) IsRoot() bool {
+	return c.ParentID == ""
+}
+
+func (c *Category) attachTo(parent *Category, subtreeHeight int) error {
+	parentID, parentPath, depth := "", "/", 0
+	if parent != nil {
+		parentID, parentPath, depth = parent.ID, parent.Path, parent.Depth+1
+	}
+
+	if depth+subtreeHeight > MaxCategoryDepth {
+		return ErrCategoryTooDeep
+	}
+
+	c.ParentID = parentID
+	c.Depth = depth
+	c.Path = parentPath + c.Slug.String() + "/"
+	return nil
+}
+
+// parentPath mengembalikan path parent dari Path saat ini.
+func (c *Category) parentPath() string {
+	trimmed := strings.TrimSuffix(c.Path, "/")
+	return trimmed[:strings.LastIndex(trimmed, "/")+1]
+}
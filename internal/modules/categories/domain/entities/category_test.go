@@ -0,0 +1,111 @@
+package entities_test
+
+import (
+	"errors"
+	"testing"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/value_objects"
+)
+
+// Helper function
+func CreateValidCategory(t *testing.T, id, name, slug string, parent *entities.Category) *entities.Category {
+	t.Helper()
+
+	nameVO, err := vo.NewCategoryName(name)
+	if err != nil {
+		t.Fatalf("Error creating name value object: %v", err)
+	}
+	slugVO, err := vo.NewCategorySlug(slug)
+	if err != nil {
+		t.Fatalf("Error creating slug value object: %v", err)
+	}
+
+	category, err := entities.NewCategory(id, *nameVO, *slugVO, "", parent, 0)
+	if err != nil {
+		t.Fatalf("Error creating category: %v", err)
+	}
+
+	return category
+}
+
+func TestNewCategory(t *testing.T) {
+	t.Run("should build the materialized path from the parent", func(t *testing.T) {
+		news := CreateValidCategory(t, "news-uuid", "Berita", "berita", nil)
+		politics := CreateValidCategory(t, "politics-uuid", "Politik", "politik", news)
+		elections := CreateValidCategory(t, "elections-uuid", "Pemilu", "pemilu", politics)
+
+		if news.Path != "/berita/" || !news.IsRoot() {
+			t.Errorf("Expected root path '/berita/', but got '%s'", news.Path)
+		}
+		if elections.Path != "/berita/politik/pemilu/" {
+			t.Errorf("Expected path '/berita/politik/pemilu/', but got '%s'", elections.Path)
+		}
+		if elections.Depth != 2 || elections.ParentID != "politics-uuid" {
+			t.Errorf("Expected depth 2 under politics, but got depth %d under '%s'", elections.Depth, elections.ParentID)
+		}
+	})
+
+	t.Run("should reject categories deeper than the maximum depth", func(t *testing.T) {
+		parent := CreateValidCategory(t, "level-0", "Level 0", "level-0", nil)
+		for i := 1; i <= entities.MaxCategoryDepth; i++ {
+			parent = CreateValidCategory(t, "level", "Level", "level", parent)
+		}
+
+		nameVO, _ := vo.NewCategoryName("Terlalu dalam")
+		slugVO, _ := vo.NewCategorySlug("terlalu-dalam")
+		_, err := entities.NewCategory("too-deep", *nameVO, *slugVO, "", parent, 0)
+		if !errors.Is(err, entities.ErrCategoryTooDeep) {
+			t.Errorf("Expected error ErrCategoryTooDeep, but got %v", err)
+		}
+	})
+}
+
+func TestCategoryMoveTo(t *testing.T) {
+	t.Run("should recompute path and depth when moved", func(t *testing.T) {
+		news := CreateValidCategory(t, "news-uuid", "Berita", "berita", nil)
+		sport := CreateValidCategory(t, "sport-uuid", "Olahraga", "olahraga", nil)
+		football := CreateValidCategory(t, "football-uuid", "Sepak Bola", "sepak-bola", sport)
+
+		if err := football.MoveTo(news, 3, 0); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if football.Path != "/berita/sepak-bola/" || football.Depth != 1 || football.Position != 3 {
+			t.Errorf("Unexpected category after move: path=%s depth=%d position=%d", football.Path, football.Depth, football.Position)
+		}
+	})
+
+	t.Run("should refuse to move a category under its own descendant", func(t *testing.T) {
+		news := CreateValidCategory(t, "news-uuid", "Berita", "berita", nil)
+		politics := CreateValidCategory(t, "politics-uuid", "Politik", "politik", news)
+
+		err := news.MoveTo(politics, 0, 1)
+		if !errors.Is(err, entities.ErrCategoryMoveIntoItself) {
+			t.Errorf("Expected error ErrCategoryMoveIntoItself, but got %v", err)
+		}
+	})
+
+	t.Run("should not treat a sibling with a common prefix as a descendant", func(t *testing.T) {
+		news := CreateValidCategory(t, "news-uuid", "Berita", "berita", nil)
+		newsroom := CreateValidCategory(t, "newsroom-uuid", "Berita Redaksi", "berita-redaksi", nil)
+
+		if news.IsAncestorOf(newsroom) {
+			t.Error("Expected '/berita/' not to be an ancestor of '/berita-redaksi/'")
+		}
+	})
+}
+
+func TestCategoryRename(t *testing.T) {
+	t.Run("should keep the parent path when the slug changes", func(t *testing.T) {
+		news := CreateValidCategory(t, "news-uuid", "Berita", "berita", nil)
+		politics := CreateValidCategory(t, "politics-uuid", "Politik", "politik", news)
+
+		nameVO, _ := vo.NewCategoryName("Politik Nasional")
+		slugVO, _ := vo.NewCategorySlug("politik-nasional")
+		politics.Rename(*nameVO, *slugVO, "")
+
+		if politics.Path != "/berita/politik-nasional/" {
+			t.Errorf("Expected path '/berita/politik-nasional/', but got '%s'", politics.Path)
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"context"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/entities"
+)
+
+type CategoryRepository interface {
+	Save(ctx context.Context, category *entities.Category) (*entities.Category, error)
+	FindByID(ctx context.Context, id string) (*entities.Category, error)
+	FindByPath(ctx context.Context, path string) (*entities.Category, error)
+	// FindAll mengembalikan seluruh kategori urut berdasarkan depth lalu position.
+	FindAll(ctx context.Context) ([]*entities.Category, error)
+	// FindChildren mengembalikan anak langsung parentID (kosong untuk root) urut position.
+	FindChildren(ctx context.Context, parentID string) ([]*entities.Category, error)
+	// SubtreeHeight mengembalikan selisih depth turunan terdalam dengan kategori ini.
+	SubtreeHeight(ctx context.Context, category *entities.Category) (int, error)
+	ExistsByPath(ctx context.Context, path string) (bool, error)
+
+	// LockAncestors mengunci (FOR UPDATE) setiap kategori di sepanjang paths,
+	// yaitu kategori itu sendiri beserta semua ancestor-nya, urut id supaya
+	// tidak deadlock. Hanya berarti di dalam TxManager.WithinTx.
+	LockAncestors(ctx context.Context, paths ...string) error
+
+	// UpdateWithSubtree menyimpan kategori dan, jika path-nya berubah dari
+	// oldPath, menulis ulang path dan depth seluruh turunannya dalam satu transaksi.
+	// Mengembalikan false jika path di database sudah bukan oldPath (diubah
+	// oleh request lain).
+	UpdateWithSubtree(ctx context.Context, category *entities.Category, oldPath string, oldDepth int) (bool, error)
+	// UpdatePositions menyimpan position sekumpulan kategori dalam satu transaksi.
+	UpdatePositions(ctx context.Context, categories []*entities.Category) error
+
+	// IsInUse mengecek apakah kategori masih punya anak atau artikel.
+	IsInUse(ctx context.Context, id string) (bool, error)
+	Delete(ctx context.Context, id string) error
+}
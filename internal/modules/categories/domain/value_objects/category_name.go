@@ -0,0 +1,44 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+type CategoryName struct {
+	value string
+}
+
+const (
+	MinCategoryNameLength = 2
+	MaxCategoryNameLength = 100
+)
+
+var (
+	ErrCategoryNameEmpty         = errors.New("category name cannot be empty")
+	ErrCategoryNameInvalidLength = errors.New("category name must be between 2 and 100 characters")
+)
+
+func NewCategoryName(value string) (*CategoryName, error) {
+	payload := strings.Join(strings.Fields(value), " ")
+
+	if payload == "" {
+		return nil, ErrCategoryNameEmpty
+	}
+
+	length := utf8.RuneCountInString(payload)
+	if length < MinCategoryNameLength || length > MaxCategoryNameLength {
+		return nil, ErrCategoryNameInvalidLength
+	}
+
+	return &CategoryName{value: payload}, nil
+}
+
+func (n *CategoryName) String() string {
+	return n.value
+}
+
+func (n *CategoryName) Value() string {
+	return n.value
+}
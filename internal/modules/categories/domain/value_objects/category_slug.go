@@ -0,0 +1,53 @@
+package valueobjects
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// CategorySlug adalah satu segmen path kategori, mis. "politik" pada /berita/politik/.
+type CategorySlug struct {
+	value string
+}
+
+const (
+	MinCategorySlugLength = 2
+	MaxCategorySlugLength = 60
+)
+
+// ^[a-z0-9]+ - Dimulai dengan huruf kecil atau angka.
+// (-[a-z0-9]+)*$ - Dipisah tanda hubung tunggal, tidak boleh diakhiri tanda hubung.
+var categorySlugRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+var (
+	ErrCategorySlugEmpty             = errors.New("category slug cannot be empty")
+	ErrCategorySlugInvalidLength     = errors.New("category slug must be between 2 and 60 characters")
+	ErrCategorySlugInvalidCharacters = errors.New("category slug can only contain lowercase letters, numbers, and single hyphens")
+)
+
+func NewCategorySlug(value string) (*CategorySlug, error) {
+	payload := strings.ToLower(strings.TrimSpace(value))
+
+	if payload == "" {
+		return nil, ErrCategorySlugEmpty
+	}
+
+	if len(payload) < MinCategorySlugLength || len(payload) > MaxCategorySlugLength {
+		return nil, ErrCategorySlugInvalidLength
+	}
+
+	if !categorySlugRegex.MatchString(payload) {
+		return nil, ErrCategorySlugInvalidCharacters
+	}
+
+	return &CategorySlug{value: payload}, nil
+}
+
+func (s *CategorySlug) String() string {
+	return s.value
+}
+
+func (s *CategorySlug) Value() string {
+	return s.value
+}
@@ -0,0 +1,43 @@
+package valueobjects_test
+
+import (
+	"errors"
+	"testing"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/value_objects"
+)
+
+func TestNewCategorySlug(t *testing.T) {
+	t.Run("should lowercase a valid slug", func(t *testing.T) {
+		slug, err := vo.NewCategorySlug(" Pemilu-2029 ")
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if slug.String() != "pemilu-2029" {
+			t.Errorf("Expected slug to be 'pemilu-2029', but got '%s'", slug.String())
+		}
+	})
+
+	t.Run("should return an error for empty slug", func(t *testing.T) {
+		_, err := vo.NewCategorySlug("  ")
+		if !errors.Is(err, vo.ErrCategorySlugEmpty) {
+			t.Errorf("Expected error ErrCategorySlugEmpty, but got %v", err)
+		}
+	})
+
+	t.Run("should return an error for invalid characters", func(t *testing.T) {
+		for _, value := range []string{"berita/politik", "berita--politik", "-berita", "berita_politik"} {
+			_, err := vo.NewCategorySlug(value)
+			if !errors.Is(err, vo.ErrCategorySlugInvalidCharacters) {
+				t.Errorf("Expected error ErrCategorySlugInvalidCharacters for %q, but got %v", value, err)
+			}
+		}
+	})
+
+	t.Run("should return an error for too short slug", func(t *testing.T) {
+		_, err := vo.NewCategorySlug("a")
+		if !errors.Is(err, vo.ErrCategorySlugInvalidLength) {
+			t.Errorf("Expected error ErrCategorySlugInvalidLength, but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_categories_updated_at ON categories;
+DROP INDEX IF EXISTS idx_categories_parent_id_position;
+DROP INDEX IF EXISTS idx_categories_path_pattern;
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS categories (
+    id VARCHAR(255) PRIMARY KEY,
+    parent_id VARCHAR(255) REFERENCES categories(id) ON DELETE RESTRICT,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(60) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    path VARCHAR(400) NOT NULL UNIQUE,
+    depth SMALLINT NOT NULL DEFAULT 0,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index untuk performance
+-- text_pattern_ops supaya query subtree (path LIKE '/berita/%') bisa memakai index
+CREATE INDEX IF NOT EXISTS idx_categories_path_pattern ON categories(path text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id_position ON categories(parent_id, position);
+
+-- Trigger untuk auto-update updated_at (function dibuat oleh migration users)
+CREATE TRIGGER update_categories_updated_at
+    BEFORE UPDATE ON categories
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,312 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/categories/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type CategoryRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewCategoryRepositoryPostgres(db *sql.DB) repos.CategoryRepository {
+	return &CategoryRepositoryPostgres{db: db}
+}
+
+const categoryColumns = "id, parent_id, name, slug, description, path, depth, position, created_at, updated_at"
+
+// rowScanner dipenuhi oleh *sql.Row dan *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (r *CategoryRepositoryPostgres) Save(ctx context.Context, category *entities.Category) (*entities.Category, error) {
+	query := `
+		INSERT INTO categories (id, parent_id, name, slug, description, path, depth, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING created_at, updated_at
+	`
+	var createdAt, updatedAt time.Time
+
+	err := shared.Executor(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		category.ID,
+		nullString(category.ParentID),
+		category.Name.String(),
+		category.Slug.String(),
+		category.Description,
+		category.Path,
+		category.Depth,
+		category.Position,
+		category.CreatedAt,
+		category.UpdatedAt,
+	).Scan(&createdAt, &updatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	category.CreatedAt = createdAt
+	category.UpdatedAt = updatedAt
+
+	return category, nil
+}
+
+func (r *CategoryRepositoryPostgres) FindByID(ctx context.Context, id string) (*entities.Category, error) {
+	query := "SELECT " + categoryColumns + " FROM categories WHERE id = $1"
+
+	category, err := scanCategory(shared.Executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil // Kategori tidak ditemukan
+	}
+	return category, err
+}
+
+func (r *CategoryRepositoryPostgres) FindByPath(ctx context.Context, path string) (*entities.Category, error) {
+	query := "SELECT " + categoryColumns + " FROM categories WHERE path = $1"
+
+	category, err := scanCategory(shared.Executor(ctx, r.db).QueryRowContext(ctx, query, path))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return category, err
+}
+
+func (r *CategoryRepositoryPostgres) FindAll(ctx context.Context) ([]*entities.Category, error) {
+	query := "SELECT " + categoryColumns + " FROM categories ORDER BY depth, position, name"
+	return r.queryCategories(ctx, query)
+}
+
+func (r *CategoryRepositoryPostgres) FindChildren(ctx context.Context, parentID string) ([]*entities.Category, error) {
+	if parentID == "" {
+		query := "SELECT " + categoryColumns + " FROM categories WHERE parent_id IS NULL ORDER BY position, name"
+		return r.queryCategories(ctx, query)
+	}
+
+	query := "SELECT " + categoryColumns + " FROM categories WHERE parent_id = $1 ORDER BY position, name"
+	return r.queryCategories(ctx, query, parentID)
+}
+
+func (r *CategoryRepositoryPostgres) SubtreeHeight(ctx context.Context, category *entities.Category) (int, error) {
+	query := "SELECT COALESCE(MAX(depth), $2) - $2 FROM categories WHERE path LIKE $1 || '%'"
+
+	var height int
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, category.Path, category.Depth).Scan(&height); err != nil {
+		return 0, err
+	}
+	return height, nil
+}
+
+func (r *CategoryRepositoryPostgres) ExistsByPath(ctx context.Context, path string) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM categories WHERE path = $1)"
+
+	var exists bool
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, path).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *CategoryRepositoryPostgres) LockAncestors(ctx context.Context, paths ...string) error {
+	var lockPaths []string
+	for _, path := range paths {
+		lockPaths = append(lockPaths, ancestorPaths(path)...)
+	}
+	if len(lockPaths) == 0 {
+		return nil
+	}
+
+	_, err := shared.Executor(ctx, r.db).ExecContext(
+		ctx,
+		"SELECT id FROM categories WHERE path = ANY($1) ORDER BY id FOR UPDATE",
+		pq.Array(lockPaths),
+	)
+	return err
+}
+
+func (r *CategoryRepositoryPostgres) UpdateWithSubtree(ctx context.Context, category *entities.Category, oldPath string, oldDepth int) (bool, error) {
+	// ✅ Kategori dan path turunannya disimpan dalam satu transaksi
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	// ✅ Optimistic check: path turunan ditulis ulang dari oldPath, jadi hanya
+	// update jika path belum diubah request lain
+	err = tx.QueryRowContext(
+		ctx,
+		`UPDATE categories
+		SET parent_id = $2, name = $3, slug = $4, description = $5, path = $6, depth = $7, position = $8, updated_at = $9
+		WHERE id = $1 AND path = $10
+		RETURNING updated_at`,
+		category.ID,
+		nullString(category.ParentID),
+		category.Name.String(),
+		category.Slug.String(),
+		category.Description,
+		category.Path,
+		category.Depth,
+		category.Position,
+		time.Now(),
+		oldPath,
+	).Scan(&category.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if category.Path != oldPath {
+		// Ganti prefix oldPath dengan path baru pada setiap turunan
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE categories
+			SET path = $2 || substring(path FROM $3), depth = depth + $4
+			WHERE path LIKE $1 || '%' AND id <> $5`,
+			oldPath,
+			category.Path,
+			len(oldPath)+1,
+			category.Depth-oldDepth,
+			category.ID,
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *CategoryRepositoryPostgres) UpdatePositions(ctx context.Context, categories []*entities.Category) error {
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, category := range categories {
+		_, err := tx.ExecContext(ctx, "UPDATE categories SET position = $2 WHERE id = $1", category.ID, category.Position)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *CategoryRepositoryPostgres) IsInUse(ctx context.Context, id string) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM categories WHERE parent_id = $1)
+			OR EXISTS (SELECT 1 FROM articles WHERE category_id = $1)
+	`
+
+	var inUse bool
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, id).Scan(&inUse); err != nil {
+		return false, err
+	}
+	return inUse, nil
+}
+
+func (r *CategoryRepositoryPostgres) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM categories WHERE id = $1"
+	_, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, id)
+	return err
+}
+
+func (r *CategoryRepositoryPostgres) queryCategories(ctx context.Context, query string, args ...interface{}) ([]*entities.Category, error) {
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []*entities.Category
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// nullString menyimpan string kosong sebagai NULL (parent_id kategori root).
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// scanCategory membaca satu baris categoryColumns dan membuat ulang value object-nya.
+func scanCategory(row rowScanner) (*entities.Category, error) {
+	var category entities.Category
+	var parentID sql.NullString
+	var name, slug string
+
+	err := row.Scan(
+		&category.ID,
+		&parentID,
+		&name,
+		&slug,
+		&category.Description,
+		&category.Path,
+		&category.Depth,
+		&category.Position,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// ✅ Recreate value objects dari data yang diambil
+	nameVO, err := vo.NewCategoryName(name)
+	if err != nil {
+		return nil, err
+	}
+	category.Name = *nameVO
+
+	slugVO, err := vo.NewCategorySlug(slug)
+	if err != nil {
+		return nil, err
+	}
+	category.Slug = *slugVO
+
+	category.ParentID = parentID.String
+	return &category, nil
+}
+
+// ancestorPaths memecah "/a/b/c/" menjadi "/a/", "/a/b/" dan "/a/b/c/".
+func ancestorPaths(path string) []string {
+	var paths []string
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	prefix := "/"
+	for _, segment := range segments {
+		if segment == "" {
+			continue
+		}
+		prefix += segment + "/"
+		paths = append(paths, prefix)
+	}
+	return paths
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/categories/application/usecases"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type CategoryHandler struct {
+	createUseCase  *usecases.CreateCategory
+	getUseCase     *usecases.GetCategory
+	treeUseCase    *usecases.GetCategoryTree
+	updateUseCase  *usecases.UpdateCategory
+	moveUseCase    *usecases.MoveCategory
+	reorderUseCase *usecases.ReorderCategory
+	deleteUseCase  *usecases.DeleteCategory
+}
+
+func NewCategoryHandler(
+	createUseCase *usecases.CreateCategory,
+	getUseCase *usecases.GetCategory,
+	treeUseCase *usecases.GetCategoryTree,
+	updateUseCase *usecases.UpdateCategory,
+	moveUseCase *usecases.MoveCategory,
+	reorderUseCase *usecases.ReorderCategory,
+	deleteUseCase *usecases.DeleteCategory) *CategoryHandler {
+	return &CategoryHandler{
+		createUseCase:  createUseCase,
+		getUseCase:     getUseCase,
+		treeUseCase:    treeUseCase,
+		updateUseCase:  updateUseCase,
+		moveUseCase:    moveUseCase,
+		reorderUseCase: reorderUseCase,
+		deleteUseCase:  deleteUseCase,
+	}
+}
+
+func (h *CategoryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var input dto.CreateCategoryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Basic validation
+	if strings.TrimSpace(input.Name) == "" {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Name is required", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.createUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Category created successfully", http.StatusCreated)
+}
+
+func (h *CategoryHandler) Get(w http.ResponseWriter, r *http.Request) {
+	result, err := h.getUseCase.Execute(r.Context(), &dto.GetCategoryInput{ID: r.PathValue("id")})
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Category retrieved successfully", http.StatusOK)
+}
+
+func (h *CategoryHandler) Tree(w http.ResponseWriter, r *http.Request) {
+	result, err := h.treeUseCase.Execute(r.Context())
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Category tree retrieved successfully", http.StatusOK)
+}
+
+func (h *CategoryHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var input dto.UpdateCategoryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.ID = r.PathValue("id")
+
+	result, err := h.updateUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Category updated successfully", http.StatusOK)
+}
+
+func (h *CategoryHandler) Move(w http.ResponseWriter, r *http.Request) {
+	var input dto.MoveCategoryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.ID = r.PathValue("id")
+
+	result, err := h.moveUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Category moved successfully", http.StatusOK)
+}
+
+func (h *CategoryHandler) Reorder(w http.ResponseWriter, r *http.Request) {
+	var input dto.ReorderCategoryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.ID = r.PathValue("id")
+
+	result, err := h.reorderUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Category reordered successfully", http.StatusOK)
+}
+
+func (h *CategoryHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.deleteUseCase.Execute(r.Context(), &dto.DeleteCategoryInput{ID: r.PathValue("id")}); err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, nil, "Category deleted successfully", http.StatusOK)
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"net/http"
+
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	middleware "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/middleware"
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/categories/interface/rest/handlers"
+)
+
+func SetupCategoryRoutes(mux *http.ServeMux, categoryHandler *handlers.CategoryHandler, jwtMiddleware *middleware.JWTMiddleware) {
+	// Public endpoints (menu navigasi & section front)
+	mux.HandleFunc("GET /api/v1/categories/tree", categoryHandler.Tree)
+	mux.HandleFunc("GET /api/v1/categories/{id}", categoryHandler.Get)
+
+	// Protected endpoints
+	manage := string(authvo.PermissionCategoryManage)
+	mux.Handle("POST /api/v1/categories", jwtMiddleware.Protect(manage, categoryHandler.Create))
+	mux.Handle("PATCH /api/v1/categories/{id}", jwtMiddleware.Protect(manage, categoryHandler.Update))
+	mux.Handle("DELETE /api/v1/categories/{id}", jwtMiddleware.Protect(manage, categoryHandler.Delete))
+	mux.Handle("POST /api/v1/categories/{id}/move", jwtMiddleware.Protect(manage, categoryHandler.Move))
+	mux.Handle("POST /api/v1/categories/{id}/reorder", jwtMiddleware.Protect(manage, categoryHandler.Reorder))
+}
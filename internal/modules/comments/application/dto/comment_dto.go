@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"time"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// CreateCommentInput: ParentID diisi untuk membalas komentar lain.
+type CreateCommentInput struct {
+	ArticleID string `json:"-"`
+	ParentID  string `json:"parent_id,omitempty"`
+	Body      string `json:"body" validate:"required,max=2000"`
+
+	Actor *shared.Principal `json:"-"`
+}
+
+type ListArticleCommentsInput struct {
+	ArticleID string
+}
+
+// ListModerationQueueInput: Status kosong berarti antrean pending.
+type ListModerationQueueInput struct {
+	Status string
+	Page   int
+	Limit  int
+
+	Actor *shared.Principal
+}
+
+type ModerateCommentInput struct {
+	CommentID string `json:"-"`
+	Action    string `json:"action" validate:"required,oneof=approve reject spam"`
+
+	Actor *shared.Principal `json:"-"`
+}
+
+type CommentOutput struct {
+	ID          string           `json:"id"`
+	ArticleID   string           `json:"article_id"`
+	ParentID    string           `json:"parent_id,omitempty"`
+	AuthorID    string           `json:"author_id"`
+	AuthorName  string           `json:"author_name,omitempty"`
+	Body        string           `json:"body"`
+	Status      string           `json:"status"`
+	Depth       int              `json:"depth"`
+	ModeratedBy string           `json:"moderated_by,omitempty"`
+	ModeratedAt *time.Time       `json:"moderated_at,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	Replies     []*CommentOutput `json:"replies,omitempty"`
+}
+
+// CommentThreadOutput berisi komentar utama beserta balasannya secara bersarang.
+type CommentThreadOutput struct {
+	Items []*CommentOutput `json:"items"`
+	Total int              `json:"total"`
+}
+
+type CommentListOutput struct {
+	Items []*CommentOutput `json:"items"`
+	Page  int              `json:"page"`
+	Limit int              `json:"limit"`
+	Total int              `json:"total"`
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/comments/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/entities"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func toCommentOutput(comment *entities.Comment) *dto.CommentOutput {
+	return &dto.CommentOutput{
+		ID:          comment.ID,
+		ArticleID:   comment.ArticleID,
+		ParentID:    comment.ParentID,
+		AuthorID:    comment.AuthorID,
+		AuthorName:  comment.AuthorName,
+		Body:        comment.Body.String(),
+		Status:      comment.Status.String(),
+		Depth:       comment.Depth,
+		ModeratedBy: comment.ModeratedBy,
+		ModeratedAt: comment.ModeratedAt,
+		CreatedAt:   comment.CreatedAt,
+	}
+}
+
+// buildThread menyusun komentar (urut dari terlama) menjadi pohon balasan.
+// Balasan yang parent-nya tidak ikut (mis. parent di-reject setelah dibalas)
+// tidak ditampilkan. Mengembalikan komentar utama dan jumlah yang tampil.
+func buildThread(comments []*entities.Comment) ([]*dto.CommentOutput, int) {
+	byID := make(map[string]*dto.CommentOutput, len(comments))
+	roots := make([]*dto.CommentOutput, 0)
+	total := 0
+
+	// Urutan created_at menjamin parent selalu diproses sebelum balasannya
+	for _, comment := range comments {
+		output := toCommentOutput(comment)
+
+		if !comment.IsReply() {
+			roots = append(roots, output)
+		} else if parent, ok := byID[comment.ParentID]; ok {
+			parent.Replies = append(parent.Replies, output)
+		} else {
+			continue
+		}
+
+		byID[comment.ID] = output
+		total++
+	}
+
+	return roots, total
+}
+
+func hasPermission(actor *shared.Principal, permission authvo.Permission) bool {
+	return actor != nil && actor.HasPermission(string(permission))
+}
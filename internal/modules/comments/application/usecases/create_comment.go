@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/comments/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// RateLimit membatasi jumlah komentar per user dalam satu jendela waktu.
+// Max <= 0 mematikan pembatasan.
+type RateLimit struct {
+	Max    int
+	Window time.Duration
+}
+
+// CreateComment adalah use case untuk mengirim komentar atau balasan.
+type CreateComment struct {
+	commentRepository repos.CommentRepository
+	uuidGenerator     shared.UUIDGenerator
+	blocklist         vo.Blocklist
+	rateLimit         RateLimit
+	txManager         shared.TxManager
+}
+
+// NewCreateComment adalah konstruktor untuk use case ini.
+func NewCreateComment(
+	commentRepo repos.CommentRepository,
+	uuidGen shared.UUIDGenerator,
+	blocklist vo.Blocklist,
+	rateLimit RateLimit,
+	txManager shared.TxManager) *CreateComment {
+	return &CreateComment{
+		commentRepository: commentRepo,
+		uuidGenerator:     uuidGen,
+		blocklist:         blocklist,
+		rateLimit:         rateLimit,
+		txManager:         txManager,
+	}
+}
+
+// Execute menyimpan komentar baru sebagai pending; komentar baru tampil
+// publik setelah disetujui moderator.
+func (c *CreateComment) Execute(ctx context.Context, input *dto.CreateCommentInput) (*dto.CommentOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+	if !hasPermission(input.Actor, authvo.PermissionCommentCreate) {
+		return nil, shared.NewForbiddenError("You are not allowed to comment")
+	}
+
+	// 1. Validasi Input
+	body, err := vo.NewCommentBody(input.Body)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	if _, blocked := c.blocklist.Match(body.String()); blocked {
+		return nil, shared.NewValidationError("comment contains words that are not allowed")
+	}
+
+	// 2. Komentar hanya untuk artikel yang sudah tayang
+	published, err := c.commentRepository.IsArticlePublished(ctx, input.ArticleID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if !published {
+		return nil, shared.NewNotFoundError("Article not found")
+	}
+
+	var parent *entities.Comment
+	if input.ParentID != "" {
+		parent, err = c.commentRepository.FindByID(ctx, input.ParentID)
+		if err != nil {
+			return nil, shared.NewDatabaseError(err)
+		}
+		if parent == nil {
+			return nil, shared.NewNotFoundError("Parent comment not found")
+		}
+	}
+
+	// 3. Membuat Entity Comment baru
+	comment, err := entities.NewComment(c.uuidGenerator.NewUUID(), input.ArticleID, input.Actor.UserID, *body, parent)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 4. Rate limit per user dan simpan ke antrean moderasi dalam satu transaksi.
+	// ✅ Lock per penulis: request paralel menunggu, sehingga tidak bisa sama-sama
+	// lolos hitungan sebelum salah satunya tersimpan
+	var savedComment *entities.Comment
+	err = c.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if c.rateLimit.Max > 0 {
+			if err := c.commentRepository.LockAuthor(ctx, comment.AuthorID); err != nil {
+				return shared.NewDatabaseError(err)
+			}
+
+			count, err := c.commentRepository.CountByAuthorSince(ctx, comment.AuthorID, time.Now().Add(-c.rateLimit.Window))
+			if err != nil {
+				return shared.NewDatabaseError(err)
+			}
+			if count >= c.rateLimit.Max {
+				return shared.NewRateLimitError("You are commenting too fast, please try again later")
+			}
+		}
+
+		savedComment, err = c.commentRepository.Save(ctx, comment)
+		if err != nil {
+			return shared.NewDatabaseError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCommentOutput(savedComment), nil
+}
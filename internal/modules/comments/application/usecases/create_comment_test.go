@@ -0,0 +1,290 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/comments/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/comments/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// --- Mock Implementations ---
+
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+func (m *MockCommentRepository) Save(ctx context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	args := m.Called(ctx, comment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if fn, ok := args.Get(0).(func(*entities.Comment) *entities.Comment); ok {
+		return fn(comment), args.Error(1)
+	}
+	return args.Get(0).(*entities.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindByID(ctx context.Context, id string) (*entities.Comment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindApprovedByArticle(ctx context.Context, articleID string) ([]*entities.Comment, error) {
+	args := m.Called(ctx, articleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindByStatus(ctx context.Context, status string, limit, offset int) ([]*entities.Comment, int, error) {
+	args := m.Called(ctx, status, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*entities.Comment), args.Int(1), args.Error(2)
+}
+
+func (m *MockCommentRepository) UpdateStatus(ctx context.Context, comment *entities.Comment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) CountByAuthorSince(ctx context.Context, authorID string, since time.Time) (int, error) {
+	args := m.Called(ctx, authorID, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCommentRepository) LockAuthor(ctx context.Context, authorID string) error {
+	args := m.Called(ctx, authorID)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) IsArticlePublished(ctx context.Context, articleID string) (bool, error) {
+	args := m.Called(ctx, articleID)
+	return args.Bool(0), args.Error(1)
+}
+
+type MockUUIDGenerator struct {
+	mock.Mock
+}
+
+func (m *MockUUIDGenerator) NewUUID() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+// --- Helpers ---
+
+var defaultRateLimit = usecases.RateLimit{Max: 3, Window: 10 * time.Minute}
+
+func newPrincipal(userID string, roles ...string) *shared.Principal {
+	return &shared.Principal{
+		UserID:      userID,
+		Roles:       roles,
+		Permissions: authvo.PermissionsForRoles(roles),
+	}
+}
+
+func newStoredComment(t *testing.T, id, parentID, status string) *entities.Comment {
+	t.Helper()
+	body, err := vo.NewCommentBody("Komentar " + id)
+	if err != nil {
+		t.Fatalf("Error creating comment body: %v", err)
+	}
+	statusVO, err := vo.NewCommentStatus(status)
+	if err != nil {
+		t.Fatalf("Error creating comment status: %v", err)
+	}
+	comment, _ := entities.NewComment(id, "article-uuid", "reader-uuid", *body, nil)
+	comment.ParentID = parentID
+	comment.Status = *statusVO
+	return comment
+}
+
+// --- Test Suite ---
+
+func TestCreateComment(t *testing.T) {
+	t.Run("should queue a reply for moderation", func(t *testing.T) {
+		commentRepoMock := new(MockCommentRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		createCommentUsecase := usecases.NewCreateComment(commentRepoMock, uuidGenMock, vo.NewBlocklist(nil), defaultRateLimit, shared.NewInMemoryTxManager())
+
+		parent := newStoredComment(t, "parent-uuid", "", vo.CommentStatusApproved)
+		commentRepoMock.On("LockAuthor", mock.Anything, "reader-uuid").Return(nil).Once()
+		commentRepoMock.On("CountByAuthorSince", mock.Anything, "reader-uuid", mock.AnythingOfType("time.Time")).Return(2, nil).Once()
+		commentRepoMock.On("IsArticlePublished", mock.Anything, "article-uuid").Return(true, nil).Once()
+		commentRepoMock.On("FindByID", mock.Anything, "parent-uuid").Return(parent, nil).Once()
+		uuidGenMock.On("NewUUID").Return("comment-uuid").Once()
+		commentRepoMock.On("Save", mock.Anything, mock.AnythingOfType("*entities.Comment")).
+			Return(func(c *entities.Comment) *entities.Comment { return c }, nil).Once()
+
+		output, err := createCommentUsecase.Execute(context.Background(), &dto.CreateCommentInput{
+			ArticleID: "article-uuid",
+			ParentID:  "parent-uuid",
+			Body:      "  Setuju dengan komentar di atas.  ",
+			Actor:     newPrincipal("reader-uuid", authvo.RoleReader),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "comment-uuid", output.ID)
+		assert.Equal(t, "parent-uuid", output.ParentID)
+		assert.Equal(t, 1, output.Depth)
+		assert.Equal(t, vo.CommentStatusPending, output.Status)
+		assert.Equal(t, "Setuju dengan komentar di atas.", output.Body)
+		commentRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should reject comments containing blocked words", func(t *testing.T) {
+		commentRepoMock := new(MockCommentRepository)
+		createCommentUsecase := usecases.NewCreateComment(commentRepoMock, new(MockUUIDGenerator), vo.NewBlocklist([]string{"judi online"}), defaultRateLimit, shared.NewInMemoryTxManager())
+
+		_, err := createCommentUsecase.Execute(context.Background(), &dto.CreateCommentInput{
+			ArticleID: "article-uuid",
+			Body:      "Daftar JUDI online sekarang!",
+			Actor:     newPrincipal("reader-uuid", authvo.RoleReader),
+		})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		commentRepoMock.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should rate limit users who comment too often", func(t *testing.T) {
+		commentRepoMock := new(MockCommentRepository)
+		uuidGenMock := new(MockUUIDGenerator)
+		txManager := shared.NewInMemoryTxManager()
+		createCommentUsecase := usecases.NewCreateComment(commentRepoMock, uuidGenMock, vo.NewBlocklist(nil), defaultRateLimit, txManager)
+
+		commentRepoMock.On("IsArticlePublished", mock.Anything, "article-uuid").Return(true, nil).Once()
+		uuidGenMock.On("NewUUID").Return("comment-uuid").Once()
+		// ✅ Hitungan dibaca setelah lock penulis diambil, di transaksi yang sama dengan insert
+		commentRepoMock.On("LockAuthor", mock.Anything, "reader-uuid").Return(nil).Once()
+		commentRepoMock.On("CountByAuthorSince", mock.Anything, "reader-uuid", mock.MatchedBy(func(since time.Time) bool {
+			return time.Since(since) >= defaultRateLimit.Window
+		})).Return(3, nil).Once()
+
+		_, err := createCommentUsecase.Execute(context.Background(), &dto.CreateCommentInput{
+			ArticleID: "article-uuid",
+			Body:      "Komentar keempat",
+			Actor:     newPrincipal("reader-uuid", authvo.RoleReader),
+		})
+
+		assert.Equal(t, "RATE_LIMITED", shared.GetErrorCode(err))
+		assert.Equal(t, 429, shared.GetStatusCode(shared.GetErrorCode(err)))
+		assert.Equal(t, 1, txManager.Rollbacks)
+		commentRepoMock.AssertExpectations(t)
+		commentRepoMock.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not allow comments on unpublished articles", func(t *testing.T) {
+		commentRepoMock := new(MockCommentRepository)
+		createCommentUsecase := usecases.NewCreateComment(commentRepoMock, new(MockUUIDGenerator), vo.NewBlocklist(nil), usecases.RateLimit{}, shared.NewInMemoryTxManager())
+
+		commentRepoMock.On("IsArticlePublished", mock.Anything, "draft-uuid").Return(false, nil).Once()
+
+		_, err := createCommentUsecase.Execute(context.Background(), &dto.CreateCommentInput{
+			ArticleID: "draft-uuid",
+			Body:      "Bocoran!",
+			Actor:     newPrincipal("reader-uuid", authvo.RoleReader),
+		})
+
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+		commentRepoMock.AssertNotCalled(t, "CountByAuthorSince", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should require authentication", func(t *testing.T) {
+		createCommentUsecase := usecases.NewCreateComment(new(MockCommentRepository), new(MockUUIDGenerator), vo.NewBlocklist(nil), defaultRateLimit, shared.NewInMemoryTxManager())
+
+		_, err := createCommentUsecase.Execute(context.Background(), &dto.CreateCommentInput{ArticleID: "article-uuid", Body: "Halo"})
+
+		assert.Equal(t, "UNAUTHORIZED", shared.GetErrorCode(err))
+	})
+}
+
+func TestListArticleComments(t *testing.T) {
+	t.Run("should nest approved replies under their parents", func(t *testing.T) {
+		commentRepoMock := new(MockCommentRepository)
+		listCommentsUsecase := usecases.NewListArticleComments(commentRepoMock)
+
+		comments := []*entities.Comment{
+			newStoredComment(t, "c1", "", vo.CommentStatusApproved),
+			newStoredComment(t, "c2", "", vo.CommentStatusApproved),
+			newStoredComment(t, "c1-1", "c1", vo.CommentStatusApproved),
+			newStoredComment(t, "c1-1-1", "c1-1", vo.CommentStatusApproved),
+			// Parent sudah di-reject: balasan ini tidak ikut ditampilkan
+			newStoredComment(t, "orphan", "rejected-uuid", vo.CommentStatusApproved),
+		}
+		commentRepoMock.On("IsArticlePublished", mock.Anything, "article-uuid").Return(true, nil).Once()
+		commentRepoMock.On("FindApprovedByArticle", mock.Anything, "article-uuid").Return(comments, nil).Once()
+
+		output, err := listCommentsUsecase.Execute(context.Background(), &dto.ListArticleCommentsInput{ArticleID: "article-uuid"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 4, output.Total)
+		assert.Len(t, output.Items, 2)
+		assert.Equal(t, "c1-1", output.Items[0].Replies[0].ID)
+		assert.Equal(t, "c1-1-1", output.Items[0].Replies[0].Replies[0].ID)
+		assert.Empty(t, output.Items[1].Replies)
+	})
+}
+
+func TestModerateComment(t *testing.T) {
+	t.Run("should let editors mark a comment as spam", func(t *testing.T) {
+		commentRepoMock := new(MockCommentRepository)
+		moderateCommentUsecase := usecases.NewModerateComment(commentRepoMock)
+
+		comment := newStoredComment(t, "comment-uuid", "", vo.CommentStatusPending)
+		commentRepoMock.On("FindByID", mock.Anything, "comment-uuid").Return(comment, nil).Once()
+		commentRepoMock.On("UpdateStatus", mock.Anything, comment).Return(nil).Once()
+
+		output, err := moderateCommentUsecase.Execute(context.Background(), &dto.ModerateCommentInput{
+			CommentID: "comment-uuid",
+			Action:    "spam",
+			Actor:     newPrincipal("editor-uuid", authvo.RoleEditor),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, vo.CommentStatusSpam, output.Status)
+		assert.Equal(t, "editor-uuid", output.ModeratedBy)
+		commentRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should forbid readers from moderating", func(t *testing.T) {
+		commentRepoMock := new(MockCommentRepository)
+		moderateCommentUsecase := usecases.NewModerateComment(commentRepoMock)
+
+		_, err := moderateCommentUsecase.Execute(context.Background(), &dto.ModerateCommentInput{
+			CommentID: "comment-uuid",
+			Action:    "approve",
+			Actor:     newPrincipal("reader-uuid", authvo.RoleReader),
+		})
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+		commentRepoMock.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should default the queue to pending comments", func(t *testing.T) {
+		commentRepoMock := new(MockCommentRepository)
+		queueUsecase := usecases.NewListModerationQueue(commentRepoMock)
+
+		commentRepoMock.On("FindByStatus", mock.Anything, vo.CommentStatusPending, 20, 0).Return([]*entities.Comment{}, 0, nil).Once()
+
+		output, err := queueUsecase.Execute(context.Background(), &dto.ListModerationQueueInput{Actor: newPrincipal("editor-uuid", authvo.RoleEditor)})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, output.Page)
+		commentRepoMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/comments/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// ListArticleComments adalah use case untuk menampilkan thread komentar publik.
+type ListArticleComments struct {
+	commentRepository repos.CommentRepository
+}
+
+// NewListArticleComments adalah konstruktor untuk use case ini.
+func NewListArticleComments(commentRepo repos.CommentRepository) *ListArticleComments {
+	return &ListArticleComments{
+		commentRepository: commentRepo,
+	}
+}
+
+// Execute hanya menampilkan komentar approved pada artikel yang sudah tayang.
+func (l *ListArticleComments) Execute(ctx context.Context, input *dto.ListArticleCommentsInput) (*dto.CommentThreadOutput, error) {
+	published, err := l.commentRepository.IsArticlePublished(ctx, input.ArticleID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if !published {
+		return nil, shared.NewNotFoundError("Article not found")
+	}
+
+	comments, err := l.commentRepository.FindApprovedByArticle(ctx, input.ArticleID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	items, total := buildThread(comments)
+	return &dto.CommentThreadOutput{Items: items, Total: total}, nil
+}
@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"context"
+
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/comments/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// ModerateComment adalah use case moderator untuk approve, reject atau
+// menandai komentar sebagai spam.
+type ModerateComment struct {
+	commentRepository repos.CommentRepository
+}
+
+// NewModerateComment adalah konstruktor untuk use case ini.
+func NewModerateComment(commentRepo repos.CommentRepository) *ModerateComment {
+	return &ModerateComment{
+		commentRepository: commentRepo,
+	}
+}
+
+func (m *ModerateComment) Execute(ctx context.Context, input *dto.ModerateCommentInput) (*dto.CommentOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+	if !hasPermission(input.Actor, authvo.PermissionCommentModerate) {
+		return nil, shared.NewForbiddenError("You are not allowed to moderate comments")
+	}
+
+	// 1. Validasi Input
+	action, err := vo.NewModerationAction(input.Action)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 2. Mencari komentar
+	comment, err := m.commentRepository.FindByID(ctx, input.CommentID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if comment == nil {
+		return nil, shared.NewNotFoundError("Comment not found")
+	}
+
+	// 3. Menerapkan keputusan
+	if _, err := comment.Moderate(*action, input.Actor.UserID); err != nil {
+		return nil, shared.NewConflictError(err.Error())
+	}
+
+	if err := m.commentRepository.UpdateStatus(ctx, comment); err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	return toCommentOutput(comment), nil
+}
+
+// ListModerationQueue adalah use case untuk antrean moderasi komentar.
+type ListModerationQueue struct {
+	commentRepository repos.CommentRepository
+}
+
+// NewListModerationQueue adalah konstruktor untuk use case ini.
+func NewListModerationQueue(commentRepo repos.CommentRepository) *ListModerationQueue {
+	return &ListModerationQueue{
+		commentRepository: commentRepo,
+	}
+}
+
+// Execute menampilkan komentar dengan status tertentu, terlama lebih dulu.
+func (l *ListModerationQueue) Execute(ctx context.Context, input *dto.ListModerationQueueInput) (*dto.CommentListOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+	if !hasPermission(input.Actor, authvo.PermissionCommentModerate) {
+		return nil, shared.NewForbiddenError("You are not allowed to moderate comments")
+	}
+
+	status := vo.PendingCommentStatus()
+	if input.Status != "" {
+		parsed, err := vo.NewCommentStatus(input.Status)
+		if err != nil {
+			return nil, shared.NewValidationError(err.Error())
+		}
+		status = *parsed
+	}
+
+	page, limit := input.Page, input.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultPageLimit
+	}
+	limit = min(limit, maxPageLimit)
+
+	comments, total, err := l.commentRepository.FindByStatus(ctx, status.String(), limit, (page-1)*limit)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output := &dto.CommentListOutput{
+		Items: make([]*dto.CommentOutput, 0, len(comments)),
+		Page:  page,
+		Limit: limit,
+		Total: total,
+	}
+	for _, comment := range comments {
+		output.Items = append(output.Items, toCommentOutput(comment))
+	}
+
+	return output, nil
+}
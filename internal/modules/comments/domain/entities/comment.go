@@ -0,0 +1,98 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/value_objects"
+)
+
+// MaxCommentDepth adalah kedalaman balasan maksimum (komentar utama = 0).
+const MaxCommentDepth = 4
+
+var (
+	ErrCommentTooDeep           = errors.New("replies can be nested at most 5 levels deep")
+	ErrCommentParentMismatch    = errors.New("parent comment belongs to another article")
+	ErrCommentParentNotApproved = errors.New("cannot reply to a comment that is not approved")
+	ErrCommentAlreadyInStatus   = errors.New("comment already has this status")
+	ErrCommentModeratorEmpty    = errors.New("moderator cannot be empty")
+)
+
+// Comment adalah komentar pembaca pada artikel. ParentID kosong untuk
+// komentar utama; balasan menyimpan Depth supaya thread bisa dibatasi.
+type Comment struct {
+	ID         string
+	ArticleID  string
+	ParentID   string
+	AuthorID   string
+	AuthorName string // diisi repository dari tabel users, hanya untuk ditampilkan
+	Body       vo.CommentBody
+	Status     vo.CommentStatus
+	Depth      int
+
+	ModeratedBy string
+	ModeratedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewComment membuat komentar pending pada artikel, sebagai balasan parent
+// jika parent tidak nil.
+func NewComment(id, articleID, authorID string, body vo.CommentBody, parent *Comment) (*Comment, error) {
+	depth := 0
+	parentID := ""
+	if parent != nil {
+		switch {
+		case parent.ArticleID != articleID:
+			return nil, ErrCommentParentMismatch
+		case !parent.IsApproved():
+			return nil, ErrCommentParentNotApproved
+		case parent.Depth+1 > MaxCommentDepth:
+			return nil, ErrCommentTooDeep
+		}
+		depth = parent.Depth + 1
+		parentID = parent.ID
+	}
+
+	now := time.Now()
+	return &Comment{
+		ID:        id,
+		ArticleID: articleID,
+		ParentID:  parentID,
+		AuthorID:  authorID,
+		Body:      body,
+		Status:    vo.PendingCommentStatus(),
+		Depth:     depth,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Moderate menerapkan keputusan moderator. Mengembalikan status sebelumnya.
+func (c *Comment) Moderate(action vo.ModerationAction, moderatorID string) (string, error) {
+	if moderatorID == "" {
+		return "", ErrCommentModeratorEmpty
+	}
+
+	target := action.TargetStatus()
+	if c.Status.Is(target.String()) {
+		return "", ErrCommentAlreadyInStatus
+	}
+
+	previous := c.Status.String()
+	now := time.Now()
+	c.Status = target
+	c.ModeratedBy = moderatorID
+	c.ModeratedAt = &now
+	c.UpdatedAt = now
+	return previous, nil
+}
+
+func (c *Comment) IsApproved() bool {
+	return c.Status.Is(vo.CommentStatusApproved)
+}
+
+func (c *Comment) IsReply() bool {
+	return c.ParentID != ""
+}
@@ -0,0 +1,100 @@
+package entities_test
+
+import (
+	"errors"
+	"testing"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/value_objects"
+)
+
+func newBody(t *testing.T, text string) vo.CommentBody {
+	t.Helper()
+	body, err := vo.NewCommentBody(text)
+	if err != nil {
+		t.Fatalf("Error creating comment body: %v", err)
+	}
+	return *body
+}
+
+func newAction(t *testing.T, action string) vo.ModerationAction {
+	t.Helper()
+	moderation, err := vo.NewModerationAction(action)
+	if err != nil {
+		t.Fatalf("Error creating moderation action: %v", err)
+	}
+	return *moderation
+}
+
+func TestNewComment(t *testing.T) {
+	root, err := entities.NewComment("c1", "article-uuid", "reader-uuid", newBody(t, "Komentar pertama"), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if !root.Status.Is(vo.CommentStatusPending) {
+		t.Errorf("Expected new comment to be pending, but got %s", root.Status.String())
+	}
+
+	t.Run("should not allow replies to pending comments", func(t *testing.T) {
+		_, err := entities.NewComment("c2", "article-uuid", "reader-uuid", newBody(t, "Balasan"), root)
+		if !errors.Is(err, entities.ErrCommentParentNotApproved) {
+			t.Errorf("Expected error %v, but got %v", entities.ErrCommentParentNotApproved, err)
+		}
+	})
+
+	if _, err := root.Moderate(newAction(t, vo.ModerationApprove), "editor-uuid"); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	t.Run("should nest replies up to the maximum depth", func(t *testing.T) {
+		parent := root
+		for depth := 1; depth <= entities.MaxCommentDepth; depth++ {
+			reply, err := entities.NewComment("reply", "article-uuid", "reader-uuid", newBody(t, "Balasan"), parent)
+			if err != nil {
+				t.Fatalf("Expected no error at depth %d, but got %v", depth, err)
+			}
+			if reply.Depth != depth || reply.ParentID != parent.ID {
+				t.Fatalf("Expected depth %d under %s, but got depth %d under %s", depth, parent.ID, reply.Depth, reply.ParentID)
+			}
+			reply.ID = parent.ID + "-r"
+			reply.Moderate(newAction(t, vo.ModerationApprove), "editor-uuid")
+			parent = reply
+		}
+
+		_, err := entities.NewComment("too-deep", "article-uuid", "reader-uuid", newBody(t, "Balasan"), parent)
+		if !errors.Is(err, entities.ErrCommentTooDeep) {
+			t.Errorf("Expected error %v, but got %v", entities.ErrCommentTooDeep, err)
+		}
+	})
+
+	t.Run("should reject a parent from another article", func(t *testing.T) {
+		_, err := entities.NewComment("c3", "other-article", "reader-uuid", newBody(t, "Balasan"), root)
+		if !errors.Is(err, entities.ErrCommentParentMismatch) {
+			t.Errorf("Expected error %v, but got %v", entities.ErrCommentParentMismatch, err)
+		}
+	})
+}
+
+func TestCommentModerate(t *testing.T) {
+	comment, _ := entities.NewComment("c1", "article-uuid", "reader-uuid", newBody(t, "Beli obat murah di sini"), nil)
+
+	previous, err := comment.Moderate(newAction(t, vo.ModerationSpam), "editor-uuid")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if previous != vo.CommentStatusPending || !comment.Status.Is(vo.CommentStatusSpam) {
+		t.Errorf("Expected pending -> spam, but got %s -> %s", previous, comment.Status.String())
+	}
+	if comment.ModeratedBy != "editor-uuid" || comment.ModeratedAt == nil {
+		t.Error("Expected moderator and moderation time to be recorded")
+	}
+
+	if _, err := comment.Moderate(newAction(t, vo.ModerationSpam), "editor-uuid"); !errors.Is(err, entities.ErrCommentAlreadyInStatus) {
+		t.Errorf("Expected error %v, but got %v", entities.ErrCommentAlreadyInStatus, err)
+	}
+
+	// Salah tandai spam bisa dikoreksi
+	if _, err := comment.Moderate(newAction(t, vo.ModerationApprove), "admin-uuid"); err != nil {
+		t.Errorf("Expected no error, but got %v", err)
+	}
+}
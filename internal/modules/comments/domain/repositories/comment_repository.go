@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/entities"
+)
+
+type CommentRepository interface {
+	Save(ctx context.Context, comment *entities.Comment) (*entities.Comment, error)
+	FindByID(ctx context.Context, id string) (*entities.Comment, error)
+	// FindApprovedByArticle mengembalikan seluruh komentar approved pada
+	// artikel, urut dari yang terlama, untuk disusun menjadi thread.
+	FindApprovedByArticle(ctx context.Context, articleID string) ([]*entities.Comment, error)
+	// FindByStatus adalah antrean moderasi: terlama lebih dulu beserta totalnya.
+	FindByStatus(ctx context.Context, status string, limit, offset int) ([]*entities.Comment, int, error)
+	// UpdateStatus menyimpan keputusan moderasi.
+	UpdateStatus(ctx context.Context, comment *entities.Comment) error
+	// CountByAuthorSince menghitung komentar user sejak waktu tertentu (rate limit).
+	CountByAuthorSince(ctx context.Context, authorID string, since time.Time) (int, error)
+	// LockAuthor mengunci rate limit penulis sampai transaksi selesai.
+	// Harus dipanggil di dalam WithinTx.
+	LockAuthor(ctx context.Context, authorID string) error
+	// IsArticlePublished mengecek artikel di modul articles.
+	IsArticlePublished(ctx context.Context, articleID string) (bool, error)
+}
@@ -0,0 +1,56 @@
+package valueobjects
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Blocklist adalah daftar kata terlarang di komentar. Pencocokan per kata
+// utuh tanpa membedakan huruf besar/kecil, sehingga "asu" tidak memblokir
+// "asuransi". Frasa beberapa kata juga didukung, mis. "judi online".
+type Blocklist struct {
+	phrases [][]string
+}
+
+func NewBlocklist(words []string) Blocklist {
+	var phrases [][]string
+	for _, word := range words {
+		if tokens := tokenize(word); len(tokens) > 0 {
+			phrases = append(phrases, tokens)
+		}
+	}
+	return Blocklist{phrases: phrases}
+}
+
+// Match mengembalikan kata terlarang pertama yang ditemukan di text.
+func (b Blocklist) Match(text string) (string, bool) {
+	if len(b.phrases) == 0 {
+		return "", false
+	}
+
+	tokens := tokenize(text)
+	for _, phrase := range b.phrases {
+		for i := 0; i+len(phrase) <= len(tokens); i++ {
+			if equalTokens(tokens[i:i+len(phrase)], phrase) {
+				return strings.Join(phrase, " "), true
+			}
+		}
+	}
+	return "", false
+}
+
+// tokenize memecah text menjadi kata huruf kecil; tanda baca adalah pemisah.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+func equalTokens(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+package valueobjects_test
+
+import (
+	"testing"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/value_objects"
+)
+
+func TestBlocklist(t *testing.T) {
+	blocklist := vo.NewBlocklist([]string{"Bodoh", " judi online ", "", "asu"})
+
+	blockedCases := []struct {
+		input    string
+		expected string
+	}{
+		{"Dasar BODOH!", "bodoh"},
+		{"Ayo main judi   online di sini", "judi online"},
+		{"asu.", "asu"},
+	}
+
+	for _, tc := range blockedCases {
+		t.Run("block "+tc.input, func(t *testing.T) {
+			word, blocked := blocklist.Match(tc.input)
+			if !blocked {
+				t.Fatalf("Expected '%s' to be blocked", tc.input)
+			}
+			if word != tc.expected {
+				t.Errorf("Expected matched word '%s', but got '%s'", tc.expected, word)
+			}
+		})
+	}
+
+	allowedCases := []string{
+		"Asuransi banjir perlu diperluas.",
+		"Judi itu merugikan, online atau tidak.",
+		"Artikel yang informatif.",
+	}
+
+	for _, input := range allowedCases {
+		t.Run("allow "+input, func(t *testing.T) {
+			if word, blocked := blocklist.Match(input); blocked {
+				t.Errorf("Expected '%s' to be allowed, but matched '%s'", input, word)
+			}
+		})
+	}
+
+	t.Run("empty blocklist allows everything", func(t *testing.T) {
+		if _, blocked := vo.NewBlocklist(nil).Match("bodoh"); blocked {
+			t.Error("Expected empty blocklist to allow every comment")
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// CommentBody adalah isi komentar dalam teks polos. Format (HTML/markdown)
+// tidak didukung; klien wajib meng-escape saat menampilkan.
+type CommentBody struct {
+	value string
+}
+
+const MaxCommentBodyLength = 2000
+
+var (
+	ErrCommentBodyEmpty   = errors.New("comment cannot be empty")
+	ErrCommentBodyTooLong = errors.New("comment must be at most 2000 characters")
+)
+
+func NewCommentBody(value string) (*CommentBody, error) {
+	payload := strings.TrimSpace(value)
+
+	if payload == "" {
+		return nil, ErrCommentBodyEmpty
+	}
+
+	if utf8.RuneCountInString(payload) > MaxCommentBodyLength {
+		return nil, ErrCommentBodyTooLong
+	}
+
+	return &CommentBody{value: payload}, nil
+}
+
+func (b *CommentBody) String() string {
+	return b.value
+}
+
+func (b *CommentBody) Value() string {
+	return b.value
+}
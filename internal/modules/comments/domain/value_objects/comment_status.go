@@ -0,0 +1,62 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// CommentStatus adalah status moderasi komentar. Setiap komentar baru
+// masuk antrean moderasi (pending) dan hanya yang approved tampil publik.
+type CommentStatus struct {
+	value string
+}
+
+const (
+	CommentStatusPending  = "pending"
+	CommentStatusApproved = "approved"
+	CommentStatusRejected = "rejected"
+	CommentStatusSpam     = "spam"
+)
+
+var validCommentStatuses = map[string]bool{
+	CommentStatusPending:  true,
+	CommentStatusApproved: true,
+	CommentStatusRejected: true,
+	CommentStatusSpam:     true,
+}
+
+var (
+	ErrCommentStatusEmpty   = errors.New("comment status cannot be empty")
+	ErrCommentStatusInvalid = errors.New("comment status must be one of pending, approved, rejected, spam")
+)
+
+func NewCommentStatus(value string) (*CommentStatus, error) {
+	payload := strings.ToLower(strings.TrimSpace(value))
+
+	if payload == "" {
+		return nil, ErrCommentStatusEmpty
+	}
+
+	if !validCommentStatuses[payload] {
+		return nil, ErrCommentStatusInvalid
+	}
+
+	return &CommentStatus{value: payload}, nil
+}
+
+// PendingCommentStatus adalah status awal setiap komentar baru.
+func PendingCommentStatus() CommentStatus {
+	return CommentStatus{value: CommentStatusPending}
+}
+
+func (s *CommentStatus) String() string {
+	return s.value
+}
+
+func (s *CommentStatus) Value() string {
+	return s.value
+}
+
+func (s *CommentStatus) Is(status string) bool {
+	return s.value == status
+}
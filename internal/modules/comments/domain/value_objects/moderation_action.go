@@ -0,0 +1,57 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// ModerationAction adalah keputusan moderator atas sebuah komentar.
+type ModerationAction struct {
+	value string
+}
+
+const (
+	ModerationApprove = "approve"
+	ModerationReject  = "reject"
+	ModerationSpam    = "spam"
+)
+
+// moderationTargets: aksi -> status tujuan. Keputusan boleh dikoreksi,
+// mis. komentar approved yang ternyata spam, atau spam yang salah tandai.
+var moderationTargets = map[string]string{
+	ModerationApprove: CommentStatusApproved,
+	ModerationReject:  CommentStatusRejected,
+	ModerationSpam:    CommentStatusSpam,
+}
+
+var (
+	ErrModerationActionEmpty   = errors.New("moderation action cannot be empty")
+	ErrModerationActionInvalid = errors.New("moderation action must be one of approve, reject, spam")
+)
+
+func NewModerationAction(value string) (*ModerationAction, error) {
+	payload := strings.ToLower(strings.TrimSpace(value))
+
+	if payload == "" {
+		return nil, ErrModerationActionEmpty
+	}
+
+	if _, ok := moderationTargets[payload]; !ok {
+		return nil, ErrModerationActionInvalid
+	}
+
+	return &ModerationAction{value: payload}, nil
+}
+
+// TargetStatus adalah status komentar setelah aksi ini diterapkan.
+func (a *ModerationAction) TargetStatus() CommentStatus {
+	return CommentStatus{value: moderationTargets[a.value]}
+}
+
+func (a *ModerationAction) String() string {
+	return a.value
+}
+
+func (a *ModerationAction) Value() string {
+	return a.value
+}
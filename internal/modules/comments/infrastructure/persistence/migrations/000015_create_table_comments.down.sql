@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS update_comments_updated_at ON comments;
+DROP INDEX IF EXISTS idx_comments_parent_id;
+DROP INDEX IF EXISTS idx_comments_author_created;
+DROP INDEX IF EXISTS idx_comments_status_created;
+DROP INDEX IF EXISTS idx_comments_article_status_created;
+DROP TABLE IF EXISTS comments;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS comments (
+    id VARCHAR(255) PRIMARY KEY,
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    parent_id VARCHAR(255) REFERENCES comments(id) ON DELETE CASCADE,
+    author_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'spam')),
+    depth SMALLINT NOT NULL DEFAULT 0,
+    moderated_by VARCHAR(255) REFERENCES users(id) ON DELETE SET NULL,
+    moderated_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index untuk performance
+-- Thread publik per artikel dan antrean moderasi (terlama lebih dulu)
+CREATE INDEX IF NOT EXISTS idx_comments_article_status_created ON comments(article_id, status, created_at);
+CREATE INDEX IF NOT EXISTS idx_comments_status_created ON comments(status, created_at);
+-- Rate limit per user
+CREATE INDEX IF NOT EXISTS idx_comments_author_created ON comments(author_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments(parent_id);
+
+-- Trigger untuk auto-update updated_at (function dibuat oleh migration users)
+CREATE TRIGGER update_comments_updated_at
+    BEFORE UPDATE ON comments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/comments/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type CommentRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewCommentRepositoryPostgres(db *sql.DB) repos.CommentRepository {
+	return &CommentRepositoryPostgres{db: db}
+}
+
+// commentColumns selalu di-join dengan users untuk nama penulis.
+const commentColumns = "c.id, c.article_id, c.parent_id, c.author_id, u.username, c.body, c.status, c.depth, c.moderated_by, c.moderated_at, c.created_at, c.updated_at"
+
+const commentFrom = " FROM comments c JOIN users u ON u.id = c.author_id"
+
+// rowScanner dipenuhi oleh *sql.Row dan *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (r *CommentRepositoryPostgres) Save(ctx context.Context, comment *entities.Comment) (*entities.Comment, error) {
+	query := `
+		INSERT INTO comments (id, article_id, parent_id, author_id, body, status, depth, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING created_at, updated_at
+	`
+	var createdAt, updatedAt time.Time
+
+	err := shared.Executor(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		comment.ID,
+		comment.ArticleID,
+		nullString(comment.ParentID),
+		comment.AuthorID,
+		comment.Body.String(),
+		comment.Status.String(),
+		comment.Depth,
+		comment.CreatedAt,
+		comment.UpdatedAt,
+	).Scan(&createdAt, &updatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	comment.CreatedAt = createdAt
+	comment.UpdatedAt = updatedAt
+
+	return comment, nil
+}
+
+func (r *CommentRepositoryPostgres) FindByID(ctx context.Context, id string) (*entities.Comment, error) {
+	query := "SELECT " + commentColumns + commentFrom + " WHERE c.id = $1"
+
+	comment, err := scanComment(shared.Executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil // Komentar tidak ditemukan
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (r *CommentRepositoryPostgres) FindApprovedByArticle(ctx context.Context, articleID string) ([]*entities.Comment, error) {
+	query := "SELECT " + commentColumns + commentFrom + " WHERE c.article_id = $1 AND c.status = $2 ORDER BY c.created_at"
+
+	return r.query(ctx, query, articleID, vo.CommentStatusApproved)
+}
+
+func (r *CommentRepositoryPostgres) FindByStatus(ctx context.Context, status string, limit, offset int) ([]*entities.Comment, int, error) {
+	var total int
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM comments WHERE status = $1", status).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := "SELECT " + commentColumns + commentFrom + " WHERE c.status = $1 ORDER BY c.created_at LIMIT $2 OFFSET $3"
+
+	comments, err := r.query(ctx, query, status, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+func (r *CommentRepositoryPostgres) UpdateStatus(ctx context.Context, comment *entities.Comment) error {
+	query := `
+		UPDATE comments
+		SET status = $2, moderated_by = $3, moderated_at = $4, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	return shared.Executor(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		comment.ID,
+		comment.Status.String(),
+		nullString(comment.ModeratedBy),
+		comment.ModeratedAt,
+	).Scan(&comment.UpdatedAt)
+}
+
+func (r *CommentRepositoryPostgres) CountByAuthorSince(ctx context.Context, authorID string, since time.Time) (int, error) {
+	query := "SELECT COUNT(*) FROM comments WHERE author_id = $1 AND created_at >= $2"
+
+	var count int
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, authorID, since).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *CommentRepositoryPostgres) LockAuthor(ctx context.Context, authorID string) error {
+	// Advisory lock per penulis, dilepas otomatis saat commit atau rollback
+	query := "SELECT pg_advisory_xact_lock(hashtext($1))"
+
+	_, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, "comments:"+authorID)
+	return err
+}
+
+func (r *CommentRepositoryPostgres) IsArticlePublished(ctx context.Context, articleID string) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM articles WHERE id = $1 AND status = 'published' AND deleted_at IS NULL)"
+
+	var published bool
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, articleID).Scan(&published); err != nil {
+		return false, err
+	}
+	return published, nil
+}
+
+func (r *CommentRepositoryPostgres) query(ctx context.Context, query string, args ...interface{}) ([]*entities.Comment, error) {
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []*entities.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+
+	return comments, rows.Err()
+}
+
+// nullString menyimpan string kosong sebagai NULL (untuk kolom foreign key opsional).
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// scanComment membaca satu baris commentColumns dan membuat ulang value object-nya.
+func scanComment(row rowScanner) (*entities.Comment, error) {
+	var comment entities.Comment
+	var body, status string
+	var parentID, moderatedBy sql.NullString
+	var moderatedAt sql.NullTime
+
+	err := row.Scan(
+		&comment.ID,
+		&comment.ArticleID,
+		&parentID,
+		&comment.AuthorID,
+		&comment.AuthorName,
+		&body,
+		&status,
+		&comment.Depth,
+		&moderatedBy,
+		&moderatedAt,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// ✅ Recreate value objects dari data yang diambil
+	bodyVO, err := vo.NewCommentBody(body)
+	if err != nil {
+		return nil, err
+	}
+	comment.Body = *bodyVO
+
+	statusVO, err := vo.NewCommentStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	comment.Status = *statusVO
+
+	comment.ParentID = parentID.String
+	comment.ModeratedBy = moderatedBy.String
+	if moderatedAt.Valid {
+		comment.ModeratedAt = &moderatedAt.Time
+	}
+
+	return &comment, nil
+}
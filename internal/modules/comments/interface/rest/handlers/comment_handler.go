@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/comments/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/comments/application/usecases"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type CommentHandler struct {
+	createUseCase   *usecases.CreateComment
+	listUseCase     *usecases.ListArticleComments
+	queueUseCase    *usecases.ListModerationQueue
+	moderateUseCase *usecases.ModerateComment
+}
+
+func NewCommentHandler(
+	createUseCase *usecases.CreateComment,
+	listUseCase *usecases.ListArticleComments,
+	queueUseCase *usecases.ListModerationQueue,
+	moderateUseCase *usecases.ModerateComment) *CommentHandler {
+	return &CommentHandler{
+		createUseCase:   createUseCase,
+		listUseCase:     listUseCase,
+		queueUseCase:    queueUseCase,
+		moderateUseCase: moderateUseCase,
+	}
+}
+
+func (h *CommentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var input dto.CreateCommentInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.ArticleID = r.PathValue("id")
+	input.Actor = actor(r)
+
+	// Basic validation
+	if strings.TrimSpace(input.Body) == "" {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Comment body is required", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.createUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Comment submitted for moderation", http.StatusCreated)
+}
+
+func (h *CommentHandler) List(w http.ResponseWriter, r *http.Request) {
+	result, err := h.listUseCase.Execute(r.Context(), &dto.ListArticleCommentsInput{ArticleID: r.PathValue("id")})
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Comments retrieved successfully", http.StatusOK)
+}
+
+func (h *CommentHandler) Queue(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	input := dto.ListModerationQueueInput{
+		Status: query.Get("status"),
+		Page:   page,
+		Limit:  limit,
+		Actor:  actor(r),
+	}
+
+	result, err := h.queueUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Comments retrieved successfully", http.StatusOK)
+}
+
+func (h *CommentHandler) Moderate(w http.ResponseWriter, r *http.Request) {
+	var input dto.ModerateCommentInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.CommentID = r.PathValue("id")
+	input.Actor = actor(r)
+
+	// Basic validation
+	if strings.TrimSpace(input.Action) == "" {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Action is required", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.moderateUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Comment moderated successfully", http.StatusOK)
+}
+
+func actor(r *http.Request) *shared.Principal {
+	principal, ok := shared.PrincipalFromContext(r.Context())
+	if !ok {
+		return nil
+	}
+	return principal
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"net/http"
+
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	middleware "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/middleware"
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/comments/interface/rest/handlers"
+)
+
+func SetupCommentRoutes(mux *http.ServeMux, commentHandler *handlers.CommentHandler, jwtMiddleware *middleware.JWTMiddleware) {
+	// Public endpoints (thread komentar approved)
+	mux.HandleFunc("GET /api/v1/articles/{id}/comments", commentHandler.List)
+
+	// Protected endpoints
+	mux.Handle("POST /api/v1/articles/{id}/comments", jwtMiddleware.Protect(string(authvo.PermissionCommentCreate), commentHandler.Create))
+
+	// Moderasi
+	mux.Handle("GET /api/v1/comments/moderation", jwtMiddleware.Protect(string(authvo.PermissionCommentModerate), commentHandler.Queue))
+	mux.Handle("POST /api/v1/comments/{id}/moderate", jwtMiddleware.Protect(string(authvo.PermissionCommentModerate), commentHandler.Moderate))
+}
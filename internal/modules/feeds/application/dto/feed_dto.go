@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+// GetFeedInput: SectionPath dan Tag kosong berarti feed artikel terbaru.
+type GetFeedInput struct {
+	Format      string
+	SectionPath string
+	Tag         string
+}
+
+// FeedOutput berisi data feed dengan URL absolut, siap dirender sebagai
+// RSS maupun Atom. Path adalah path kanonis feed ini (tanpa host).
+type FeedOutput struct {
+	Format       string
+	ContentType  string
+	ID           string
+	Title        string
+	Description  string
+	Link         string
+	SelfLink     string
+	Path         string
+	ETag         string
+	LastModified time.Time
+	Items        []FeedItemOutput
+}
+
+type FeedItemOutput struct {
+	GUID        string
+	Title       string
+	Link        string
+	Summary     string
+	Author      string
+	Categories  []string
+	Enclosure   *FeedEnclosureOutput
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+type FeedEnclosureOutput struct {
+	URL    string
+	Type   string
+	Length int64
+}
@@ -0,0 +1,193 @@
+package usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/domain/value_objects"
+	tagvo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	defaultFeedItemLimit = 20
+	summaryLength        = 280
+)
+
+// FeedSettings adalah identitas situs untuk feed. SiteURL dan MediaBaseURL
+// dipakai untuk membangun URL absolut; MediaBaseURL boleh relatif terhadap SiteURL.
+type FeedSettings struct {
+	Title        string
+	Description  string
+	SiteURL      string
+	MediaBaseURL string
+	ItemLimit    int
+}
+
+// GetFeed adalah use case untuk membangun feed RSS/Atom artikel published.
+type GetFeed struct {
+	feedRepository repos.FeedRepository
+	settings       FeedSettings
+}
+
+// NewGetFeed adalah konstruktor untuk use case ini.
+func NewGetFeed(feedRepo repos.FeedRepository, settings FeedSettings) *GetFeed {
+	settings.SiteURL = strings.TrimSuffix(settings.SiteURL, "/")
+	settings.MediaBaseURL = strings.TrimSuffix(settings.MediaBaseURL, "/")
+	if !strings.Contains(settings.MediaBaseURL, "://") {
+		settings.MediaBaseURL = settings.SiteURL + "/" + strings.TrimPrefix(settings.MediaBaseURL, "/")
+	}
+	if settings.ItemLimit < 1 {
+		settings.ItemLimit = defaultFeedItemLimit
+	}
+
+	return &GetFeed{
+		feedRepository: feedRepo,
+		settings:       settings,
+	}
+}
+
+// Execute membangun feed terbaru, feed section, atau feed tag.
+func (g *GetFeed) Execute(ctx context.Context, input *dto.GetFeedInput) (*dto.FeedOutput, error) {
+	// 1. Validasi Input
+	format, err := vo.NewFeedFormat(input.Format)
+	if err != nil {
+		return nil, shared.NewNotFoundError("Feed not found")
+	}
+
+	output := &dto.FeedOutput{
+		Format:      format.String(),
+		ContentType: format.ContentType(),
+		Title:       g.settings.Title,
+		Description: g.settings.Description,
+		Link:        g.settings.SiteURL + "/",
+		Path:        "/feeds/latest" + format.Extension(),
+	}
+	filter := repos.FeedFilter{Limit: g.settings.ItemLimit}
+
+	// 2. Menentukan cakupan feed
+	switch {
+	case input.SectionPath != "":
+		section, err := g.feedRepository.FindSection(ctx, "/"+strings.Trim(input.SectionPath, "/")+"/")
+		if err != nil {
+			return nil, shared.NewDatabaseError(err)
+		}
+		if section == nil {
+			return nil, shared.NewNotFoundError("Section not found")
+		}
+		filter.CategoryPath = section.Path
+		output.Title = g.settings.Title + " - " + section.Name
+		output.Description = firstNonEmpty(section.Description, output.Description)
+		output.Link = g.settings.SiteURL + "/section" + section.Path
+		output.Path = "/feeds/section" + strings.TrimSuffix(section.Path, "/") + format.Extension()
+
+	case input.Tag != "":
+		tag, err := g.feedRepository.FindTag(ctx, tagvo.NormalizeTagSlug(input.Tag))
+		if err != nil {
+			return nil, shared.NewDatabaseError(err)
+		}
+		if tag == nil {
+			return nil, shared.NewNotFoundError("Tag not found")
+		}
+		filter.TagID = tag.ID
+		output.Title = g.settings.Title + " - " + tag.Name
+		output.Link = g.settings.SiteURL + "/tag/" + tag.Slug
+		output.Path = "/feeds/tag/" + tag.Slug + format.Extension()
+	}
+	output.SelfLink = g.settings.SiteURL + output.Path
+	output.ID = output.SelfLink
+
+	// 3. Ambil artikel
+	lastModified, err := g.feedRepository.LastModified(ctx, filter)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	items, err := g.feedRepository.FindItems(ctx, filter)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output.LastModified = lastModified.UTC().Truncate(time.Second)
+	output.Items = make([]dto.FeedItemOutput, 0, len(items))
+	for _, item := range items {
+		output.Items = append(output.Items, g.toFeedItemOutput(item))
+	}
+	output.ETag = feedETag(output, items)
+
+	return output, nil
+}
+
+func (g *GetFeed) toFeedItemOutput(item *entities.FeedItem) dto.FeedItemOutput {
+	categories := make([]string, 0, len(item.Tags)+1)
+	if item.Section != "" {
+		categories = append(categories, item.Section)
+	}
+	categories = append(categories, item.Tags...)
+
+	output := dto.FeedItemOutput{
+		// ✅ GUID dari ID artikel, bukan slug, agar tetap sama saat slug diganti
+		GUID:        "urn:uuid:" + item.ArticleID,
+		Title:       item.Title,
+		Link:        g.settings.SiteURL + "/articles/" + item.Slug,
+		Summary:     summarize(item),
+		Author:      item.AuthorName,
+		Categories:  categories,
+		PublishedAt: item.PublishedAt.UTC(),
+		UpdatedAt:   item.UpdatedAt.UTC(),
+	}
+
+	if item.Image != nil {
+		output.Enclosure = &dto.FeedEnclosureOutput{
+			URL:    g.settings.MediaBaseURL + "/" + item.Image.StorageKey,
+			Type:   item.Image.ContentType,
+			Length: item.Image.Size,
+		}
+	}
+
+	return output
+}
+
+// summarize memakai excerpt, atau potongan awal isi artikel jika excerpt kosong.
+func summarize(item *entities.FeedItem) string {
+	if summary := strings.TrimSpace(item.Excerpt); summary != "" {
+		return summary
+	}
+
+	body := strings.Join(strings.Fields(item.Body), " ")
+	if utf8.RuneCountInString(body) <= summaryLength {
+		return body
+	}
+	runes := []rune(body)[:summaryLength]
+	if cut := strings.LastIndex(string(runes), " "); cut > 0 {
+		return string(runes)[:cut] + "…"
+	}
+	return string(runes) + "…"
+}
+
+// feedETag dihitung dari format, cakupan dan versi setiap artikel, sehingga
+// berubah setiap ada artikel baru, diperbarui, atau keluar dari feed.
+func feedETag(feed *dto.FeedOutput, items []*entities.FeedItem) string {
+	hash := sha256.New()
+	hash.Write([]byte(feed.Path + "\n" + feed.Title + "\n" + feed.LastModified.Format(time.RFC3339) + "\n"))
+	for _, item := range items {
+		hash.Write([]byte(item.ArticleID + "@" + strconv.FormatInt(item.UpdatedAt.UnixNano(), 36) + "\n"))
+	}
+	return `"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,169 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// --- Mock Implementations ---
+
+type MockFeedRepository struct {
+	mock.Mock
+}
+
+func (m *MockFeedRepository) FindSection(ctx context.Context, path string) (*entities.FeedSource, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.FeedSource), args.Error(1)
+}
+
+func (m *MockFeedRepository) FindTag(ctx context.Context, slug string) (*entities.FeedSource, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.FeedSource), args.Error(1)
+}
+
+func (m *MockFeedRepository) FindItems(ctx context.Context, filter repos.FeedFilter) ([]*entities.FeedItem, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.FeedItem), args.Error(1)
+}
+
+func (m *MockFeedRepository) LastModified(ctx context.Context, filter repos.FeedFilter) (time.Time, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+// --- Helper ---
+
+var feedSettings = usecases.FeedSettings{
+	Title:        "Kabar",
+	Description:  "Berita terbaru",
+	SiteURL:      "https://kabar.example/",
+	MediaBaseURL: "/media",
+	ItemLimit:    10,
+}
+
+func newFeedItem(id string, updatedAt time.Time) *entities.FeedItem {
+	return &entities.FeedItem{
+		ArticleID:   id,
+		Title:       "Pemilu 2029 dimulai",
+		Slug:        "pemilu-2029-dimulai",
+		Body:        "Isi berita.",
+		AuthorName:  "budi",
+		Section:     "Politik",
+		Tags:        []string{"Pemilu"},
+		PublishedAt: updatedAt.Add(-time.Hour),
+		UpdatedAt:   updatedAt,
+	}
+}
+
+// --- Test Suite ---
+
+func TestGetFeed(t *testing.T) {
+	updatedAt := time.Date(2029, 2, 14, 8, 30, 15, 500, time.UTC)
+
+	t.Run("should build the latest feed with absolute links and enclosures", func(t *testing.T) {
+		feedRepoMock := new(MockFeedRepository)
+		getFeedUsecase := usecases.NewGetFeed(feedRepoMock, feedSettings)
+
+		item := newFeedItem("article-uuid", updatedAt)
+		item.Image = &entities.FeedImage{StorageKey: "2029/02/photo.jpg", ContentType: "image/jpeg", Size: 2048}
+		filter := repos.FeedFilter{Limit: 10}
+		feedRepoMock.On("LastModified", mock.Anything, filter).Return(updatedAt, nil).Once()
+		feedRepoMock.On("FindItems", mock.Anything, filter).Return([]*entities.FeedItem{item}, nil).Once()
+
+		output, err := getFeedUsecase.Execute(context.Background(), &dto.GetFeedInput{Format: "rss"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "/feeds/latest.rss", output.Path)
+		assert.Equal(t, "https://kabar.example/feeds/latest.rss", output.SelfLink)
+		assert.Equal(t, updatedAt.Truncate(time.Second), output.LastModified)
+		assert.NotEmpty(t, output.ETag)
+		assert.Len(t, output.Items, 1)
+		assert.Equal(t, "urn:uuid:article-uuid", output.Items[0].GUID)
+		assert.Equal(t, "https://kabar.example/articles/pemilu-2029-dimulai", output.Items[0].Link)
+		assert.Equal(t, []string{"Politik", "Pemilu"}, output.Items[0].Categories)
+		assert.Equal(t, &dto.FeedEnclosureOutput{URL: "https://kabar.example/media/2029/02/photo.jpg", Type: "image/jpeg", Length: 2048}, output.Items[0].Enclosure)
+		feedRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should scope a section feed to its subtree", func(t *testing.T) {
+		feedRepoMock := new(MockFeedRepository)
+		getFeedUsecase := usecases.NewGetFeed(feedRepoMock, feedSettings)
+
+		section := &entities.FeedSource{ID: "politik-uuid", Name: "Politik", Slug: "politik", Path: "/berita/politik/"}
+		filter := repos.FeedFilter{CategoryPath: "/berita/politik/", Limit: 10}
+		feedRepoMock.On("FindSection", mock.Anything, "/politik/").Return(section, nil).Once()
+		feedRepoMock.On("LastModified", mock.Anything, filter).Return(updatedAt, nil).Once()
+		feedRepoMock.On("FindItems", mock.Anything, filter).Return([]*entities.FeedItem{}, nil).Once()
+
+		output, err := getFeedUsecase.Execute(context.Background(), &dto.GetFeedInput{Format: "atom", SectionPath: "politik"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Kabar - Politik", output.Title)
+		assert.Equal(t, "/feeds/section/berita/politik.atom", output.Path)
+		feedRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should resolve the canonical slug of a tag feed", func(t *testing.T) {
+		feedRepoMock := new(MockFeedRepository)
+		getFeedUsecase := usecases.NewGetFeed(feedRepoMock, feedSettings)
+
+		tag := &entities.FeedSource{ID: "pemilu-uuid", Name: "Pemilu", Slug: "pemilu"}
+		filter := repos.FeedFilter{TagID: "pemilu-uuid", Limit: 10}
+		feedRepoMock.On("FindTag", mock.Anything, "pemilu-2029").Return(tag, nil).Once()
+		feedRepoMock.On("LastModified", mock.Anything, filter).Return(updatedAt, nil).Once()
+		feedRepoMock.On("FindItems", mock.Anything, filter).Return([]*entities.FeedItem{}, nil).Once()
+
+		output, err := getFeedUsecase.Execute(context.Background(), &dto.GetFeedInput{Format: "rss", Tag: "Pemilu 2029"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "/feeds/tag/pemilu.rss", output.Path)
+		feedRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should change the ETag when an article is updated", func(t *testing.T) {
+		feedRepoMock := new(MockFeedRepository)
+		getFeedUsecase := usecases.NewGetFeed(feedRepoMock, feedSettings)
+
+		filter := repos.FeedFilter{Limit: 10}
+		feedRepoMock.On("LastModified", mock.Anything, filter).Return(updatedAt, nil).Twice()
+		feedRepoMock.On("FindItems", mock.Anything, filter).Return([]*entities.FeedItem{newFeedItem("article-uuid", updatedAt)}, nil).Once()
+		feedRepoMock.On("FindItems", mock.Anything, filter).Return([]*entities.FeedItem{newFeedItem("article-uuid", updatedAt.Add(time.Millisecond))}, nil).Once()
+
+		first, _ := getFeedUsecase.Execute(context.Background(), &dto.GetFeedInput{Format: "rss"})
+		second, _ := getFeedUsecase.Execute(context.Background(), &dto.GetFeedInput{Format: "rss"})
+
+		assert.NotEqual(t, first.ETag, second.ETag)
+	})
+
+	t.Run("should return not found for an unknown section or format", func(t *testing.T) {
+		feedRepoMock := new(MockFeedRepository)
+		getFeedUsecase := usecases.NewGetFeed(feedRepoMock, feedSettings)
+
+		feedRepoMock.On("FindSection", mock.Anything, "/unknown/").Return(nil, nil).Once()
+
+		_, err := getFeedUsecase.Execute(context.Background(), &dto.GetFeedInput{Format: "atom", SectionPath: "unknown"})
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+
+		_, err = getFeedUsecase.Execute(context.Background(), &dto.GetFeedInput{Format: "json"})
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+	})
+}
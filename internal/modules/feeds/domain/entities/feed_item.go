@@ -0,0 +1,36 @@
+package entities
+
+import "time"
+
+// FeedItem adalah artikel published dalam bentuk yang dibutuhkan feed:
+// sudah di-join dengan nama penulis, section, tag dan gambar utama.
+type FeedItem struct {
+	ArticleID   string
+	Title       string
+	Slug        string
+	Excerpt     string
+	Body        string
+	AuthorName  string
+	Section     string // nama kategori, kosong jika tanpa section
+	Tags        []string
+	Image       *FeedImage // nil jika artikel tanpa gambar utama
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+// FeedImage adalah file asli gambar utama, dipakai sebagai enclosure.
+type FeedImage struct {
+	StorageKey  string
+	ContentType string
+	Size        int64
+}
+
+// FeedSource adalah section (kategori) atau tag yang menjadi cakupan feed.
+// Path hanya terisi untuk section, mis. "/berita/politik/".
+type FeedSource struct {
+	ID          string
+	Name        string
+	Slug        string
+	Description string
+	Path        string
+}
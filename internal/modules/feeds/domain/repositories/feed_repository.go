@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/domain/entities"
+)
+
+// FeedFilter adalah cakupan feed. CategoryPath mencakup seluruh subtree
+// kategori; filter kosong berarti semua artikel published.
+type FeedFilter struct {
+	CategoryPath string
+	TagID        string
+	Limit        int
+}
+
+type FeedRepository interface {
+	// FindSection mencari kategori berdasarkan materialized path. Jika path
+	// hanya satu segmen, slug yang unik di kedalaman mana pun juga cocok.
+	FindSection(ctx context.Context, path string) (*entities.FeedSource, error)
+	// FindTag juga menerima slug lama dari tag yang sudah di-merge.
+	FindTag(ctx context.Context, slug string) (*entities.FeedSource, error)
+	// FindItems mengembalikan artikel published terbaru dalam cakupan filter.
+	FindItems(ctx context.Context, filter FeedFilter) ([]*entities.FeedItem, error)
+	// LastModified adalah updated_at terbaru dari artikel dalam cakupan filter,
+	// termasuk yang sudah tidak published, supaya unpublish juga mengubah feed.
+	LastModified(ctx context.Context, filter FeedFilter) (time.Time, error)
+}
@@ -0,0 +1,52 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// FeedFormat adalah format sindikasi feed, diambil dari ekstensi URL
+// (mis. latest.rss, politik.atom).
+type FeedFormat struct {
+	value string
+}
+
+const (
+	FeedFormatRSS  = "rss"
+	FeedFormatAtom = "atom"
+)
+
+var feedContentTypes = map[string]string{
+	FeedFormatRSS:  "application/rss+xml; charset=utf-8",
+	FeedFormatAtom: "application/atom+xml; charset=utf-8",
+}
+
+var ErrFeedFormatInvalid = errors.New("feed format must be one of rss, atom")
+
+func NewFeedFormat(value string) (*FeedFormat, error) {
+	payload := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(value), "."))
+
+	if _, ok := feedContentTypes[payload]; !ok {
+		return nil, ErrFeedFormatInvalid
+	}
+
+	return &FeedFormat{value: payload}, nil
+}
+
+// ContentType adalah media type untuk header Content-Type.
+func (f *FeedFormat) ContentType() string {
+	return feedContentTypes[f.value]
+}
+
+// Extension adalah ekstensi URL feed, mis. ".atom".
+func (f *FeedFormat) Extension() string {
+	return "." + f.value
+}
+
+func (f *FeedFormat) String() string {
+	return f.value
+}
+
+func (f *FeedFormat) Value() string {
+	return f.value
+}
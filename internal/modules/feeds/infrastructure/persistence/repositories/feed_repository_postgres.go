@@ -0,0 +1,240 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type FeedRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewFeedRepositoryPostgres(db *sql.DB) repos.FeedRepository {
+	return &FeedRepositoryPostgres{db: db}
+}
+
+func (r *FeedRepositoryPostgres) FindSection(ctx context.Context, path string) (*entities.FeedSource, error) {
+	// Slug satu segmen (mis. "politik") juga dicocokkan di kedalaman mana pun
+	slug := ""
+	if segments := strings.Split(strings.Trim(path, "/"), "/"); len(segments) == 1 {
+		slug = segments[0]
+	}
+
+	query := `
+		SELECT id, name, slug, description, path
+		FROM categories
+		WHERE path = $1 OR ($2 <> '' AND slug = $2)
+		ORDER BY path = $1 DESC, depth
+		LIMIT 2
+	`
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, path, slug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sections []*entities.FeedSource
+	for rows.Next() {
+		var section entities.FeedSource
+		if err := rows.Scan(&section.ID, &section.Name, &section.Slug, &section.Description, &section.Path); err != nil {
+			return nil, err
+		}
+		sections = append(sections, &section)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Slug yang dipakai lebih dari satu section dianggap tidak ditemukan
+	if len(sections) == 0 || (sections[0].Path != path && len(sections) > 1) {
+		return nil, nil
+	}
+
+	return sections[0], nil
+}
+
+func (r *FeedRepositoryPostgres) FindTag(ctx context.Context, slug string) (*entities.FeedSource, error) {
+	query := `
+		SELECT id, name, slug
+		FROM tags
+		WHERE slug = $1 OR id = (SELECT tag_id FROM tag_aliases WHERE slug = $1)
+		ORDER BY slug = $1 DESC
+		LIMIT 1
+	`
+
+	var tag entities.FeedSource
+	err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, slug).Scan(&tag.ID, &tag.Name, &tag.Slug)
+	if err == sql.ErrNoRows {
+		return nil, nil // Tag tidak ditemukan
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+func (r *FeedRepositoryPostgres) FindItems(ctx context.Context, filter repos.FeedFilter) ([]*entities.FeedItem, error) {
+	args := []interface{}{}
+	conditions := append([]string{"a.status = 'published'", "a.deleted_at IS NULL"}, scopeConditions(&args, filter)...)
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(`
+		SELECT a.id, a.title, a.slug, a.excerpt, a.body, u.username, c.name,
+			m.storage_key, m.content_type, m.size, a.published_at, a.updated_at
+		FROM articles a
+		JOIN users u ON u.id = a.author_id
+		LEFT JOIN categories c ON c.id = a.category_id
+		LEFT JOIN media m ON m.id = a.featured_image_id
+		WHERE %s
+		ORDER BY a.published_at DESC, a.id
+		LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*entities.FeedItem
+	for rows.Next() {
+		item, err := scanFeedItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadTags(ctx, items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *FeedRepositoryPostgres) LastModified(ctx context.Context, filter repos.FeedFilter) (time.Time, error) {
+	args := []interface{}{}
+	// ✅ Tanpa filter status: artikel yang di-unpublish juga menggeser updated_at
+	conditions := append([]string{"a.published_at IS NOT NULL"}, scopeConditions(&args, filter)...)
+
+	query := "SELECT MAX(a.updated_at) FROM articles a WHERE " + strings.Join(conditions, " AND ")
+
+	var lastModified sql.NullTime
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, args...).Scan(&lastModified); err != nil {
+		return time.Time{}, err
+	}
+
+	return lastModified.Time, nil
+}
+
+func (r *FeedRepositoryPostgres) loadTags(ctx context.Context, items []*entities.FeedItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entities.FeedItem, len(items))
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		byID[item.ArticleID] = item
+		ids = append(ids, item.ArticleID)
+	}
+
+	query := `
+		SELECT at.article_id, t.name
+		FROM article_tags at
+		JOIN tags t ON t.id = at.tag_id
+		WHERE at.article_id = ANY($1)
+		ORDER BY t.name
+	`
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var articleID, name string
+		if err := rows.Scan(&articleID, &name); err != nil {
+			return err
+		}
+		item := byID[articleID]
+		item.Tags = append(item.Tags, name)
+	}
+
+	return rows.Err()
+}
+
+// scopeConditions menerjemahkan cakupan feed menjadi kondisi WHERE pada alias a.
+func scopeConditions(args *[]interface{}, filter repos.FeedFilter) []string {
+	var conditions []string
+
+	if filter.CategoryPath != "" {
+		// ✅ Materialized path: section beserta seluruh sub-section-nya
+		*args = append(*args, filter.CategoryPath)
+		conditions = append(conditions, fmt.Sprintf(
+			"a.category_id IN (SELECT id FROM categories WHERE path LIKE $%d || '%%')",
+			len(*args),
+		))
+	}
+	if filter.TagID != "" {
+		*args = append(*args, filter.TagID)
+		conditions = append(conditions, fmt.Sprintf(
+			"a.id IN (SELECT article_id FROM article_tags WHERE tag_id = $%d)",
+			len(*args),
+		))
+	}
+
+	return conditions
+}
+
+func scanFeedItem(rows *sql.Rows) (*entities.FeedItem, error) {
+	var item entities.FeedItem
+	var section, storageKey, contentType sql.NullString
+	var size sql.NullInt64
+
+	err := rows.Scan(
+		&item.ArticleID,
+		&item.Title,
+		&item.Slug,
+		&item.Excerpt,
+		&item.Body,
+		&item.AuthorName,
+		&section,
+		&storageKey,
+		&contentType,
+		&size,
+		&item.PublishedAt,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	item.Section = section.String
+	if storageKey.Valid {
+		item.Image = &entities.FeedImage{
+			StorageKey:  storageKey.String,
+			ContentType: contentType.String,
+			Size:        size.Int64,
+		}
+	}
+
+	return &item, nil
+}
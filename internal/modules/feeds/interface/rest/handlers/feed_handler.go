@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/application/usecases"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type FeedHandler struct {
+	getFeedUseCase *usecases.GetFeed
+}
+
+func NewFeedHandler(getFeedUseCase *usecases.GetFeed) *FeedHandler {
+	return &FeedHandler{
+		getFeedUseCase: getFeedUseCase,
+	}
+}
+
+func (h *FeedHandler) Latest(w http.ResponseWriter, r *http.Request) {
+	_, format := splitFeedName(path.Base(r.URL.Path))
+	h.serve(w, r, &dto.GetFeedInput{Format: format})
+}
+
+func (h *FeedHandler) Section(w http.ResponseWriter, r *http.Request) {
+	sectionPath, format := splitFeedName(r.PathValue("path"))
+	if strings.Trim(sectionPath, "/") == "" {
+		writeFeedNotFound(w)
+		return
+	}
+	h.serve(w, r, &dto.GetFeedInput{Format: format, SectionPath: sectionPath})
+}
+
+func (h *FeedHandler) Tag(w http.ResponseWriter, r *http.Request) {
+	tag, format := splitFeedName(r.PathValue("file"))
+	if tag == "" {
+		writeFeedNotFound(w)
+		return
+	}
+	h.serve(w, r, &dto.GetFeedInput{Format: format, Tag: tag})
+}
+
+func (h *FeedHandler) serve(w http.ResponseWriter, r *http.Request, input *dto.GetFeedInput) {
+	feed, err := h.getFeedUseCase.Execute(r.Context(), input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	// ✅ Slug tag lama atau slug section tanpa path diarahkan ke URL kanonis
+	if feed.Path != r.URL.Path {
+		http.Redirect(w, r, feed.Path, http.StatusMovedPermanently)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if shared.CheckNotModified(w, r, feed.ETag, feed.LastModified) {
+		return
+	}
+
+	var body []byte
+	if feed.Format == vo.FeedFormatAtom {
+		body, err = EncodeAtom(feed)
+	} else {
+		body, err = EncodeRSS(feed)
+	}
+	if err != nil {
+		shared.WriteErrorResponse(w, "INTERNAL_ERROR", "Failed to render feed", http.StatusInternalServerError)
+		return
+	}
+
+	writeXML(w, r, feed.ContentType, body)
+}
+
+func writeFeedNotFound(w http.ResponseWriter) {
+	shared.WriteErrorResponse(w, "NOT_FOUND", "Feed not found", http.StatusNotFound)
+}
+
+// splitFeedName memisahkan "politik.atom" menjadi "politik" dan "atom".
+func splitFeedName(name string) (string, string) {
+	ext := path.Ext(name)
+	return strings.TrimSuffix(name, ext), strings.TrimPrefix(ext, ".")
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/application/dto"
+)
+
+// --- RSS 2.0 ---
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	DCNS    string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	AtomLink      atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Description string        `xml:"description"`
+	Creator     string        `xml:"dc:creator,omitempty"`
+	Categories  []string      `xml:"category"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Enclosure   *rssEnclosure `xml:"enclosure"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// EncodeRSS merender feed sebagai RSS 2.0.
+func EncodeRSS(feed *dto.FeedOutput) ([]byte, error) {
+	doc := rssDocument{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		DCNS:    "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       feed.Title,
+			Link:        feed.Link,
+			Description: feed.Description,
+			AtomLink:    atomLink{Rel: "self", Href: feed.SelfLink, Type: "application/rss+xml"},
+			Items:       make([]rssItem, 0, len(feed.Items)),
+		},
+	}
+	if !feed.LastModified.IsZero() {
+		doc.Channel.LastBuildDate = feed.LastModified.Format(time.RFC1123Z)
+	}
+
+	for _, item := range feed.Items {
+		rss := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: item.Summary,
+			Creator:     item.Author,
+			Categories:  item.Categories,
+			GUID:        rssGUID{IsPermaLink: "false", Value: item.GUID},
+			PubDate:     item.PublishedAt.Format(time.RFC1123Z),
+		}
+		if item.Enclosure != nil {
+			rss.Enclosure = &rssEnclosure{URL: item.Enclosure.URL, Length: item.Enclosure.Length, Type: item.Enclosure.Type}
+		}
+		doc.Channel.Items = append(doc.Channel.Items, rss)
+	}
+
+	return marshalXML(doc)
+}
+
+// --- Atom 1.0 ---
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Links      []atomLink     `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     atomAuthor     `xml:"author"`
+	Summary    string         `xml:"summary,omitempty"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomLink struct {
+	Rel    string `xml:"rel,attr,omitempty"`
+	Href   string `xml:"href,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length int64  `xml:"length,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// EncodeAtom merender feed sebagai Atom 1.0.
+func EncodeAtom(feed *dto.FeedOutput) ([]byte, error) {
+	doc := atomFeed{
+		ID:       feed.ID,
+		Title:    feed.Title,
+		Subtitle: feed.Description,
+		// Atom mewajibkan <updated>; feed tanpa artikel memakai epoch
+		Updated: feed.LastModified.Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Href: feed.SelfLink, Type: "application/atom+xml"},
+			{Rel: "alternate", Href: feed.Link, Type: "text/html"},
+		},
+		Entries: make([]atomEntry, 0, len(feed.Items)),
+	}
+	if feed.LastModified.IsZero() {
+		doc.Updated = time.Unix(0, 0).UTC().Format(time.RFC3339)
+	}
+
+	for _, item := range feed.Items {
+		author := item.Author
+		if author == "" {
+			author = feed.Title
+		}
+
+		entry := atomEntry{
+			ID:        item.GUID,
+			Title:     item.Title,
+			Links:     []atomLink{{Rel: "alternate", Href: item.Link, Type: "text/html"}},
+			Published: item.PublishedAt.Format(time.RFC3339),
+			Updated:   item.UpdatedAt.Format(time.RFC3339),
+			Author:    atomAuthor{Name: author},
+			Summary:   item.Summary,
+		}
+		for _, category := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+		if item.Enclosure != nil {
+			entry.Links = append(entry.Links, atomLink{
+				Rel:    "enclosure",
+				Href:   item.Enclosure.URL,
+				Type:   item.Enclosure.Type,
+				Length: item.Enclosure.Length,
+			})
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	return marshalXML(doc)
+}
+
+func marshalXML(doc interface{}) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// writeXML menulis dokumen feed; HEAD hanya mendapat header.
+func writeXML(w http.ResponseWriter, r *http.Request, contentType string, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		w.Write(body)
+	}
+}
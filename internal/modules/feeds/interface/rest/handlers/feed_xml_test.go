@@ -0,0 +1,122 @@
+package handlers_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/application/dto"
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/interface/rest/handlers"
+)
+
+func sampleFeed() *dto.FeedOutput {
+	published := time.Date(2029, 2, 14, 8, 0, 0, 0, time.UTC)
+	return &dto.FeedOutput{
+		ID:           "https://kabar.example/feeds/latest.atom",
+		Title:        "Kabar & Berita",
+		Description:  "Berita terbaru",
+		Link:         "https://kabar.example/",
+		SelfLink:     "https://kabar.example/feeds/latest.atom",
+		LastModified: published.Add(time.Hour),
+		Items: []dto.FeedItemOutput{{
+			GUID:        "urn:uuid:article-uuid",
+			Title:       "Pemilu <2029>",
+			Link:        "https://kabar.example/articles/pemilu-2029",
+			Summary:     "Ringkasan",
+			Author:      "budi",
+			Categories:  []string{"Politik", "Pemilu"},
+			Enclosure:   &dto.FeedEnclosureOutput{URL: "https://kabar.example/media/photo.jpg", Type: "image/jpeg", Length: 2048},
+			PublishedAt: published,
+			UpdatedAt:   published.Add(time.Hour),
+		}},
+	}
+}
+
+func TestEncodeRSS(t *testing.T) {
+	body, err := handlers.EncodeRSS(sampleFeed())
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	var doc struct {
+		Channel struct {
+			Title string `xml:"title"`
+			Items []struct {
+				Title string `xml:"title"`
+				GUID  struct {
+					IsPermaLink string `xml:"isPermaLink,attr"`
+					Value       string `xml:",chardata"`
+				} `xml:"guid"`
+				PubDate   string `xml:"pubDate"`
+				Enclosure struct {
+					URL    string `xml:"url,attr"`
+					Length string `xml:"length,attr"`
+					Type   string `xml:"type,attr"`
+				} `xml:"enclosure"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("Expected valid XML, but got %v", err)
+	}
+
+	if !strings.HasPrefix(string(body), "<?xml") {
+		t.Error("Expected an XML declaration")
+	}
+	if doc.Channel.Title != "Kabar & Berita" || len(doc.Channel.Items) != 1 {
+		t.Fatalf("Unexpected channel: %+v", doc.Channel)
+	}
+
+	item := doc.Channel.Items[0]
+	if item.Title != "Pemilu <2029>" {
+		t.Errorf("Expected escaped title to round-trip, but got '%s'", item.Title)
+	}
+	if item.GUID.Value != "urn:uuid:article-uuid" || item.GUID.IsPermaLink != "false" {
+		t.Errorf("Expected a non-permalink guid, but got %+v", item.GUID)
+	}
+	if item.PubDate != "Wed, 14 Feb 2029 08:00:00 +0000" {
+		t.Errorf("Expected RFC 822 pubDate, but got '%s'", item.PubDate)
+	}
+	if item.Enclosure.URL != "https://kabar.example/media/photo.jpg" || item.Enclosure.Length != "2048" || item.Enclosure.Type != "image/jpeg" {
+		t.Errorf("Unexpected enclosure: %+v", item.Enclosure)
+	}
+}
+
+func TestEncodeAtom(t *testing.T) {
+	body, err := handlers.EncodeAtom(sampleFeed())
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			ID     string `xml:"id"`
+			Author string `xml:"author>name"`
+			Links  []struct {
+				Rel  string `xml:"rel,attr"`
+				Href string `xml:"href,attr"`
+			} `xml:"link"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("Expected valid Atom XML, but got %v", err)
+	}
+
+	if doc.Updated != "2029-02-14T09:00:00Z" {
+		t.Errorf("Expected feed updated from LastModified, but got '%s'", doc.Updated)
+	}
+	if len(doc.Entries) != 1 {
+		t.Fatalf("Expected 1 entry, but got %d", len(doc.Entries))
+	}
+
+	entry := doc.Entries[0]
+	if entry.ID != "urn:uuid:article-uuid" || entry.Author != "budi" {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+	if len(entry.Links) != 2 || entry.Links[1].Rel != "enclosure" {
+		t.Errorf("Expected alternate and enclosure links, but got %+v", entry.Links)
+	}
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"net/http"
+
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/feeds/interface/rest/handlers"
+)
+
+func SetupFeedRoutes(mux *http.ServeMux, feedHandler *handlers.FeedHandler) {
+	// Public endpoints (RSS 2.0 dan Atom, format dari ekstensi)
+	mux.HandleFunc("GET /feeds/latest.rss", feedHandler.Latest)
+	mux.HandleFunc("GET /feeds/latest.atom", feedHandler.Latest)
+	// Section bersarang memakai path kategori, mis. /feeds/section/berita/politik.atom
+	mux.HandleFunc("GET /feeds/section/{path...}", feedHandler.Section)
+	mux.HandleFunc("GET /feeds/tag/{file}", feedHandler.Tag)
+}
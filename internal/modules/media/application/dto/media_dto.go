@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"io"
+	"time"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// UploadMediaInput berisi satu file dari form multipart. Tipe file ditentukan
+// dari isinya, bukan dari nama file atau header Content-Type.
+type UploadMediaInput struct {
+	File     io.Reader
+	FileName string
+
+	Alt     string
+	Caption string
+	Credit  string
+	// FocalX/FocalY nil berarti focal point di tengah gambar.
+	FocalX *float64
+	FocalY *float64
+
+	Actor *shared.Principal
+}
+
+// UpdateMediaInput memakai pointer: field nil berarti tidak diubah.
+// Mengubah focal point membuat ulang seluruh varian gambar.
+type UpdateMediaInput struct {
+	ID      string   `json:"-"`
+	Alt     *string  `json:"alt,omitempty"`
+	Caption *string  `json:"caption,omitempty"`
+	Credit  *string  `json:"credit,omitempty"`
+	FocalX  *float64 `json:"focal_x,omitempty"`
+	FocalY  *float64 `json:"focal_y,omitempty"`
+
+	Actor *shared.Principal `json:"-"`
+}
+
+type GetMediaInput struct {
+	ID string
+}
+
+type ListMediaInput struct {
+	Page  int
+	Limit int
+}
+
+type DeleteMediaInput struct {
+	ID    string
+	Actor *shared.Principal
+}
+
+type FocalPointOutput struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type MediaVariantOutput struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type MediaOutput struct {
+	ID           string                        `json:"id"`
+	URL          string                        `json:"url"`
+	OriginalName string                        `json:"original_name"`
+	ContentType  string                        `json:"content_type"`
+	Size         int64                         `json:"size"`
+	Width        int                           `json:"width,omitempty"`
+	Height       int                           `json:"height,omitempty"`
+	Alt          string                        `json:"alt"`
+	Caption      string                        `json:"caption"`
+	Credit       string                        `json:"credit"`
+	FocalPoint   FocalPointOutput              `json:"focal_point"`
+	Variants     map[string]MediaVariantOutput `json:"variants,omitempty"`
+	UploadedBy   string                        `json:"uploaded_by"`
+	CreatedAt    time.Time                     `json:"created_at"`
+	UpdatedAt    time.Time                     `json:"updated_at"`
+}
+
+type MediaListOutput struct {
+	Items []*MediaOutput `json:"items"`
+	Page  int            `json:"page"`
+	Limit int            `json:"limit"`
+	Total int            `json:"total"`
+}
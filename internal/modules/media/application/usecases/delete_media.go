@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// DeleteMedia adalah use case untuk menghapus media beserta file-filenya.
+type DeleteMedia struct {
+	mediaRepository repos.MediaRepository
+	blobStore       vo.BlobStore
+}
+
+// NewDeleteMedia adalah konstruktor untuk use case ini.
+func NewDeleteMedia(mediaRepo repos.MediaRepository, blobStore vo.BlobStore) *DeleteMedia {
+	return &DeleteMedia{
+		mediaRepository: mediaRepo,
+		blobStore:       blobStore,
+	}
+}
+
+// Execute menolak menghapus media yang masih dipakai sebagai featured image.
+func (d *DeleteMedia) Execute(ctx context.Context, input *dto.DeleteMediaInput) error {
+	if input.Actor == nil {
+		return shared.NewUnauthorizedError("Authentication required")
+	}
+
+	// 1. Mencari media
+	media, err := d.mediaRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if media == nil {
+		return shared.NewNotFoundError("Media not found")
+	}
+	if !canManage(media, input.Actor) {
+		return shared.NewForbiddenError("You are not allowed to delete this media")
+	}
+
+	// 2. Pastikan tidak dipakai artikel
+	inUse, err := d.mediaRepository.IsInUse(ctx, media.ID)
+	if err != nil {
+		return shared.NewDatabaseError(err)
+	}
+	if inUse {
+		return shared.NewConflictError("Media is used as a featured image")
+	}
+
+	// 3. Hapus metadata, lalu file-filenya
+	if err := d.mediaRepository.Delete(ctx, media.ID); err != nil {
+		return shared.NewDatabaseError(err)
+	}
+
+	_ = d.blobStore.Delete(ctx, media.StorageKey)
+	deleteVariantBlobs(ctx, d.blobStore, media.Variants)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// GetMedia adalah use case untuk menampilkan satu media beserta URL variannya.
+type GetMedia struct {
+	mediaRepository repos.MediaRepository
+	blobStore       vo.BlobStore
+}
+
+// NewGetMedia adalah konstruktor untuk use case ini.
+func NewGetMedia(mediaRepo repos.MediaRepository, blobStore vo.BlobStore) *GetMedia {
+	return &GetMedia{
+		mediaRepository: mediaRepo,
+		blobStore:       blobStore,
+	}
+}
+
+func (g *GetMedia) Execute(ctx context.Context, input *dto.GetMediaInput) (*dto.MediaOutput, error) {
+	media, err := g.mediaRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if media == nil {
+		return nil, shared.NewNotFoundError("Media not found")
+	}
+
+	return toMediaOutput(media, g.blobStore), nil
+}
+
+// ListMedia adalah use case untuk menampilkan media library dengan paginasi.
+type ListMedia struct {
+	mediaRepository repos.MediaRepository
+	blobStore       vo.BlobStore
+}
+
+// NewListMedia adalah konstruktor untuk use case ini.
+func NewListMedia(mediaRepo repos.MediaRepository, blobStore vo.BlobStore) *ListMedia {
+	return &ListMedia{
+		mediaRepository: mediaRepo,
+		blobStore:       blobStore,
+	}
+}
+
+func (l *ListMedia) Execute(ctx context.Context, input *dto.ListMediaInput) (*dto.MediaListOutput, error) {
+	page, limit := input.Page, input.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultPageLimit
+	}
+	limit = min(limit, maxPageLimit)
+
+	medias, total, err := l.mediaRepository.FindAll(ctx, limit, (page-1)*limit)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output := &dto.MediaListOutput{
+		Items: make([]*dto.MediaOutput, 0, len(medias)),
+		Page:  page,
+		Limit: limit,
+		Total: total,
+	}
+	for _, media := range medias {
+		output.Items = append(output.Items, toMediaOutput(media, l.blobStore))
+	}
+
+	return output, nil
+}
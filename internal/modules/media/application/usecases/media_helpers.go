@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func toMediaOutput(media *entities.Media, blobStore vo.BlobStore) *dto.MediaOutput {
+	output := &dto.MediaOutput{
+		ID:           media.ID,
+		URL:          blobStore.URL(media.StorageKey),
+		OriginalName: media.OriginalName,
+		ContentType:  media.ContentType.String(),
+		Size:         media.Size,
+		Width:        media.Width,
+		Height:       media.Height,
+		Alt:          media.Alt,
+		Caption:      media.Caption,
+		Credit:       media.Credit,
+		FocalPoint:   dto.FocalPointOutput{X: media.FocalPoint.X(), Y: media.FocalPoint.Y()},
+		UploadedBy:   media.UploadedBy,
+		CreatedAt:    media.CreatedAt,
+		UpdatedAt:    media.UpdatedAt,
+	}
+
+	if len(media.Variants) > 0 {
+		output.Variants = make(map[string]dto.MediaVariantOutput, len(media.Variants))
+		for _, variant := range media.Variants {
+			output.Variants[variant.Name] = dto.MediaVariantOutput{
+				URL:    blobStore.URL(variant.StorageKey),
+				Width:  variant.Width,
+				Height: variant.Height,
+			}
+		}
+	}
+
+	return output
+}
+
+// generateVariants membuat dan menyimpan seluruh vo.ImageVariants dari data
+// gambar asli. Jika salah satu gagal, varian yang sudah tersimpan dihapus lagi.
+func generateVariants(ctx context.Context, media *entities.Media, data []byte, processor vo.ImageProcessor, blobStore vo.BlobStore) ([]entities.MediaVariant, error) {
+	variants := make([]entities.MediaVariant, 0, len(vo.ImageVariants))
+
+	for _, spec := range vo.ImageVariants {
+		output, width, height, err := processor.Variant(data, spec, media.FocalPoint)
+		if err != nil {
+			deleteVariantBlobs(ctx, blobStore, variants)
+			return nil, fmt.Errorf("failed to create %s variant: %w", spec.Name, err)
+		}
+
+		contentType, err := vo.NewContentType(http.DetectContentType(output))
+		if err != nil {
+			deleteVariantBlobs(ctx, blobStore, variants)
+			return nil, fmt.Errorf("failed to create %s variant: %w", spec.Name, err)
+		}
+
+		variant := entities.MediaVariant{
+			Name:       spec.Name,
+			StorageKey: media.VariantKey(spec.Name, contentType.Extension()),
+			Width:      width,
+			Height:     height,
+			Size:       int64(len(output)),
+		}
+		if err := blobStore.Put(ctx, variant.StorageKey, bytes.NewReader(output), contentType.String()); err != nil {
+			deleteVariantBlobs(ctx, blobStore, variants)
+			return nil, fmt.Errorf("failed to store %s variant: %w", spec.Name, err)
+		}
+
+		variants = append(variants, variant)
+	}
+
+	return variants, nil
+}
+
+// deleteVariantBlobs bersifat best effort: blob yatim hanya memakan ruang
+// dan tidak pernah dirujuk lagi.
+func deleteVariantBlobs(ctx context.Context, blobStore vo.BlobStore, variants []entities.MediaVariant) {
+	for _, variant := range variants {
+		_ = blobStore.Delete(ctx, variant.StorageKey)
+	}
+}
+
+// staleVariants mengembalikan varian lama yang key-nya tidak dipakai lagi oleh media.
+func staleVariants(old []entities.MediaVariant, media *entities.Media) []entities.MediaVariant {
+	var stale []entities.MediaVariant
+	for _, variant := range old {
+		if current := media.Variant(variant.Name); current == nil || current.StorageKey != variant.StorageKey {
+			stale = append(stale, variant)
+		}
+	}
+	return stale
+}
+
+// canManage: uploader boleh mengubah dan menghapus medianya sendiri,
+// editor boleh mengelola seluruh media library.
+func canManage(media *entities.Media, actor *shared.Principal) bool {
+	return media.UploadedBy == actor.UserID || actor.HasPermission(string(authvo.PermissionArticleUpdateAny))
+}
+
+// focalPointFrom mengisi koordinat yang kosong dari focal point saat ini.
+func focalPointFrom(current vo.FocalPoint, x, y *float64) (*vo.FocalPoint, error) {
+	focalX, focalY := current.X(), current.Y()
+	if x != nil {
+		focalX = *x
+	}
+	if y != nil {
+		focalY = *y
+	}
+	return vo.NewFocalPoint(focalX, focalY)
+}
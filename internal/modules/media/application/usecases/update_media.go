@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// UpdateMedia adalah use case untuk mengubah metadata media.
+type UpdateMedia struct {
+	mediaRepository repos.MediaRepository
+	blobStore       vo.BlobStore
+	imageProcessor  vo.ImageProcessor
+}
+
+// NewUpdateMedia adalah konstruktor untuk use case ini.
+func NewUpdateMedia(mediaRepo repos.MediaRepository, blobStore vo.BlobStore, imageProcessor vo.ImageProcessor) *UpdateMedia {
+	return &UpdateMedia{
+		mediaRepository: mediaRepo,
+		blobStore:       blobStore,
+		imageProcessor:  imageProcessor,
+	}
+}
+
+// Execute mengubah alt, caption, credit dan focal point. Jika focal point
+// berubah, seluruh varian dibuat ulang dari file asli.
+func (u *UpdateMedia) Execute(ctx context.Context, input *dto.UpdateMediaInput) (*dto.MediaOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+
+	// 1. Mencari media
+	media, err := u.mediaRepository.FindByID(ctx, input.ID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if media == nil {
+		return nil, shared.NewNotFoundError("Media not found")
+	}
+	if !canManage(media, input.Actor) {
+		return nil, shared.NewForbiddenError("You are not allowed to update this media")
+	}
+
+	// 2. Menerapkan perubahan
+	alt, caption, credit := media.Alt, media.Caption, media.Credit
+	if input.Alt != nil {
+		alt = *input.Alt
+	}
+	if input.Caption != nil {
+		caption = *input.Caption
+	}
+	if input.Credit != nil {
+		credit = *input.Credit
+	}
+
+	focalPoint, err := focalPointFrom(media.FocalPoint, input.FocalX, input.FocalY)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	focalChanged, err := media.UpdateMetadata(alt, caption, credit, *focalPoint)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 3. Membuat ulang varian jika focal point berubah
+	oldVariants := media.Variants
+	if focalChanged && media.IsImage() {
+		data, err := u.readOriginal(ctx, media.StorageKey)
+		if err != nil {
+			return nil, err
+		}
+
+		media.Variants, err = generateVariants(ctx, media, data, u.imageProcessor, u.blobStore)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. Menyimpan perubahan
+	updatedMedia, err := u.mediaRepository.Update(ctx, media)
+	if err != nil {
+		if focalChanged {
+			deleteVariantBlobs(ctx, u.blobStore, media.Variants)
+		}
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	// 5. Varian lama baru dihapus setelah metadata baru tersimpan
+	if focalChanged && media.IsImage() {
+		deleteVariantBlobs(ctx, u.blobStore, staleVariants(oldVariants, media))
+	}
+
+	return toMediaOutput(updatedMedia, u.blobStore), nil
+}
+
+func (u *UpdateMedia) readOriginal(ctx context.Context, key string) ([]byte, error) {
+	file, err := u.blobStore.Open(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open original file: %w", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read original file: %w", err)
+	}
+	return data, nil
+}
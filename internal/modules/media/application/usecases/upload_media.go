@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// MaxImagePixels membatasi resolusi gambar supaya file kecil yang
+// mengembang sangat besar saat di-decode (decompression bomb) ditolak.
+const MaxImagePixels = 50_000_000
+
+// UploadMedia adalah use case untuk mengunggah file ke media library.
+type UploadMedia struct {
+	mediaRepository repos.MediaRepository
+	blobStore       vo.BlobStore
+	imageProcessor  vo.ImageProcessor
+	uuidGenerator   shared.UUIDGenerator
+	maxSize         int64
+}
+
+// NewUploadMedia adalah konstruktor untuk use case ini.
+func NewUploadMedia(
+	mediaRepo repos.MediaRepository,
+	blobStore vo.BlobStore,
+	imageProcessor vo.ImageProcessor,
+	uuidGen shared.UUIDGenerator,
+	maxSize int64) *UploadMedia {
+	return &UploadMedia{
+		mediaRepository: mediaRepo,
+		blobStore:       blobStore,
+		imageProcessor:  imageProcessor,
+		uuidGenerator:   uuidGen,
+		maxSize:         maxSize,
+	}
+}
+
+// MaxSize adalah ukuran file maksimum dalam byte, dipakai handler untuk
+// membatasi body request.
+func (u *UploadMedia) MaxSize() int64 {
+	return u.maxSize
+}
+
+// Execute menyimpan file asli beserta varian ukurannya (untuk gambar) ke
+// BlobStore, lalu metadatanya ke repository.
+func (u *UploadMedia) Execute(ctx context.Context, input *dto.UploadMediaInput) (*dto.MediaOutput, error) {
+	if input.Actor == nil {
+		return nil, shared.NewUnauthorizedError("Authentication required")
+	}
+
+	// 1. Membaca file dengan batas ukuran
+	data, err := io.ReadAll(io.LimitReader(input.File, u.maxSize+1))
+	if err != nil {
+		return nil, shared.NewValidationError("Failed to read uploaded file")
+	}
+	if len(data) == 0 {
+		return nil, shared.NewValidationError("File is empty")
+	}
+	if int64(len(data)) > u.maxSize {
+		return nil, shared.NewValidationError(fmt.Sprintf("File must be at most %d MB", u.maxSize>>20))
+	}
+
+	// 2. Validasi tipe file dari isinya
+	contentType, err := vo.NewContentType(http.DetectContentType(data))
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	focalPoint, err := focalPointFrom(vo.CenterFocalPoint(), input.FocalX, input.FocalY)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 3. Membuat entity
+	media, err := entities.NewMedia(u.uuidGenerator.NewUUID(), input.FileName, *contentType, int64(len(data)), input.Actor.UserID)
+	if err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+	if _, err := media.UpdateMetadata(input.Alt, input.Caption, input.Credit, *focalPoint); err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 4. Membaca ukuran dan membuat varian gambar
+	if media.IsImage() {
+		width, height, err := u.imageProcessor.Dimensions(data)
+		if err != nil {
+			return nil, shared.NewValidationError("File is not a valid image")
+		}
+		if width*height > MaxImagePixels {
+			return nil, shared.NewValidationError("Image resolution is too large")
+		}
+		media.Width, media.Height = width, height
+
+		media.Variants, err = generateVariants(ctx, media, data, u.imageProcessor, u.blobStore)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. Menyimpan file asli
+	if err := u.blobStore.Put(ctx, media.StorageKey, bytes.NewReader(data), contentType.String()); err != nil {
+		deleteVariantBlobs(ctx, u.blobStore, media.Variants)
+		return nil, fmt.Errorf("failed to store file: %w", err)
+	}
+
+	// 6. Menyimpan metadata; blob dibersihkan lagi jika gagal
+	savedMedia, err := u.mediaRepository.Save(ctx, media)
+	if err != nil {
+		_ = u.blobStore.Delete(ctx, media.StorageKey)
+		deleteVariantBlobs(ctx, u.blobStore, media.Variants)
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	return toMediaOutput(savedMedia, u.blobStore), nil
+}
@@ -0,0 +1,364 @@
+package usecases_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// --- Mock Implementations ---
+
+type MockMediaRepository struct {
+	mock.Mock
+}
+
+func (m *MockMediaRepository) Save(ctx context.Context, media *entities.Media) (*entities.Media, error) {
+	args := m.Called(ctx, media)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if fn, ok := args.Get(0).(func(*entities.Media) *entities.Media); ok {
+		return fn(media), args.Error(1)
+	}
+	return args.Get(0).(*entities.Media), args.Error(1)
+}
+
+func (m *MockMediaRepository) Update(ctx context.Context, media *entities.Media) (*entities.Media, error) {
+	args := m.Called(ctx, media)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if fn, ok := args.Get(0).(func(*entities.Media) *entities.Media); ok {
+		return fn(media), args.Error(1)
+	}
+	return args.Get(0).(*entities.Media), args.Error(1)
+}
+
+func (m *MockMediaRepository) FindByID(ctx context.Context, id string) (*entities.Media, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Media), args.Error(1)
+}
+
+func (m *MockMediaRepository) FindAll(ctx context.Context, limit, offset int) ([]*entities.Media, int, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*entities.Media), args.Int(1), args.Error(2)
+}
+
+func (m *MockMediaRepository) IsInUse(ctx context.Context, id string) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockMediaRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockBlobStore struct {
+	mock.Mock
+}
+
+func (m *MockBlobStore) Put(ctx context.Context, key string, content io.Reader, contentType string) error {
+	args := m.Called(ctx, key, contentType)
+	return args.Error(0)
+}
+
+func (m *MockBlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockBlobStore) URL(key string) string {
+	return "/media/" + key
+}
+
+type MockImageProcessor struct {
+	mock.Mock
+}
+
+func (m *MockImageProcessor) Dimensions(data []byte) (int, int, error) {
+	args := m.Called(data)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+func (m *MockImageProcessor) Variant(data []byte, spec vo.VariantSpec, focal vo.FocalPoint) ([]byte, int, int, error) {
+	args := m.Called(data, spec, focal)
+	if args.Get(0) == nil {
+		return nil, 0, 0, args.Error(1)
+	}
+	return args.Get(0).([]byte), spec.Width, spec.Height, args.Error(1)
+}
+
+type MockUUIDGenerator struct {
+	mock.Mock
+}
+
+func (m *MockUUIDGenerator) NewUUID() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+// --- Helpers ---
+
+// pngBytes cukup untuk dikenali http.DetectContentType sebagai image/png.
+var pngBytes = []byte("\x89PNG\r\n\x1a\n-image-data")
+
+const maxUploadSize = 1 << 20
+
+func newPrincipal(userID string, roles ...string) *shared.Principal {
+	return &shared.Principal{
+		UserID:      userID,
+		Roles:       roles,
+		Permissions: authvo.PermissionsForRoles(roles),
+	}
+}
+
+func newStoredImage(t *testing.T, id, uploadedBy string) *entities.Media {
+	t.Helper()
+	contentType, err := vo.NewContentType("image/png")
+	if err != nil {
+		t.Fatalf("Error creating content type: %v", err)
+	}
+	media, err := entities.NewMedia(id, "banjir.png", *contentType, int64(len(pngBytes)), uploadedBy)
+	if err != nil {
+		t.Fatalf("Error creating media: %v", err)
+	}
+	media.Width, media.Height = 2000, 1500
+	media.UpdatedAt = media.UpdatedAt.Add(-time.Hour)
+	for _, spec := range vo.ImageVariants {
+		media.Variants = append(media.Variants, entities.MediaVariant{
+			Name:       spec.Name,
+			StorageKey: media.VariantKey(spec.Name, ".png"),
+			Width:      spec.Width,
+			Height:     spec.Height,
+		})
+	}
+	return media
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+// --- Test Suite ---
+
+func TestUploadMedia(t *testing.T) {
+	t.Run("should store the original, all variants and the metadata", func(t *testing.T) {
+		mediaRepoMock := new(MockMediaRepository)
+		blobStoreMock := new(MockBlobStore)
+		processorMock := new(MockImageProcessor)
+		uuidGenMock := new(MockUUIDGenerator)
+		uploadMediaUsecase := usecases.NewUploadMedia(mediaRepoMock, blobStoreMock, processorMock, uuidGenMock, maxUploadSize)
+
+		uuidGenMock.On("NewUUID").Return("media-uuid").Once()
+		processorMock.On("Dimensions", pngBytes).Return(2000, 1500, nil).Once()
+		processorMock.On("Variant", pngBytes, mock.Anything, mock.MatchedBy(func(f vo.FocalPoint) bool {
+			return f.X() == 0.3 && f.Y() == 0.5
+		})).Return(pngBytes, nil).Times(3)
+		blobStoreMock.On("Put", mock.Anything, mock.Anything, "image/png").Return(nil).Times(4)
+		mediaRepoMock.On("Save", mock.Anything, mock.AnythingOfType("*entities.Media")).
+			Return(func(m *entities.Media) *entities.Media { return m }, nil).Once()
+
+		output, err := uploadMediaUsecase.Execute(context.Background(), &dto.UploadMediaInput{
+			File:     bytes.NewReader(pngBytes),
+			FileName: `C:\Users\redaksi\banjir.png`,
+			Alt:      "  Banjir di Kampung Melayu ",
+			Credit:   "Foto: Redaksi",
+			FocalX:   floatPtr(0.3),
+			Actor:    newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "media-uuid", output.ID)
+		assert.Equal(t, "banjir.png", output.OriginalName)
+		assert.Equal(t, "image/png", output.ContentType)
+		assert.Equal(t, "Banjir di Kampung Melayu", output.Alt)
+		assert.Equal(t, 2000, output.Width)
+		assert.Len(t, output.Variants, 3)
+		assert.Equal(t, 640, output.Variants[vo.VariantCard].Width)
+		assert.True(t, strings.HasSuffix(output.URL, "/media-uuid/original.png"))
+		mediaRepoMock.AssertExpectations(t)
+		blobStoreMock.AssertExpectations(t)
+		processorMock.AssertExpectations(t)
+	})
+
+	t.Run("should reject files that are not in the allowlist", func(t *testing.T) {
+		mediaRepoMock := new(MockMediaRepository)
+		blobStoreMock := new(MockBlobStore)
+		uploadMediaUsecase := usecases.NewUploadMedia(mediaRepoMock, blobStoreMock, new(MockImageProcessor), new(MockUUIDGenerator), maxUploadSize)
+
+		_, err := uploadMediaUsecase.Execute(context.Background(), &dto.UploadMediaInput{
+			File:     strings.NewReader("<svg onload=alert(1)></svg>"),
+			FileName: "logo.png",
+			Actor:    newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		blobStoreMock.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject files larger than the upload limit", func(t *testing.T) {
+		uploadMediaUsecase := usecases.NewUploadMedia(new(MockMediaRepository), new(MockBlobStore), new(MockImageProcessor), new(MockUUIDGenerator), 8)
+
+		_, err := uploadMediaUsecase.Execute(context.Background(), &dto.UploadMediaInput{
+			File:  bytes.NewReader(pngBytes),
+			Actor: newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+	})
+
+	t.Run("should delete the stored blobs when saving the metadata fails", func(t *testing.T) {
+		mediaRepoMock := new(MockMediaRepository)
+		blobStoreMock := new(MockBlobStore)
+		processorMock := new(MockImageProcessor)
+		uuidGenMock := new(MockUUIDGenerator)
+		uploadMediaUsecase := usecases.NewUploadMedia(mediaRepoMock, blobStoreMock, processorMock, uuidGenMock, maxUploadSize)
+
+		uuidGenMock.On("NewUUID").Return("media-uuid").Once()
+		processorMock.On("Dimensions", pngBytes).Return(800, 600, nil).Once()
+		processorMock.On("Variant", pngBytes, mock.Anything, mock.Anything).Return(pngBytes, nil).Times(3)
+		blobStoreMock.On("Put", mock.Anything, mock.Anything, "image/png").Return(nil).Times(4)
+		blobStoreMock.On("Delete", mock.Anything, mock.Anything).Return(nil).Times(4)
+		mediaRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused")).Once()
+
+		_, err := uploadMediaUsecase.Execute(context.Background(), &dto.UploadMediaInput{
+			File:  bytes.NewReader(pngBytes),
+			Actor: newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Equal(t, "DATABASE_ERROR", shared.GetErrorCode(err))
+		blobStoreMock.AssertExpectations(t)
+	})
+}
+
+func TestUpdateMedia(t *testing.T) {
+	t.Run("should regenerate the variants when the focal point changes", func(t *testing.T) {
+		mediaRepoMock := new(MockMediaRepository)
+		blobStoreMock := new(MockBlobStore)
+		processorMock := new(MockImageProcessor)
+		updateMediaUsecase := usecases.NewUpdateMedia(mediaRepoMock, blobStoreMock, processorMock)
+
+		media := newStoredImage(t, "media-uuid", "author-uuid")
+		oldVariantKey := media.Variant(vo.VariantHero).StorageKey
+		mediaRepoMock.On("FindByID", mock.Anything, "media-uuid").Return(media, nil).Once()
+		blobStoreMock.On("Open", mock.Anything, media.StorageKey).Return(io.NopCloser(bytes.NewReader(pngBytes)), nil).Once()
+		processorMock.On("Variant", pngBytes, mock.Anything, mock.Anything).Return(pngBytes, nil).Times(3)
+		blobStoreMock.On("Put", mock.Anything, mock.Anything, "image/png").Return(nil).Times(3)
+		mediaRepoMock.On("Update", mock.Anything, media).Return(func(m *entities.Media) *entities.Media { return m }, nil).Once()
+		blobStoreMock.On("Delete", mock.Anything, oldVariantKey).Return(nil).Once()
+		blobStoreMock.On("Delete", mock.Anything, mock.Anything).Return(nil).Times(2)
+
+		output, err := updateMediaUsecase.Execute(context.Background(), &dto.UpdateMediaInput{
+			ID:     "media-uuid",
+			FocalY: floatPtr(0.2),
+			Actor:  newPrincipal("author-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, dto.FocalPointOutput{X: 0.5, Y: 0.2}, output.FocalPoint)
+		assert.NotEqual(t, "/media/"+oldVariantKey, output.Variants[vo.VariantHero].URL)
+		mediaRepoMock.AssertExpectations(t)
+		blobStoreMock.AssertExpectations(t)
+	})
+
+	t.Run("should keep the variants when only the caption changes", func(t *testing.T) {
+		mediaRepoMock := new(MockMediaRepository)
+		blobStoreMock := new(MockBlobStore)
+		processorMock := new(MockImageProcessor)
+		updateMediaUsecase := usecases.NewUpdateMedia(mediaRepoMock, blobStoreMock, processorMock)
+
+		media := newStoredImage(t, "media-uuid", "author-uuid")
+		caption := "Warga mengungsi."
+		mediaRepoMock.On("FindByID", mock.Anything, "media-uuid").Return(media, nil).Once()
+		mediaRepoMock.On("Update", mock.Anything, media).Return(media, nil).Once()
+
+		output, err := updateMediaUsecase.Execute(context.Background(), &dto.UpdateMediaInput{
+			ID:      "media-uuid",
+			Caption: &caption,
+			Actor:   newPrincipal("editor-uuid", authvo.RoleEditor),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, caption, output.Caption)
+		processorMock.AssertNotCalled(t, "Variant", mock.Anything, mock.Anything, mock.Anything)
+		blobStoreMock.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should forbid other authors from updating the media", func(t *testing.T) {
+		mediaRepoMock := new(MockMediaRepository)
+		updateMediaUsecase := usecases.NewUpdateMedia(mediaRepoMock, new(MockBlobStore), new(MockImageProcessor))
+
+		media := newStoredImage(t, "media-uuid", "author-uuid")
+		mediaRepoMock.On("FindByID", mock.Anything, "media-uuid").Return(media, nil).Once()
+
+		_, err := updateMediaUsecase.Execute(context.Background(), &dto.UpdateMediaInput{
+			ID:    "media-uuid",
+			Actor: newPrincipal("other-uuid", authvo.RoleAuthor),
+		})
+
+		assert.Equal(t, "FORBIDDEN", shared.GetErrorCode(err))
+	})
+}
+
+func TestDeleteMedia(t *testing.T) {
+	t.Run("should delete the metadata and every blob", func(t *testing.T) {
+		mediaRepoMock := new(MockMediaRepository)
+		blobStoreMock := new(MockBlobStore)
+		deleteMediaUsecase := usecases.NewDeleteMedia(mediaRepoMock, blobStoreMock)
+
+		media := newStoredImage(t, "media-uuid", "author-uuid")
+		mediaRepoMock.On("FindByID", mock.Anything, "media-uuid").Return(media, nil).Once()
+		mediaRepoMock.On("IsInUse", mock.Anything, "media-uuid").Return(false, nil).Once()
+		mediaRepoMock.On("Delete", mock.Anything, "media-uuid").Return(nil).Once()
+		blobStoreMock.On("Delete", mock.Anything, mock.Anything).Return(nil).Times(4)
+
+		err := deleteMediaUsecase.Execute(context.Background(), &dto.DeleteMediaInput{ID: "media-uuid", Actor: newPrincipal("author-uuid", authvo.RoleAuthor)})
+
+		assert.Nil(t, err)
+		mediaRepoMock.AssertExpectations(t)
+		blobStoreMock.AssertExpectations(t)
+	})
+
+	t.Run("should refuse to delete a featured image", func(t *testing.T) {
+		mediaRepoMock := new(MockMediaRepository)
+		deleteMediaUsecase := usecases.NewDeleteMedia(mediaRepoMock, new(MockBlobStore))
+
+		media := newStoredImage(t, "media-uuid", "author-uuid")
+		mediaRepoMock.On("FindByID", mock.Anything, "media-uuid").Return(media, nil).Once()
+		mediaRepoMock.On("IsInUse", mock.Anything, "media-uuid").Return(true, nil).Once()
+
+		err := deleteMediaUsecase.Execute(context.Background(), &dto.DeleteMediaInput{ID: "media-uuid", Actor: newPrincipal("editor-uuid", authvo.RoleEditor)})
+
+		assert.Equal(t, "CONFLICT_ERROR", shared.GetErrorCode(err))
+		mediaRepoMock.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
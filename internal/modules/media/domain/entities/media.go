@@ -0,0 +1,129 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+)
+
+const (
+	MaxAltLength     = 250
+	MaxCaptionLength = 1000
+	MaxCreditLength  = 200
+)
+
+var (
+	ErrMediaUploaderEmpty  = errors.New("uploader cannot be empty")
+	ErrMediaAltTooLong     = errors.New("alt text must be at most 250 characters")
+	ErrMediaCaptionTooLong = errors.New("caption must be at most 1000 characters")
+	ErrMediaCreditTooLong  = errors.New("credit must be at most 200 characters")
+)
+
+// Media adalah satu file di media library. Untuk gambar, Width/Height adalah
+// ukuran file asli dan Variants berisi turunan sesuai vo.ImageVariants.
+type Media struct {
+	ID           string
+	OriginalName string
+	ContentType  vo.ContentType
+	Size         int64
+	StorageKey   string
+	Width        int
+	Height       int
+
+	Alt        string
+	Caption    string
+	Credit     string
+	FocalPoint vo.FocalPoint
+
+	Variants   []MediaVariant
+	UploadedBy string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// MediaVariant adalah satu turunan gambar yang sudah tersimpan di BlobStore.
+type MediaVariant struct {
+	Name       string
+	StorageKey string
+	Width      int
+	Height     int
+	Size       int64
+}
+
+func NewMedia(id, originalName string, contentType vo.ContentType, size int64, uploadedBy string) (*Media, error) {
+	if strings.TrimSpace(uploadedBy) == "" {
+		return nil, ErrMediaUploaderEmpty
+	}
+
+	now := time.Now()
+	return &Media{
+		ID:           id,
+		OriginalName: path.Base(strings.ReplaceAll(strings.TrimSpace(originalName), `\`, "/")),
+		ContentType:  contentType,
+		Size:         size,
+		StorageKey:   storageDir(id, now) + "/original" + contentType.Extension(),
+		FocalPoint:   vo.CenterFocalPoint(),
+		UploadedBy:   uploadedBy,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// UpdateMetadata mengganti alt, caption, credit dan focal point. Mengembalikan
+// true jika focal point berubah sehingga varian harus dibuat ulang.
+func (m *Media) UpdateMetadata(alt, caption, credit string, focal vo.FocalPoint) (bool, error) {
+	alt = strings.TrimSpace(alt)
+	caption = strings.TrimSpace(caption)
+	credit = strings.TrimSpace(credit)
+
+	switch {
+	case utf8.RuneCountInString(alt) > MaxAltLength:
+		return false, ErrMediaAltTooLong
+	case utf8.RuneCountInString(caption) > MaxCaptionLength:
+		return false, ErrMediaCaptionTooLong
+	case utf8.RuneCountInString(credit) > MaxCreditLength:
+		return false, ErrMediaCreditTooLong
+	}
+
+	focalChanged := !m.FocalPoint.Equals(focal)
+
+	m.Alt = alt
+	m.Caption = caption
+	m.Credit = credit
+	m.FocalPoint = focal
+	m.UpdatedAt = time.Now()
+	return focalChanged, nil
+}
+
+// VariantKey adalah key BlobStore untuk varian dengan nama dan ekstensi tertentu,
+// disimpan di folder yang sama dengan file aslinya. Key memuat versi dari
+// UpdatedAt supaya varian yang dibuat ulang tidak tertahan di cache CDN.
+func (m *Media) VariantKey(name, extension string) string {
+	version := strconv.FormatInt(m.UpdatedAt.UnixNano(), 36)
+	return path.Dir(m.StorageKey) + "/" + name + "-" + version + extension
+}
+
+// Variant mengembalikan varian dengan nama tertentu, atau nil.
+func (m *Media) Variant(name string) *MediaVariant {
+	for i := range m.Variants {
+		if m.Variants[i].Name == name {
+			return &m.Variants[i]
+		}
+	}
+	return nil
+}
+
+func (m *Media) IsImage() bool {
+	return m.ContentType.IsImage()
+}
+
+// storageDir mengelompokkan file per bulan upload, mis. "2026/10/<id>".
+func storageDir(id string, at time.Time) string {
+	return fmt.Sprintf("%04d/%02d/%s", at.Year(), int(at.Month()), id)
+}
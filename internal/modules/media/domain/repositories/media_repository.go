@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/entities"
+)
+
+type MediaRepository interface {
+	// Save menyimpan media beserta variannya dalam satu transaksi.
+	Save(ctx context.Context, media *entities.Media) (*entities.Media, error)
+	// Update menyimpan metadata dan mengganti seluruh varian dalam satu transaksi.
+	Update(ctx context.Context, media *entities.Media) (*entities.Media, error)
+	FindByID(ctx context.Context, id string) (*entities.Media, error)
+	// FindAll mengembalikan media terbaru lebih dulu beserta total seluruhnya.
+	FindAll(ctx context.Context, limit, offset int) ([]*entities.Media, int, error)
+	// IsInUse mengecek apakah media dipakai sebagai featured image artikel.
+	IsInUse(ctx context.Context, id string) (bool, error)
+	Delete(ctx context.Context, id string) error
+}
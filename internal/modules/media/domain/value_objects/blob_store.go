@@ -0,0 +1,21 @@
+package valueobjects
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore menyimpan isi file media. Key adalah path relatif dengan
+// pemisah "/", mis. "2026/10/<media-id>/original.jpg".
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader, contentType string) error
+	// Open mengembalikan ErrBlobNotFound jika key tidak ada.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete tidak mengembalikan error jika key sudah tidak ada.
+	Delete(ctx context.Context, key string) error
+	// URL mengembalikan URL publik untuk key.
+	URL(key string) string
+}
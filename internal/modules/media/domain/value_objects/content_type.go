@@ -0,0 +1,50 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+)
+
+// ContentType adalah MIME type file yang boleh diunggah. Nilainya berasal
+// dari isi file (sniffing), bukan dari header yang dikirim klien.
+type ContentType struct {
+	value string
+}
+
+var ErrContentTypeNotAllowed = errors.New("file type is not allowed, use JPEG, PNG, GIF or PDF")
+
+// allowedContentTypes memetakan MIME type yang diizinkan ke ekstensi file-nya.
+var allowedContentTypes = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"application/pdf": ".pdf",
+}
+
+func NewContentType(value string) (*ContentType, error) {
+	// "text/plain; charset=utf-8" → "text/plain"
+	payload, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(value)), ";")
+
+	if _, ok := allowedContentTypes[payload]; !ok {
+		return nil, ErrContentTypeNotAllowed
+	}
+
+	return &ContentType{value: payload}, nil
+}
+
+// IsImage menandai file yang bisa dibuatkan varian ukuran.
+func (c *ContentType) IsImage() bool {
+	return strings.HasPrefix(c.value, "image/")
+}
+
+func (c *ContentType) Extension() string {
+	return allowedContentTypes[c.value]
+}
+
+func (c *ContentType) String() string {
+	return c.value
+}
+
+func (c *ContentType) Value() string {
+	return c.value
+}
@@ -0,0 +1,67 @@
+package valueobjects_test
+
+import (
+	"errors"
+	"testing"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+)
+
+func TestNewContentType(t *testing.T) {
+	validCases := []struct {
+		input     string
+		expected  string
+		extension string
+		isImage   bool
+	}{
+		{"image/jpeg", "image/jpeg", ".jpg", true},
+		{"IMAGE/PNG", "image/png", ".png", true},
+		{"image/gif", "image/gif", ".gif", true},
+		{"application/pdf", "application/pdf", ".pdf", false},
+	}
+
+	for _, tc := range validCases {
+		t.Run("accept "+tc.input, func(t *testing.T) {
+			contentType, err := vo.NewContentType(tc.input)
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if contentType.String() != tc.expected {
+				t.Errorf("Expected content type '%s', but got '%s'", tc.expected, contentType.String())
+			}
+			if contentType.Extension() != tc.extension {
+				t.Errorf("Expected extension '%s', but got '%s'", tc.extension, contentType.Extension())
+			}
+			if contentType.IsImage() != tc.isImage {
+				t.Errorf("Expected IsImage %v, but got %v", tc.isImage, contentType.IsImage())
+			}
+		})
+	}
+
+	invalidCases := []string{"", "text/plain; charset=utf-8", "image/svg+xml", "application/octet-stream"}
+
+	for _, input := range invalidCases {
+		t.Run("reject "+input, func(t *testing.T) {
+			_, err := vo.NewContentType(input)
+			if !errors.Is(err, vo.ErrContentTypeNotAllowed) {
+				t.Errorf("Expected error %v, but got %v", vo.ErrContentTypeNotAllowed, err)
+			}
+		})
+	}
+}
+
+func TestNewFocalPoint(t *testing.T) {
+	focal, err := vo.NewFocalPoint(0, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if focal.X() != 0 || focal.Y() != 1 {
+		t.Errorf("Expected focal point (0, 1), but got (%v, %v)", focal.X(), focal.Y())
+	}
+
+	for _, tc := range [][2]float64{{-0.1, 0.5}, {0.5, 1.01}} {
+		if _, err := vo.NewFocalPoint(tc[0], tc[1]); !errors.Is(err, vo.ErrFocalPointOutOfRange) {
+			t.Errorf("Expected error %v for %v, but got %v", vo.ErrFocalPointOutOfRange, tc, err)
+		}
+	}
+}
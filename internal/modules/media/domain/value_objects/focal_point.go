@@ -0,0 +1,37 @@
+package valueobjects
+
+import "errors"
+
+// FocalPoint adalah titik terpenting gambar (mis. wajah narasumber) dalam
+// koordinat relatif 0..1 dari kiri atas. Crop varian selalu dipusatkan
+// sedekat mungkin ke titik ini.
+type FocalPoint struct {
+	x float64
+	y float64
+}
+
+var ErrFocalPointOutOfRange = errors.New("focal point coordinates must be between 0 and 1")
+
+func NewFocalPoint(x, y float64) (*FocalPoint, error) {
+	if x < 0 || x > 1 || y < 0 || y > 1 {
+		return nil, ErrFocalPointOutOfRange
+	}
+	return &FocalPoint{x: x, y: y}, nil
+}
+
+// CenterFocalPoint adalah focal point default: tengah gambar.
+func CenterFocalPoint() FocalPoint {
+	return FocalPoint{x: 0.5, y: 0.5}
+}
+
+func (f FocalPoint) X() float64 {
+	return f.x
+}
+
+func (f FocalPoint) Y() float64 {
+	return f.y
+}
+
+func (f FocalPoint) Equals(other FocalPoint) bool {
+	return f.x == other.x && f.y == other.y
+}
@@ -0,0 +1,34 @@
+package valueobjects
+
+// VariantSpec adalah ukuran turunan gambar. Gambar di-crop ke rasio
+// Width:Height di sekitar focal point, lalu diperkecil (tidak pernah
+// diperbesar) ke ukuran tersebut.
+type VariantSpec struct {
+	Name   string
+	Width  int
+	Height int
+}
+
+const (
+	VariantThumbnail = "thumbnail"
+	VariantCard      = "card"
+	VariantHero      = "hero"
+)
+
+// ImageVariants adalah varian yang dibuat untuk setiap gambar:
+// thumbnail untuk daftar & CMS, card untuk kartu artikel 16:9,
+// hero untuk gambar utama halaman artikel.
+var ImageVariants = []VariantSpec{
+	{Name: VariantThumbnail, Width: 200, Height: 200},
+	{Name: VariantCard, Width: 640, Height: 360},
+	{Name: VariantHero, Width: 1600, Height: 900},
+}
+
+// ImageProcessor membaca dan membuat varian gambar.
+type ImageProcessor interface {
+	// Dimensions mengembalikan lebar dan tinggi gambar tanpa men-decode seluruh piksel.
+	Dimensions(data []byte) (width, height int, err error)
+	// Variant membuat varian sesuai spec dan mengembalikan bytes hasil encode
+	// beserta ukuran akhirnya.
+	Variant(data []byte, spec VariantSpec, focal FocalPoint) (output []byte, width, height int, err error)
+}
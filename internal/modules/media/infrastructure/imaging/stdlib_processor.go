@@ -0,0 +1,135 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	// Registrasi decoder GIF untuk image.Decode
+	_ "image/gif"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+)
+
+const jpegQuality = 85
+
+var ErrInvalidVariantSpec = errors.New("variant width and height must be positive")
+
+// StdlibProcessor adalah ImageProcessor yang hanya memakai standard library.
+// Varian dari JPEG di-encode sebagai JPEG, selain itu sebagai PNG.
+type StdlibProcessor struct{}
+
+func NewStdlibProcessor() vo.ImageProcessor {
+	return &StdlibProcessor{}
+}
+
+func (p *StdlibProcessor) Dimensions(data []byte) (int, int, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, err
+	}
+	return config.Width, config.Height, nil
+}
+
+func (p *StdlibProcessor) Variant(data []byte, spec vo.VariantSpec, focal vo.FocalPoint) ([]byte, int, int, error) {
+	if spec.Width <= 0 || spec.Height <= 0 {
+		return nil, 0, 0, ErrInvalidVariantSpec
+	}
+
+	src, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	// 1. Crop ke rasio spec di sekitar focal point
+	crop := CropRect(src.Bounds(), spec.Width, spec.Height, focal)
+	cropped := image.NewNRGBA(image.Rect(0, 0, crop.Dx(), crop.Dy()))
+	draw.Draw(cropped, cropped.Bounds(), src, crop.Min, draw.Src)
+
+	// 2. Perkecil ke ukuran spec, tidak pernah diperbesar
+	width, height := crop.Dx(), crop.Dy()
+	if width > spec.Width {
+		width, height = spec.Width, spec.Height
+	}
+	resized := downscale(cropped, width, height)
+
+	// 3. Encode
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: jpegQuality})
+	} else {
+		err = png.Encode(&buf, resized)
+	}
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return buf.Bytes(), width, height, nil
+}
+
+// CropRect mengembalikan area terbesar dengan rasio width:height di dalam
+// bounds, dipusatkan ke focal point lalu digeser supaya tidak keluar batas.
+func CropRect(bounds image.Rectangle, width, height int, focal vo.FocalPoint) image.Rectangle {
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	cropW, cropH := srcW, srcW*height/width
+	if cropH > srcH {
+		cropW, cropH = srcH*width/height, srcH
+	}
+	cropW, cropH = max(cropW, 1), max(cropH, 1)
+
+	x := clamp(int(focal.X()*float64(srcW))-cropW/2, 0, srcW-cropW)
+	y := clamp(int(focal.Y()*float64(srcH))-cropH/2, 0, srcH-cropH)
+
+	origin := bounds.Min.Add(image.Pt(x, y))
+	return image.Rectangle{Min: origin, Max: origin.Add(image.Pt(cropW, cropH))}
+}
+
+// downscale memperkecil src dengan box filter: setiap piksel tujuan adalah
+// rata-rata piksel sumber yang tertutup olehnya. Cukup untuk rasio perkecilan
+// besar tanpa aliasing, dan sama dengan salinan biasa jika ukurannya sama.
+func downscale(src *image.NRGBA, width, height int) *image.NRGBA {
+	srcW, srcH := src.Bounds().Dx(), src.Bounds().Dy()
+	if srcW == width && srcH == height {
+		return src
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for dy := 0; dy < height; dy++ {
+		y0 := dy * srcH / height
+		y1 := max((dy+1)*srcH/height, y0+1)
+
+		for dx := 0; dx < width; dx++ {
+			x0 := dx * srcW / width
+			x1 := max((dx+1)*srcW/width, x0+1)
+
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += int(src.Pix[offset])
+					g += int(src.Pix[offset+1])
+					b += int(src.Pix[offset+2])
+					a += int(src.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			offset := dst.PixOffset(dx, dy)
+			dst.Pix[offset] = uint8(r / n)
+			dst.Pix[offset+1] = uint8(g / n)
+			dst.Pix[offset+2] = uint8(b / n)
+			dst.Pix[offset+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
+
+func clamp(value, low, high int) int {
+	return max(low, min(value, high))
+}
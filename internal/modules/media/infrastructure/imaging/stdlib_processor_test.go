@@ -0,0 +1,116 @@
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+	imaging "github.com/jokosaputro95/cms-news-api/internal/modules/media/infrastructure/imaging"
+)
+
+func focalPoint(t *testing.T, x, y float64) vo.FocalPoint {
+	t.Helper()
+	focal, err := vo.NewFocalPoint(x, y)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	return *focal
+}
+
+func encodedImage(t *testing.T, width, height int, encode func(*bytes.Buffer, image.Image) error) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := encode(&buf, img); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	return buf.Bytes()
+}
+
+func encodePNG(buf *bytes.Buffer, img image.Image) error {
+	return png.Encode(buf, img)
+}
+
+func encodeJPEG(buf *bytes.Buffer, img image.Image) error {
+	return jpeg.Encode(buf, img, nil)
+}
+
+func TestCropRect(t *testing.T) {
+	bounds := image.Rect(0, 0, 4000, 2000)
+
+	t.Run("should crop a square around the focal point", func(t *testing.T) {
+		crop := imaging.CropRect(bounds, 200, 200, focalPoint(t, 0.25, 0.5))
+		assert.Equal(t, image.Rect(0, 0, 2000, 2000), crop)
+
+		crop = imaging.CropRect(bounds, 200, 200, focalPoint(t, 0.6, 0.5))
+		assert.Equal(t, image.Rect(1400, 0, 3400, 2000), crop)
+	})
+
+	t.Run("should keep the crop inside the image near the edges", func(t *testing.T) {
+		crop := imaging.CropRect(bounds, 200, 200, focalPoint(t, 1, 0))
+		assert.Equal(t, image.Rect(2000, 0, 4000, 2000), crop)
+	})
+
+	t.Run("should use the full width for a wider source ratio", func(t *testing.T) {
+		crop := imaging.CropRect(image.Rect(0, 0, 1600, 1600), 1600, 900, focalPoint(t, 0.5, 0.1))
+		assert.Equal(t, image.Rect(0, 0, 1600, 900), crop)
+	})
+}
+
+func TestStdlibProcessor(t *testing.T) {
+	processor := imaging.NewStdlibProcessor()
+
+	t.Run("should read the dimensions", func(t *testing.T) {
+		width, height, err := processor.Dimensions(encodedImage(t, 320, 240, encodePNG))
+
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		assert.Equal(t, 320, width)
+		assert.Equal(t, 240, height)
+	})
+
+	t.Run("should downscale to the variant size and keep the JPEG format", func(t *testing.T) {
+		spec := vo.VariantSpec{Name: vo.VariantCard, Width: 64, Height: 36}
+
+		output, width, height, err := processor.Variant(encodedImage(t, 200, 200, encodeJPEG), spec, vo.CenterFocalPoint())
+
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		assert.Equal(t, 64, width)
+		assert.Equal(t, 36, height)
+		assert.Equal(t, "image/jpeg", http.DetectContentType(output))
+	})
+
+	t.Run("should never upscale small images", func(t *testing.T) {
+		spec := vo.VariantSpec{Name: vo.VariantHero, Width: 1600, Height: 900}
+
+		output, width, height, err := processor.Variant(encodedImage(t, 320, 320, encodePNG), spec, vo.CenterFocalPoint())
+
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		assert.Equal(t, 320, width)
+		assert.Equal(t, 180, height)
+		assert.Equal(t, "image/png", http.DetectContentType(output))
+	})
+
+	t.Run("should reject data that is not an image", func(t *testing.T) {
+		_, _, err := processor.Dimensions([]byte("%PDF-1.7"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS update_media_updated_at ON media;
+DROP INDEX IF EXISTS idx_media_uploaded_by;
+DROP INDEX IF EXISTS idx_media_created_at;
+DROP TABLE IF EXISTS media_variants;
+DROP TABLE IF EXISTS media;
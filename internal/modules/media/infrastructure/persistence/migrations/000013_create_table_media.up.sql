@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS media (
+    id VARCHAR(255) PRIMARY KEY,
+    original_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(500) NOT NULL UNIQUE,
+    width INTEGER NOT NULL DEFAULT 0,
+    height INTEGER NOT NULL DEFAULT 0,
+    alt VARCHAR(250) NOT NULL DEFAULT '',
+    caption TEXT NOT NULL DEFAULT '',
+    credit VARCHAR(200) NOT NULL DEFAULT '',
+    focal_x DOUBLE PRECISION NOT NULL DEFAULT 0.5 CHECK (focal_x BETWEEN 0 AND 1),
+    focal_y DOUBLE PRECISION NOT NULL DEFAULT 0.5 CHECK (focal_y BETWEEN 0 AND 1),
+    uploaded_by VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Varian gambar (thumbnail, card, hero) dibuat ulang setiap focal point berubah
+CREATE TABLE IF NOT EXISTS media_variants (
+    media_id VARCHAR(255) NOT NULL REFERENCES media(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    storage_key VARCHAR(500) NOT NULL,
+    width INTEGER NOT NULL,
+    height INTEGER NOT NULL,
+    size BIGINT NOT NULL,
+    PRIMARY KEY (media_id, name)
+);
+
+-- Index untuk performance
+CREATE INDEX IF NOT EXISTS idx_media_created_at ON media(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_media_uploaded_by ON media(uploaded_by);
+
+-- Trigger untuk auto-update updated_at (function dibuat oleh migration users)
+CREATE TRIGGER update_media_updated_at
+    BEFORE UPDATE ON media
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,294 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type MediaRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewMediaRepositoryPostgres(db *sql.DB) repos.MediaRepository {
+	return &MediaRepositoryPostgres{db: db}
+}
+
+const mediaColumns = "id, original_name, content_type, size, storage_key, width, height, alt, caption, credit, focal_x, focal_y, uploaded_by, created_at, updated_at"
+
+// rowScanner dipenuhi oleh *sql.Row dan *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (r *MediaRepositoryPostgres) Save(ctx context.Context, media *entities.Media) (*entities.Media, error) {
+	query := `
+		INSERT INTO media (id, original_name, content_type, size, storage_key, width, height, alt, caption, credit, focal_x, focal_y, uploaded_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING created_at, updated_at
+	`
+	var createdAt, updatedAt time.Time
+
+	// ✅ Media dan variannya disimpan dalam satu transaksi
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(
+		ctx,
+		query,
+		media.ID,
+		media.OriginalName,
+		media.ContentType.String(),
+		media.Size,
+		media.StorageKey,
+		media.Width,
+		media.Height,
+		media.Alt,
+		media.Caption,
+		media.Credit,
+		media.FocalPoint.X(),
+		media.FocalPoint.Y(),
+		media.UploadedBy,
+		media.CreatedAt,
+		media.UpdatedAt,
+	).Scan(&createdAt, &updatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := insertVariants(ctx, tx, media); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	media.CreatedAt = createdAt
+	media.UpdatedAt = updatedAt
+
+	return media, nil
+}
+
+func (r *MediaRepositoryPostgres) Update(ctx context.Context, media *entities.Media) (*entities.Media, error) {
+	query := `
+		UPDATE media
+		SET alt = $2, caption = $3, credit = $4, focal_x = $5, focal_y = $6
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(
+		ctx,
+		query,
+		media.ID,
+		media.Alt,
+		media.Caption,
+		media.Credit,
+		media.FocalPoint.X(),
+		media.FocalPoint.Y(),
+	).Scan(&media.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM media_variants WHERE media_id = $1", media.ID); err != nil {
+		return nil, err
+	}
+
+	if err := insertVariants(ctx, tx, media); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+func (r *MediaRepositoryPostgres) FindByID(ctx context.Context, id string) (*entities.Media, error) {
+	query := "SELECT " + mediaColumns + " FROM media WHERE id = $1"
+
+	media, err := scanMedia(shared.Executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil // Media tidak ditemukan
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadVariants(ctx, media); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
+
+func (r *MediaRepositoryPostgres) FindAll(ctx context.Context, limit, offset int) ([]*entities.Media, int, error) {
+	var total int
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM media").Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := "SELECT " + mediaColumns + " FROM media ORDER BY created_at DESC LIMIT $1 OFFSET $2"
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var medias []*entities.Media
+	for rows.Next() {
+		media, err := scanMedia(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		medias = append(medias, media)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.loadVariants(ctx, medias...); err != nil {
+		return nil, 0, err
+	}
+
+	return medias, total, nil
+}
+
+func (r *MediaRepositoryPostgres) IsInUse(ctx context.Context, id string) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM articles WHERE featured_image_id = $1)"
+
+	var inUse bool
+	if err := shared.Executor(ctx, r.db).QueryRowContext(ctx, query, id).Scan(&inUse); err != nil {
+		return false, err
+	}
+	return inUse, nil
+}
+
+func (r *MediaRepositoryPostgres) Delete(ctx context.Context, id string) error {
+	query := "DELETE FROM media WHERE id = $1"
+	_, err := shared.Executor(ctx, r.db).ExecContext(ctx, query, id)
+	return err
+}
+
+// loadVariants mengisi Variants untuk sekumpulan media dengan satu query.
+func (r *MediaRepositoryPostgres) loadVariants(ctx context.Context, medias ...*entities.Media) error {
+	if len(medias) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*entities.Media, len(medias))
+	ids := make([]string, 0, len(medias))
+	for _, media := range medias {
+		byID[media.ID] = media
+		ids = append(ids, media.ID)
+	}
+
+	query := `
+		SELECT media_id, name, storage_key, width, height, size
+		FROM media_variants
+		WHERE media_id = ANY($1)
+		ORDER BY width
+	`
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var mediaID string
+		var variant entities.MediaVariant
+		if err := rows.Scan(&mediaID, &variant.Name, &variant.StorageKey, &variant.Width, &variant.Height, &variant.Size); err != nil {
+			return err
+		}
+		media := byID[mediaID]
+		media.Variants = append(media.Variants, variant)
+	}
+
+	return rows.Err()
+}
+
+func insertVariants(ctx context.Context, tx shared.DBTX, media *entities.Media) error {
+	for _, variant := range media.Variants {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO media_variants (media_id, name, storage_key, width, height, size)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			media.ID,
+			variant.Name,
+			variant.StorageKey,
+			variant.Width,
+			variant.Height,
+			variant.Size,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanMedia membaca satu baris mediaColumns dan membuat ulang value object-nya.
+func scanMedia(row rowScanner) (*entities.Media, error) {
+	var media entities.Media
+	var contentType string
+	var focalX, focalY float64
+
+	err := row.Scan(
+		&media.ID,
+		&media.OriginalName,
+		&contentType,
+		&media.Size,
+		&media.StorageKey,
+		&media.Width,
+		&media.Height,
+		&media.Alt,
+		&media.Caption,
+		&media.Credit,
+		&focalX,
+		&focalY,
+		&media.UploadedBy,
+		&media.CreatedAt,
+		&media.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// ✅ Recreate value objects dari data yang diambil
+	contentTypeVO, err := vo.NewContentType(contentType)
+	if err != nil {
+		return nil, err
+	}
+	media.ContentType = *contentTypeVO
+
+	focalVO, err := vo.NewFocalPoint(focalX, focalY)
+	if err != nil {
+		return nil, err
+	}
+	media.FocalPoint = *focalVO
+
+	return &media, nil
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"net/http"
+	"path"
+	"strings"
+)
+
+// FileServer menyajikan blob milik LocalBlobStore dari root. Listing
+// direktori dan file tersembunyi (file upload sementara) tidak disajikan.
+func FileServer(root string) http.Handler {
+	files := http.FileServer(http.Dir(root))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := path.Clean("/" + r.URL.Path)
+		if strings.HasSuffix(r.URL.Path, "/") || strings.HasPrefix(path.Base(name), ".") {
+			http.NotFound(w, r)
+			return
+		}
+
+		// Key media tidak pernah ditimpa, jadi aman di-cache lama
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		files.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,53 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/media/domain/value_objects"
+	storage "github.com/jokosaputro95/cms-news-api/internal/modules/media/infrastructure/storage"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should store, read and delete a blob", func(t *testing.T) {
+		store := storage.NewLocalBlobStore(t.TempDir(), "https://cdn.example.com/media/")
+		key := "2026/10/media-uuid/original.jpg"
+
+		if err := store.Put(ctx, key, strings.NewReader("jpeg-bytes"), "image/jpeg"); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+
+		file, err := store.Open(ctx, key)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		content, _ := io.ReadAll(file)
+		file.Close()
+		assert.Equal(t, "jpeg-bytes", string(content))
+		assert.Equal(t, "https://cdn.example.com/media/"+key, store.URL(key))
+
+		if err := store.Delete(ctx, key); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		_, err = store.Open(ctx, key)
+		assert.ErrorIs(t, err, vo.ErrBlobNotFound)
+
+		// Menghapus key yang sudah tidak ada bukan error
+		assert.NoError(t, store.Delete(ctx, key))
+	})
+
+	t.Run("should reject keys outside the storage root", func(t *testing.T) {
+		store := storage.NewLocalBlobStore(t.TempDir(), "/media")
+
+		for _, key := range []string{"", "../etc/passwd", "2026/../../secret", "/absolute", "a//b"} {
+			err := store.Put(ctx, key, strings.NewReader("x"), "image/png")
+			assert.ErrorIs(t, err, storage.ErrInvalidBlobKey, key)
+		}
+	})
+}
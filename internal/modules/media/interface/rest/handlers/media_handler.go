@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/media/application/usecases"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// multipartOverhead memberi ruang untuk field form selain file.
+const multipartOverhead = 1 << 20
+
+type MediaHandler struct {
+	uploadUseCase *usecases.UploadMedia
+	getUseCase    *usecases.GetMedia
+	listUseCase   *usecases.ListMedia
+	updateUseCase *usecases.UpdateMedia
+	deleteUseCase *usecases.DeleteMedia
+}
+
+func NewMediaHandler(
+	uploadUseCase *usecases.UploadMedia,
+	getUseCase *usecases.GetMedia,
+	listUseCase *usecases.ListMedia,
+	updateUseCase *usecases.UpdateMedia,
+	deleteUseCase *usecases.DeleteMedia) *MediaHandler {
+	return &MediaHandler{
+		uploadUseCase: uploadUseCase,
+		getUseCase:    getUseCase,
+		listUseCase:   listUseCase,
+		updateUseCase: updateUseCase,
+		deleteUseCase: deleteUseCase,
+	}
+}
+
+// Upload menerima multipart/form-data dengan field "file" dan metadata
+// opsional alt, caption, credit, focal_x, focal_y.
+func (h *MediaHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, h.uploadUseCase.MaxSize()+multipartOverhead)
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		shared.WriteErrorResponse(w, "INVALID_FORM", "Request must be multipart/form-data", http.StatusBadRequest)
+		return
+	}
+
+	input := dto.UploadMediaInput{Actor: actor(r)}
+	fields := map[string]string{}
+
+	// File dibaca terakhir: field metadata harus dikirim sebelum field "file"
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				shared.WriteErrorResponse(w, "VALIDATION_ERROR", "File is too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			break // io.EOF atau form rusak: file wajib ada, dicek di bawah
+		}
+
+		if part.FormName() == "file" {
+			input.File = part
+			input.FileName = part.FileName()
+			break
+		}
+
+		value, err := readField(part)
+		if err != nil {
+			shared.WriteErrorResponse(w, "INVALID_FORM", "Invalid form field", http.StatusBadRequest)
+			return
+		}
+		fields[part.FormName()] = value
+	}
+
+	// Basic validation
+	if input.File == nil {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "File is required", http.StatusBadRequest)
+		return
+	}
+
+	input.Alt, input.Caption, input.Credit = fields["alt"], fields["caption"], fields["credit"]
+	if input.FocalX, err = formFloat(fields["focal_x"]); err != nil {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "focal_x must be a number", http.StatusBadRequest)
+		return
+	}
+	if input.FocalY, err = formFloat(fields["focal_y"]); err != nil {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "focal_y must be a number", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.uploadUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Media uploaded successfully", http.StatusCreated)
+}
+
+func (h *MediaHandler) Get(w http.ResponseWriter, r *http.Request) {
+	result, err := h.getUseCase.Execute(r.Context(), &dto.GetMediaInput{ID: r.PathValue("id")})
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Media retrieved successfully", http.StatusOK)
+}
+
+func (h *MediaHandler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	result, err := h.listUseCase.Execute(r.Context(), &dto.ListMediaInput{Page: page, Limit: limit})
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Media retrieved successfully", http.StatusOK)
+}
+
+func (h *MediaHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var input dto.UpdateMediaInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.ID = r.PathValue("id")
+	input.Actor = actor(r)
+
+	result, err := h.updateUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Media updated successfully", http.StatusOK)
+}
+
+func (h *MediaHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	err := h.deleteUseCase.Execute(r.Context(), &dto.DeleteMediaInput{ID: r.PathValue("id"), Actor: actor(r)})
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, nil, "Media deleted successfully", http.StatusOK)
+}
+
+func actor(r *http.Request) *shared.Principal {
+	principal, ok := shared.PrincipalFromContext(r.Context())
+	if !ok {
+		return nil
+	}
+	return principal
+}
+
+// maxFieldLength membatasi field metadata; batas sebenarnya divalidasi entity.
+const maxFieldLength = 4096
+
+func readField(part io.Reader) (string, error) {
+	value, err := io.ReadAll(io.LimitReader(part, maxFieldLength+1))
+	if err != nil {
+		return "", err
+	}
+	if len(value) > maxFieldLength {
+		return "", errors.New("field too long")
+	}
+	return strings.TrimSpace(string(value)), nil
+}
+
+func formFloat(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
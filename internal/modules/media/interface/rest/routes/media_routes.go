@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"net/http"
+
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	middleware "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/middleware"
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/media/interface/rest/handlers"
+)
+
+// SetupMediaRoutes mendaftarkan API media library. files menyajikan isi
+// BlobStore di bawah /media/ dan boleh nil jika file disajikan CDN.
+func SetupMediaRoutes(mux *http.ServeMux, mediaHandler *handlers.MediaHandler, files http.Handler, jwtMiddleware *middleware.JWTMiddleware) {
+	// Public endpoints
+	mux.HandleFunc("GET /api/v1/media/{id}", mediaHandler.Get)
+	if files != nil {
+		mux.Handle("GET /media/", http.StripPrefix("/media", files))
+	}
+
+	// Protected endpoints
+	upload := string(authvo.PermissionMediaUpload)
+	mux.Handle("POST /api/v1/media", jwtMiddleware.Protect(upload, mediaHandler.Upload))
+	mux.Handle("GET /api/v1/media", jwtMiddleware.Protect(upload, mediaHandler.List))
+	mux.Handle("PATCH /api/v1/media/{id}", jwtMiddleware.Protect(upload, mediaHandler.Update))
+	mux.Handle("DELETE /api/v1/media/{id}", jwtMiddleware.Protect(upload, mediaHandler.Delete))
+}
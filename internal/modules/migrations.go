@@ -0,0 +1,10 @@
+// Package modules menampung migrasi SQL seluruh modul.
+package modules
+
+import "embed"
+
+// Migrations berisi file migrasi semua modul. Modul baru cukup menaruh file
+// NNNNNN_nama.up.sql/.down.sql di infrastructure/persistence/migrations.
+//
+//go:embed */infrastructure/persistence/migrations/*.sql
+var Migrations embed.FS
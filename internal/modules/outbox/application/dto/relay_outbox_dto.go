@@ -0,0 +1,9 @@
+package dto
+
+// RelayOutboxOutput merangkum hasil satu putaran dispatcher.
+type RelayOutboxOutput struct {
+	Delivered    int
+	Retried      int
+	DeadLettered int
+	Purged       int
+}
@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	// relayBatchSize adalah jumlah pesan yang diklaim per query.
+	relayBatchSize = 50
+	// relayLease adalah lama pesan terkunci untuk satu dispatcher. Jika proses
+	// mati sebelum hasilnya disimpan, pesan diambil lagi setelah lease habis.
+	relayLease = time.Minute
+	// relayLeaseMargin adalah sisa lease minimal untuk mulai mengirim pesan
+	// berikutnya, supaya pesan tidak dikirim setelah replika lain bisa mengklaimnya.
+	relayLeaseMargin = 10 * time.Second
+)
+
+// EventDecoder mengubah payload outbox kembali menjadi domain event bertipe,
+// supaya handler menerima tipe yang sama dengan saat event dicatat.
+type EventDecoder func(payload []byte) (shared.DomainEvent, error)
+
+// DecodeAs membuat EventDecoder untuk tipe event T.
+func DecodeAs[T shared.DomainEvent]() EventDecoder {
+	return func(payload []byte) (shared.DomainEvent, error) {
+		var event T
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+}
+
+// RelayOutbox meneruskan pesan outbox ke handler dengan retry, backoff dan
+// dead-letter. Pengiriman bersifat at-least-once.
+type RelayOutbox struct {
+	outboxRepository repos.OutboxRepository
+	dispatcher       shared.EventDispatcher
+	retryPolicy      vo.RetryPolicy
+	retention        time.Duration
+	decoders         map[string]EventDecoder
+}
+
+// NewRelayOutbox adalah konstruktor untuk use case ini. Pesan terkirim
+// dihapus setelah retention, 0 berarti tidak pernah dihapus.
+func NewRelayOutbox(outboxRepo repos.OutboxRepository, dispatcher shared.EventDispatcher, retryPolicy vo.RetryPolicy, retention time.Duration) *RelayOutbox {
+	return &RelayOutbox{
+		outboxRepository: outboxRepo,
+		dispatcher:       dispatcher,
+		retryPolicy:      retryPolicy,
+		retention:        retention,
+		decoders:         make(map[string]EventDecoder),
+	}
+}
+
+// Register mendaftarkan decoder untuk eventName. Pesan dengan event yang
+// tidak terdaftar langsung masuk dead-letter.
+func (r *RelayOutbox) Register(eventName string, decoder EventDecoder) {
+	r.decoders[eventName] = decoder
+}
+
+// Execute memproses semua pesan yang jatuh tempo pada now, batch demi batch.
+// now hanya titik awal; waktu klaim tiap batch maju sesuai waktu yang sudah
+// berlalu, karena lease dihitung dari waktu klaim.
+func (r *RelayOutbox) Execute(ctx context.Context, now time.Time) (*dto.RelayOutboxOutput, error) {
+	output := &dto.RelayOutboxOutput{}
+	started := time.Now()
+	clock := func() time.Time { return now.Add(time.Since(started)) }
+
+drain:
+	for {
+		// 1. Klaim pesan yang jatuh tempo dengan lease mulai dari saat ini
+		messages, err := r.outboxRepository.ClaimDue(ctx, clock(), relayLease, relayBatchSize)
+		if err != nil {
+			return output, shared.NewDatabaseError(err)
+		}
+		claimed := time.Now()
+
+		// 2. Kirim satu per satu dan simpan hasilnya
+		for _, message := range messages {
+			// ✅ Berhenti sebelum lease habis. Sisa pesan tetap terkunci dan
+			// diklaim ulang setelah lease lewat, tidak dikirim dua kali
+			if time.Since(claimed) >= relayLease-relayLeaseMargin {
+				break drain
+			}
+
+			r.deliver(ctx, message, clock(), output)
+
+			if err := r.outboxRepository.Update(ctx, message); err != nil {
+				return output, shared.NewDatabaseError(err)
+			}
+		}
+
+		if len(messages) < relayBatchSize {
+			break
+		}
+	}
+
+	// 3. Bersihkan pesan terkirim yang sudah melewati masa simpan
+	if r.retention > 0 {
+		purged, err := r.outboxRepository.DeleteDeliveredBefore(ctx, now.Add(-r.retention))
+		if err != nil {
+			return output, shared.NewDatabaseError(err)
+		}
+		output.Purged = purged
+	}
+
+	return output, nil
+}
+
+// deliver mengubah status message sesuai hasil dispatch dan mencatatnya di output.
+func (r *RelayOutbox) deliver(ctx context.Context, message *entities.OutboxMessage, now time.Time, output *dto.RelayOutboxOutput) {
+	decode, ok := r.decoders[message.EventName]
+	if !ok {
+		message.DeadLetter(now, fmt.Sprintf("no decoder registered for %s", message.EventName))
+		output.DeadLettered++
+		return
+	}
+
+	event, err := decode(message.Payload)
+	if err != nil {
+		// ✅ Payload rusak tidak akan membaik dengan retry
+		message.DeadLetter(now, fmt.Sprintf("failed to decode payload: %v", err))
+		output.DeadLettered++
+		return
+	}
+
+	if err := r.dispatcher.Dispatch(ctx, event); err != nil {
+		if message.RecordFailure(now, err.Error(), r.retryPolicy) {
+			output.DeadLettered++
+		} else {
+			output.Retried++
+		}
+		return
+	}
+
+	message.MarkDelivered(now)
+	output.Delivered++
+}
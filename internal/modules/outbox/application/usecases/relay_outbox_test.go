@@ -0,0 +1,227 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.OutboxMessage, error) {
+	args := m.Called(ctx, now, lease, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxRepository) Update(ctx context.Context, message *entities.OutboxMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) DeleteDeliveredBefore(ctx context.Context, before time.Time) (int, error) {
+	args := m.Called(ctx, before)
+	return args.Int(0), args.Error(1)
+}
+
+type MockEventDispatcher struct {
+	mock.Mock
+}
+
+func (m *MockEventDispatcher) Dispatch(ctx context.Context, event shared.DomainEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+type testEvent struct {
+	ID string    `json:"id"`
+	At time.Time `json:"occurred_at"`
+}
+
+func (e testEvent) EventName() string {
+	return "test.happened"
+}
+
+func (e testEvent) OccurredAt() time.Time {
+	return e.At
+}
+
+var retryPolicy = vo.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+
+func setupRelayOutboxTest(t *testing.T) (*MockOutboxRepository, *MockEventDispatcher, *usecases.RelayOutbox) {
+	t.Helper()
+	outboxRepoMock := new(MockOutboxRepository)
+	dispatcherMock := new(MockEventDispatcher)
+
+	relayOutboxUsecase := usecases.NewRelayOutbox(outboxRepoMock, dispatcherMock, retryPolicy, 0)
+	relayOutboxUsecase.Register("test.happened", usecases.DecodeAs[testEvent]())
+
+	return outboxRepoMock, dispatcherMock, relayOutboxUsecase
+}
+
+func newOutboxMessage(t *testing.T, id int64, attempts int) *entities.OutboxMessage {
+	t.Helper()
+	message, err := entities.NewOutboxMessage(testEvent{ID: "event-1", At: time.Now().Add(-time.Minute).UTC()})
+	if err != nil {
+		t.Fatalf("Error creating outbox message: %v", err)
+	}
+	message.ID = id
+	message.Attempts = attempts
+	return message
+}
+
+// claimedFrom mencocokkan waktu klaim yang dimulai dari now ditambah waktu yang sudah berlalu.
+func claimedFrom(now time.Time) interface{} {
+	return mock.MatchedBy(func(claimedAt time.Time) bool {
+		return !claimedAt.Before(now) && claimedAt.Before(now.Add(time.Second))
+	})
+}
+
+func TestRelayOutbox(t *testing.T) {
+	t.Run("should decode and deliver a due message", func(t *testing.T) {
+		outboxRepoMock, dispatcherMock, relayOutboxUsecase := setupRelayOutboxTest(t)
+		now := time.Now()
+		message := newOutboxMessage(t, 1, 0)
+
+		outboxRepoMock.On("ClaimDue", mock.Anything, claimedFrom(now), mock.Anything, mock.Anything).Return([]*entities.OutboxMessage{message}, nil).Once()
+		dispatcherMock.On("Dispatch", mock.Anything, mock.MatchedBy(func(event shared.DomainEvent) bool {
+			// ✅ Handler menerima event bertipe, bukan payload mentah
+			decoded, ok := event.(testEvent)
+			return ok && decoded.ID == "event-1"
+		})).Return(nil).Once()
+		outboxRepoMock.On("Update", mock.Anything, mock.MatchedBy(func(m *entities.OutboxMessage) bool {
+			return m.DeliveredAt != nil && m.Attempts == 1
+		})).Return(nil).Once()
+
+		output, err := relayOutboxUsecase.Execute(context.Background(), now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, output.Delivered)
+		outboxRepoMock.AssertExpectations(t)
+		dispatcherMock.AssertExpectations(t)
+	})
+
+	t.Run("should schedule a retry with backoff when a handler fails", func(t *testing.T) {
+		outboxRepoMock, dispatcherMock, relayOutboxUsecase := setupRelayOutboxTest(t)
+		now := time.Now()
+		message := newOutboxMessage(t, 1, 1)
+
+		outboxRepoMock.On("ClaimDue", mock.Anything, claimedFrom(now), mock.Anything, mock.Anything).Return([]*entities.OutboxMessage{message}, nil).Once()
+		dispatcherMock.On("Dispatch", mock.Anything, mock.Anything).Return(errors.New("smtp down")).Once()
+		outboxRepoMock.On("Update", mock.Anything, mock.MatchedBy(func(m *entities.OutboxMessage) bool {
+			return m.DeliveredAt == nil && m.DeadLetteredAt == nil && m.Attempts == 2 &&
+				m.LastError == "smtp down" && !m.NextAttemptAt.Before(now.Add(2*time.Second)) && m.NextAttemptAt.Before(now.Add(3*time.Second))
+		})).Return(nil).Once()
+
+		output, err := relayOutboxUsecase.Execute(context.Background(), now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, output.Retried)
+		outboxRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should dead-letter a message after the last attempt", func(t *testing.T) {
+		outboxRepoMock, dispatcherMock, relayOutboxUsecase := setupRelayOutboxTest(t)
+		now := time.Now()
+		message := newOutboxMessage(t, 1, 2)
+
+		outboxRepoMock.On("ClaimDue", mock.Anything, claimedFrom(now), mock.Anything, mock.Anything).Return([]*entities.OutboxMessage{message}, nil).Once()
+		dispatcherMock.On("Dispatch", mock.Anything, mock.Anything).Return(errors.New("smtp down")).Once()
+		outboxRepoMock.On("Update", mock.Anything, mock.MatchedBy(func(m *entities.OutboxMessage) bool {
+			return m.DeadLetteredAt != nil && m.Attempts == 3
+		})).Return(nil).Once()
+
+		output, err := relayOutboxUsecase.Execute(context.Background(), now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, output.DeadLettered)
+		outboxRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should dead-letter an event without a registered decoder", func(t *testing.T) {
+		outboxRepoMock, dispatcherMock, relayOutboxUsecase := setupRelayOutboxTest(t)
+		now := time.Now()
+		message := newOutboxMessage(t, 1, 0)
+		message.EventName = "unknown.event"
+
+		outboxRepoMock.On("ClaimDue", mock.Anything, claimedFrom(now), mock.Anything, mock.Anything).Return([]*entities.OutboxMessage{message}, nil).Once()
+		outboxRepoMock.On("Update", mock.Anything, mock.MatchedBy(func(m *entities.OutboxMessage) bool {
+			return m.DeadLetteredAt != nil
+		})).Return(nil).Once()
+
+		output, err := relayOutboxUsecase.Execute(context.Background(), now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, output.DeadLettered)
+		dispatcherMock.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should purge delivered messages older than the retention", func(t *testing.T) {
+		outboxRepoMock := new(MockOutboxRepository)
+		relayOutboxUsecase := usecases.NewRelayOutbox(outboxRepoMock, new(MockEventDispatcher), retryPolicy, 24*time.Hour)
+		now := time.Now()
+
+		outboxRepoMock.On("ClaimDue", mock.Anything, claimedFrom(now), mock.Anything, mock.Anything).Return(nil, nil).Once()
+		outboxRepoMock.On("DeleteDeliveredBefore", mock.Anything, now.Add(-24*time.Hour)).Return(4, nil).Once()
+
+		output, err := relayOutboxUsecase.Execute(context.Background(), now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 4, output.Purged)
+		outboxRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should claim each batch with a lease starting when it is claimed", func(t *testing.T) {
+		outboxRepoMock, dispatcherMock, relayOutboxUsecase := setupRelayOutboxTest(t)
+		now := time.Now()
+
+		firstBatch := make([]*entities.OutboxMessage, 50)
+		for i := range firstBatch {
+			firstBatch[i] = newOutboxMessage(t, int64(i+1), 0)
+		}
+		var claimTimes []time.Time
+		outboxRepoMock.On("ClaimDue", mock.Anything, mock.Anything, time.Minute, 50).Run(func(args mock.Arguments) {
+			claimTimes = append(claimTimes, args.Get(1).(time.Time))
+		}).Return(firstBatch, nil).Once()
+		outboxRepoMock.On("ClaimDue", mock.Anything, mock.Anything, time.Minute, 50).Run(func(args mock.Arguments) {
+			claimTimes = append(claimTimes, args.Get(1).(time.Time))
+		}).Return(nil, nil).Once()
+		dispatcherMock.On("Dispatch", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+			time.Sleep(time.Millisecond)
+		}).Return(nil).Times(50)
+		outboxRepoMock.On("Update", mock.Anything, mock.Anything).Return(nil).Times(50)
+
+		output, err := relayOutboxUsecase.Execute(context.Background(), now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 50, output.Delivered)
+		if assert.Len(t, claimTimes, 2) {
+			// ✅ Batch kedua diklaim dengan waktu terbaru, bukan now dari tick
+			assert.True(t, claimTimes[1].Sub(claimTimes[0]) >= 50*time.Millisecond)
+		}
+	})
+
+	t.Run("should return a database error when claiming fails", func(t *testing.T) {
+		outboxRepoMock, _, relayOutboxUsecase := setupRelayOutboxTest(t)
+
+		outboxRepoMock.On("ClaimDue", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+
+		_, err := relayOutboxUsecase.Execute(context.Background(), time.Now())
+
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, "DATABASE_ERROR", shared.GetErrorCode(err))
+	})
+}
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// OutboxMessage adalah domain event yang sudah diserialisasi dan menunggu
+// diteruskan ke handler oleh dispatcher.
+type OutboxMessage struct {
+	ID             int64
+	EventName      string
+	Payload        []byte
+	OccurredAt     time.Time
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+	DeliveredAt    *time.Time
+	DeadLetteredAt *time.Time
+	CreatedAt      time.Time
+}
+
+// NewOutboxMessage menyerialisasi event menjadi JSON. ID diisi database.
+func NewOutboxMessage(event shared.DomainEvent) (*OutboxMessage, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event %s: %w", event.EventName(), err)
+	}
+
+	return &OutboxMessage{
+		EventName:     event.EventName(),
+		Payload:       payload,
+		OccurredAt:    event.OccurredAt(),
+		NextAttemptAt: event.OccurredAt(),
+	}, nil
+}
+
+// MarkDelivered menandai pesan sudah diterima semua handler.
+func (m *OutboxMessage) MarkDelivered(now time.Time) {
+	m.Attempts++
+	m.LastError = ""
+	m.DeliveredAt = &now
+}
+
+// RecordFailure mencatat percobaan yang gagal dan menjadwalkan percobaan
+// berikutnya sesuai policy. Mengembalikan true jika pesan masuk dead-letter.
+func (m *OutboxMessage) RecordFailure(now time.Time, reason string, policy vo.RetryPolicy) bool {
+	m.Attempts++
+	m.LastError = reason
+
+	if policy.Exhausted(m.Attempts) {
+		m.DeadLetteredAt = &now
+		return true
+	}
+
+	m.NextAttemptAt = now.Add(policy.Backoff(m.Attempts))
+	return false
+}
+
+// DeadLetter menghentikan percobaan ulang, mis. karena event tidak dikenal.
+func (m *OutboxMessage) DeadLetter(now time.Time, reason string) {
+	m.Attempts++
+	m.LastError = reason
+	m.DeadLetteredAt = &now
+}
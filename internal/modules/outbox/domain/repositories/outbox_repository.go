@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/entities"
+)
+
+// OutboxRepository dipakai dispatcher. Penulisan pesan baru dilakukan oleh
+// repository aggregate di dalam transaksinya sendiri, bukan lewat interface ini.
+type OutboxRepository interface {
+	// ClaimDue mengambil paling banyak limit pesan yang jatuh tempo pada now
+	// dan menguncinya selama lease supaya tidak diproses replika lain.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.OutboxMessage, error)
+	// Update menyimpan hasil percobaan pengiriman dan melepas kunci pesan.
+	Update(ctx context.Context, message *entities.OutboxMessage) error
+	// DeleteDeliveredBefore menghapus pesan terkirim yang lebih lama dari before.
+	DeleteDeliveredBefore(ctx context.Context, before time.Time) (int, error)
+}
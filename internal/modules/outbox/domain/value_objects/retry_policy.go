@@ -0,0 +1,34 @@
+package valueobjects
+
+import "time"
+
+// RetryPolicy menentukan berapa kali pesan outbox dicoba ulang dan berapa
+// lama jeda di antaranya (exponential backoff).
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Exhausted bernilai true jika attempts sudah mencapai batas percobaan.
+func (p RetryPolicy) Exhausted(attempts int) bool {
+	return p.MaxAttempts > 0 && attempts >= p.MaxAttempts
+}
+
+// Backoff mengembalikan jeda sebelum percobaan berikutnya setelah attempts
+// kali gagal: BaseDelay, 2x, 4x, ... dan tidak melebihi MaxDelay.
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempts; i++ {
+		// ✅ Berhenti menggandakan sebelum overflow
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,44 @@
+package valueobjects_test
+
+import (
+	"testing"
+	"time"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/value_objects"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := vo.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 8 * time.Second},
+		{5, 10 * time.Second},
+		{100, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := policy.Backoff(tt.attempts); got != tt.expected {
+			t.Errorf("Expected backoff after %d attempt(s) to be %v, but got %v", tt.attempts, tt.expected, got)
+		}
+	}
+}
+
+func TestRetryPolicyExhausted(t *testing.T) {
+	policy := vo.RetryPolicy{MaxAttempts: 3}
+
+	if policy.Exhausted(2) {
+		t.Error("Expected 2 attempts not to exhaust a policy of 3")
+	}
+	if !policy.Exhausted(3) {
+		t.Error("Expected 3 attempts to exhaust a policy of 3")
+	}
+	if (vo.RetryPolicy{}).Exhausted(1000) {
+		t.Error("Expected a zero MaxAttempts to retry forever")
+	}
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_outbox_dead_lettered_at;
+DROP INDEX IF EXISTS idx_outbox_delivered_at;
+DROP INDEX IF EXISTS idx_outbox_pending;
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,27 @@
+-- Outbox menyimpan domain event di transaksi yang sama dengan perubahan
+-- aggregate, lalu dispatcher meneruskannya ke handler (at-least-once).
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_name VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    dead_lettered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index untuk dispatcher: hanya pesan yang belum terkirim dan belum dead-letter
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at)
+    WHERE delivered_at IS NULL AND dead_lettered_at IS NULL;
+
+-- Index untuk membersihkan pesan terkirim yang sudah melewati masa simpan
+CREATE INDEX IF NOT EXISTS idx_outbox_delivered_at ON outbox(delivered_at)
+    WHERE delivered_at IS NOT NULL;
+
+-- Index untuk memeriksa pesan dead-letter secara manual
+CREATE INDEX IF NOT EXISTS idx_outbox_dead_lettered_at ON outbox(dead_lettered_at)
+    WHERE dead_lettered_at IS NOT NULL;
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type OutboxRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewOutboxRepositoryPostgres(db *sql.DB) repos.OutboxRepository {
+	return &OutboxRepositoryPostgres{db: db}
+}
+
+func (r *OutboxRepositoryPostgres) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.OutboxMessage, error) {
+	// ✅ SKIP LOCKED + locked_until: pesan yang sedang diproses replika lain dilewati,
+	// dan kembali bisa diambil jika prosesnya mati sebelum Update
+	query := `
+		UPDATE outbox SET locked_until = $2
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE delivered_at IS NULL AND dead_lettered_at IS NULL
+			  AND next_attempt_at <= $1
+			  AND (locked_until IS NULL OR locked_until <= $1)
+			ORDER BY id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, event_name, payload, occurred_at, attempts, next_attempt_at, last_error, created_at
+	`
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*entities.OutboxMessage
+	for rows.Next() {
+		var message entities.OutboxMessage
+		if err := rows.Scan(
+			&message.ID,
+			&message.EventName,
+			&message.Payload,
+			&message.OccurredAt,
+			&message.Attempts,
+			&message.NextAttemptAt,
+			&message.LastError,
+			&message.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		messages = append(messages, &message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// RETURNING tidak menjamin urutan, event dikirim sesuai urutan penulisan
+	sort.Slice(messages, func(i, j int) bool { return messages[i].ID < messages[j].ID })
+
+	return messages, nil
+}
+
+func (r *OutboxRepositoryPostgres) Update(ctx context.Context, message *entities.OutboxMessage) error {
+	_, err := shared.Executor(ctx, r.db).ExecContext(
+		ctx,
+		`UPDATE outbox
+		SET attempts = $2, next_attempt_at = $3, last_error = $4, delivered_at = $5, dead_lettered_at = $6, locked_until = NULL
+		WHERE id = $1`,
+		message.ID,
+		message.Attempts,
+		message.NextAttemptAt,
+		message.LastError,
+		message.DeliveredAt,
+		message.DeadLetteredAt,
+	)
+	return err
+}
+
+func (r *OutboxRepositoryPostgres) DeleteDeliveredBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := shared.Executor(ctx, r.db).ExecContext(ctx, "DELETE FROM outbox WHERE delivered_at < $1", before)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
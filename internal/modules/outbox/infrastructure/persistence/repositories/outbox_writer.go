@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/domain/entities"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// OutboxWriterPostgres menulis event ke tabel outbox memakai tx milik
+// repository aggregate. Tidak menyimpan *sql.DB karena selalu ikut tx pemanggil.
+type OutboxWriterPostgres struct{}
+
+func NewOutboxWriterPostgres() shared.EventOutbox {
+	return &OutboxWriterPostgres{}
+}
+
+// Append menulis event ke outbox memakai tx milik repository aggregate,
+// sehingga event ikut ter-commit (atau ikut batal) bersama perubahan datanya.
+func (w *OutboxWriterPostgres) Append(ctx context.Context, tx shared.DBTX, events []shared.DomainEvent) error {
+	for _, event := range events {
+		message, err := entities.NewOutboxMessage(event)
+		if err != nil {
+			return err
+		}
+
+		// Payload dikirim sebagai string supaya driver tidak meng-encode-nya sebagai bytea
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO outbox (event_name, payload, occurred_at, next_attempt_at)
+			VALUES ($1, $2, $3, $4)`,
+			message.EventName,
+			string(message.Payload),
+			message.OccurredAt,
+			message.NextAttemptAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/outbox/application/usecases"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// OutboxDispatcher menjalankan RelayOutbox secara periodik. Aman dijalankan
+// di beberapa replika karena pesan diklaim dengan FOR UPDATE SKIP LOCKED.
+type OutboxDispatcher struct {
+	useCase  *usecases.RelayOutbox
+	interval time.Duration
+}
+
+// defaultPollInterval dipakai jika interval tidak positif, karena
+// time.NewTicker panic untuk interval <= 0.
+const defaultPollInterval = time.Second
+
+func NewOutboxDispatcher(useCase *usecases.RelayOutbox, interval time.Duration) *OutboxDispatcher {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	return &OutboxDispatcher{
+		useCase:  useCase,
+		interval: interval,
+	}
+}
+
+var _ shared.Worker = (*OutboxDispatcher)(nil)
+
+// Run melakukan polling sampai ctx dibatalkan.
+func (d *OutboxDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			output, err := d.useCase.Execute(ctx, now)
+			if err != nil {
+				log.Printf("❌ Outbox dispatcher failed: %v", err)
+				continue
+			}
+			if output.Delivered > 0 {
+				log.Printf("✅ Outbox dispatcher delivered %d event(s)", output.Delivered)
+			}
+			if output.Retried > 0 {
+				log.Printf("❌ Outbox dispatcher will retry %d event(s)", output.Retried)
+			}
+			if output.DeadLettered > 0 {
+				log.Printf("❌ Outbox dispatcher dead-lettered %d event(s)", output.DeadLettered)
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+type SitemapRefOutput struct {
+	Loc          string
+	LastModified time.Time
+}
+
+// SitemapIndexOutput adalah daftar file sitemap (sitemap index).
+type SitemapIndexOutput struct {
+	Sitemaps     []SitemapRefOutput
+	LastModified time.Time
+}
+
+// GetSitemapInput: Name adalah nama file tanpa ekstensi, mis. "sections"
+// atau "articles-2".
+type GetSitemapInput struct {
+	Name string
+}
+
+type SitemapURLOutput struct {
+	Loc          string
+	LastModified time.Time
+}
+
+type URLSetOutput struct {
+	URLs         []SitemapURLOutput
+	LastModified time.Time
+}
+
+type NewsURLOutput struct {
+	Loc         string
+	Title       string
+	Language    string
+	PublishedAt time.Time
+}
+
+// NewsSitemapOutput adalah news sitemap: hanya artikel dalam jendela waktu
+// terbaru beserta metadata <news:publication>.
+type NewsSitemapOutput struct {
+	PublicationName string
+	URLs            []NewsURLOutput
+	LastModified    time.Time
+}
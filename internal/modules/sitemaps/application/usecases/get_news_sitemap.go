@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// Batasan Google News: hanya artikel 48 jam terakhir, maksimal 1.000 URL.
+const (
+	NewsSitemapWindow  = 48 * time.Hour
+	MaxNewsSitemapURLs = 1000
+)
+
+// GetNewsSitemap adalah use case untuk news sitemap.
+type GetNewsSitemap struct {
+	sitemapRepository repos.SitemapRepository
+	settings          SitemapSettings
+}
+
+// NewGetNewsSitemap adalah konstruktor untuk use case ini.
+func NewGetNewsSitemap(sitemapRepo repos.SitemapRepository, settings SitemapSettings) *GetNewsSitemap {
+	return &GetNewsSitemap{
+		sitemapRepository: sitemapRepo,
+		settings:          normalizeSettings(settings),
+	}
+}
+
+// Execute menyusun news sitemap dari artikel yang published dalam NewsSitemapWindow.
+func (g *GetNewsSitemap) Execute(ctx context.Context) (*dto.NewsSitemapOutput, error) {
+	articles, err := findNewsArticles(ctx, g.sitemapRepository)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output := &dto.NewsSitemapOutput{
+		PublicationName: g.settings.PublicationName,
+		URLs:            make([]dto.NewsURLOutput, 0, len(articles)),
+	}
+	for _, article := range articles {
+		output.URLs = append(output.URLs, dto.NewsURLOutput{
+			Loc:         g.settings.articleURL(article.Slug),
+			Title:       article.Title,
+			Language:    article.Language,
+			PublishedAt: article.PublishedAt,
+		})
+		output.LastModified = latest(output.LastModified, article.UpdatedAt)
+	}
+
+	return output, nil
+}
+
+// findNewsArticles mengambil isi news sitemap; dipakai juga sitemap index
+// supaya <lastmod> news sitemap dihitung dari jendela yang sama.
+func findNewsArticles(ctx context.Context, sitemapRepo repos.SitemapRepository) ([]*entities.ArticleEntry, error) {
+	return sitemapRepo.FindPublishedSince(ctx, time.Now().Add(-NewsSitemapWindow), MaxNewsSitemapURLs)
+}
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	sectionsSitemapName  = "sections"
+	articleSitemapPrefix = "articles-"
+)
+
+// GetSitemap adalah use case untuk satu file urlset yang terdaftar di sitemap index.
+type GetSitemap struct {
+	sitemapRepository repos.SitemapRepository
+	settings          SitemapSettings
+}
+
+// NewGetSitemap adalah konstruktor untuk use case ini.
+func NewGetSitemap(sitemapRepo repos.SitemapRepository, settings SitemapSettings) *GetSitemap {
+	return &GetSitemap{
+		sitemapRepository: sitemapRepo,
+		settings:          normalizeSettings(settings),
+	}
+}
+
+// Execute menyusun urlset untuk sitemap section atau satu halaman sitemap artikel.
+func (g *GetSitemap) Execute(ctx context.Context, input *dto.GetSitemapInput) (*dto.URLSetOutput, error) {
+	if input.Name == sectionsSitemapName {
+		return g.sections(ctx)
+	}
+
+	// 1. Validasi Input
+	page, err := strconv.Atoi(strings.TrimPrefix(input.Name, articleSitemapPrefix))
+	if !strings.HasPrefix(input.Name, articleSitemapPrefix) || err != nil || page < 1 {
+		return nil, shared.NewNotFoundError("Sitemap not found")
+	}
+
+	// 2. Ambil artikel pada halaman tersebut
+	articles, err := g.sitemapRepository.FindArticles(ctx, entities.MaxURLsPerSitemap, (page-1)*entities.MaxURLsPerSitemap)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if len(articles) == 0 {
+		return nil, shared.NewNotFoundError("Sitemap not found")
+	}
+
+	output := &dto.URLSetOutput{URLs: make([]dto.SitemapURLOutput, 0, len(articles))}
+	for _, article := range articles {
+		output.URLs = append(output.URLs, dto.SitemapURLOutput{
+			Loc:          g.settings.articleURL(article.Slug),
+			LastModified: article.UpdatedAt,
+		})
+		output.LastModified = latest(output.LastModified, article.UpdatedAt)
+	}
+
+	return output, nil
+}
+
+func (g *GetSitemap) sections(ctx context.Context) (*dto.URLSetOutput, error) {
+	sections, err := g.sitemapRepository.FindSections(ctx)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	output := &dto.URLSetOutput{URLs: make([]dto.SitemapURLOutput, 0, len(sections)+1)}
+	output.URLs = append(output.URLs, dto.SitemapURLOutput{Loc: g.settings.SiteURL + "/"})
+	for _, section := range sections {
+		output.URLs = append(output.URLs, dto.SitemapURLOutput{
+			Loc:          g.settings.sectionURL(section.Path),
+			LastModified: section.UpdatedAt,
+		})
+		output.LastModified = latest(output.LastModified, section.UpdatedAt)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"strconv"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// GetSitemapIndex adalah use case untuk sitemap index yang menunjuk ke
+// sitemap section, sitemap artikel per 50.000 URL, dan news sitemap.
+type GetSitemapIndex struct {
+	sitemapRepository repos.SitemapRepository
+	settings          SitemapSettings
+}
+
+// NewGetSitemapIndex adalah konstruktor untuk use case ini.
+func NewGetSitemapIndex(sitemapRepo repos.SitemapRepository, settings SitemapSettings) *GetSitemapIndex {
+	return &GetSitemapIndex{
+		sitemapRepository: sitemapRepo,
+		settings:          normalizeSettings(settings),
+	}
+}
+
+// Execute menyusun daftar file sitemap beserta waktu perubahan terakhirnya.
+func (g *GetSitemapIndex) Execute(ctx context.Context) (*dto.SitemapIndexOutput, error) {
+	// 1. Ambil section, halaman artikel dan isi news sitemap
+	sections, err := g.sitemapRepository.FindSections(ctx)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	pages, err := g.sitemapRepository.ArticlePages(ctx, entities.MaxURLsPerSitemap)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	news, err := findNewsArticles(ctx, g.sitemapRepository)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	// 2. Susun index
+	output := &dto.SitemapIndexOutput{Sitemaps: make([]dto.SitemapRefOutput, 0, len(pages)+2)}
+
+	sectionsRef := dto.SitemapRefOutput{Loc: g.settings.SiteURL + "/sitemaps/sections.xml"}
+	for _, section := range sections {
+		sectionsRef.LastModified = latest(sectionsRef.LastModified, section.UpdatedAt)
+	}
+	output.Sitemaps = append(output.Sitemaps, sectionsRef)
+
+	for _, page := range pages {
+		output.Sitemaps = append(output.Sitemaps, dto.SitemapRefOutput{
+			Loc:          g.settings.SiteURL + "/sitemaps/articles-" + strconv.Itoa(page.Number) + ".xml",
+			LastModified: page.LastModified,
+		})
+	}
+
+	// ✅ Sama dengan GetNewsSitemap: hanya artikel dalam NewsSitemapWindow
+	newsRef := dto.SitemapRefOutput{Loc: g.settings.SiteURL + "/news-sitemap.xml"}
+	for _, article := range news {
+		newsRef.LastModified = latest(newsRef.LastModified, article.UpdatedAt)
+	}
+	output.Sitemaps = append(output.Sitemaps, newsRef)
+
+	for _, ref := range output.Sitemaps {
+		output.LastModified = latest(output.LastModified, ref.LastModified)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,171 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/entities"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// --- Mock Implementations ---
+
+type MockSitemapRepository struct {
+	mock.Mock
+}
+
+func (m *MockSitemapRepository) ArticlePages(ctx context.Context, pageSize int) ([]*entities.ArticlePage, error) {
+	args := m.Called(ctx, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ArticlePage), args.Error(1)
+}
+
+func (m *MockSitemapRepository) FindArticles(ctx context.Context, limit, offset int) ([]*entities.ArticleEntry, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ArticleEntry), args.Error(1)
+}
+
+func (m *MockSitemapRepository) FindPublishedSince(ctx context.Context, since time.Time, limit int) ([]*entities.ArticleEntry, error) {
+	args := m.Called(ctx, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.ArticleEntry), args.Error(1)
+}
+
+func (m *MockSitemapRepository) FindSections(ctx context.Context) ([]*entities.SectionEntry, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.SectionEntry), args.Error(1)
+}
+
+var sitemapSettings = usecases.SitemapSettings{SiteURL: "https://kabar.example/", PublicationName: "Kabar"}
+
+// --- Test Suite ---
+
+func TestGetSitemapIndex(t *testing.T) {
+	t.Run("should list one sitemap per 50k articles", func(t *testing.T) {
+		sitemapRepoMock := new(MockSitemapRepository)
+		getSitemapIndexUsecase := usecases.NewGetSitemapIndex(sitemapRepoMock, sitemapSettings)
+
+		first := time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC)
+		second := first.Add(24 * time.Hour)
+		sitemapRepoMock.On("FindSections", mock.Anything).Return([]*entities.SectionEntry{{Path: "/berita/", UpdatedAt: first}}, nil).Once()
+		sitemapRepoMock.On("ArticlePages", mock.Anything, entities.MaxURLsPerSitemap).Return([]*entities.ArticlePage{
+			{Number: 1, LastModified: first},
+			{Number: 2, LastModified: second},
+		}, nil).Once()
+		sitemapRepoMock.On("FindPublishedSince", mock.Anything, mock.Anything, usecases.MaxNewsSitemapURLs).Return([]*entities.ArticleEntry{}, nil).Once()
+
+		output, err := getSitemapIndexUsecase.Execute(context.Background())
+
+		assert.Nil(t, err)
+		locs := make([]string, 0, len(output.Sitemaps))
+		for _, ref := range output.Sitemaps {
+			locs = append(locs, ref.Loc)
+		}
+		assert.Equal(t, []string{
+			"https://kabar.example/sitemaps/sections.xml",
+			"https://kabar.example/sitemaps/articles-1.xml",
+			"https://kabar.example/sitemaps/articles-2.xml",
+			"https://kabar.example/news-sitemap.xml",
+		}, locs)
+		assert.Equal(t, second, output.LastModified)
+		sitemapRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should take the news sitemap lastmod from the last 48 hours only", func(t *testing.T) {
+		sitemapRepoMock := new(MockSitemapRepository)
+		getSitemapIndexUsecase := usecases.NewGetSitemapIndex(sitemapRepoMock, sitemapSettings)
+
+		recent := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+		editedOld := time.Now().UTC().Truncate(time.Second)
+		withinWindow := mock.MatchedBy(func(since time.Time) bool {
+			age := time.Since(since)
+			return age >= usecases.NewsSitemapWindow && age < usecases.NewsSitemapWindow+time.Minute
+		})
+		sitemapRepoMock.On("FindSections", mock.Anything).Return([]*entities.SectionEntry{}, nil).Once()
+		// Artikel lama yang baru diedit menggeser halaman artikelnya, bukan news sitemap
+		sitemapRepoMock.On("ArticlePages", mock.Anything, entities.MaxURLsPerSitemap).Return([]*entities.ArticlePage{
+			{Number: 1, LastModified: editedOld},
+		}, nil).Once()
+		sitemapRepoMock.On("FindPublishedSince", mock.Anything, withinWindow, usecases.MaxNewsSitemapURLs).Return([]*entities.ArticleEntry{
+			{Slug: "pemilu-2029", PublishedAt: recent, UpdatedAt: recent},
+		}, nil).Once()
+
+		output, err := getSitemapIndexUsecase.Execute(context.Background())
+
+		assert.Nil(t, err)
+		newsRef := output.Sitemaps[len(output.Sitemaps)-1]
+		assert.Equal(t, "https://kabar.example/news-sitemap.xml", newsRef.Loc)
+		assert.Equal(t, recent, newsRef.LastModified)
+		assert.Equal(t, editedOld, output.LastModified)
+		sitemapRepoMock.AssertExpectations(t)
+	})
+}
+
+func TestGetSitemap(t *testing.T) {
+	t.Run("should page articles by 50k URLs", func(t *testing.T) {
+		sitemapRepoMock := new(MockSitemapRepository)
+		getSitemapUsecase := usecases.NewGetSitemap(sitemapRepoMock, sitemapSettings)
+
+		updatedAt := time.Date(2029, 2, 14, 8, 0, 0, 0, time.UTC)
+		sitemapRepoMock.On("FindArticles", mock.Anything, entities.MaxURLsPerSitemap, entities.MaxURLsPerSitemap).
+			Return([]*entities.ArticleEntry{{Slug: "pemilu-2029", UpdatedAt: updatedAt}}, nil).Once()
+
+		output, err := getSitemapUsecase.Execute(context.Background(), &dto.GetSitemapInput{Name: "articles-2"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []dto.SitemapURLOutput{{Loc: "https://kabar.example/articles/pemilu-2029", LastModified: updatedAt}}, output.URLs)
+		sitemapRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should return not found for an empty or unknown page", func(t *testing.T) {
+		sitemapRepoMock := new(MockSitemapRepository)
+		getSitemapUsecase := usecases.NewGetSitemap(sitemapRepoMock, sitemapSettings)
+
+		sitemapRepoMock.On("FindArticles", mock.Anything, entities.MaxURLsPerSitemap, 4*entities.MaxURLsPerSitemap).Return([]*entities.ArticleEntry{}, nil).Once()
+
+		for _, name := range []string{"articles-5", "articles-0", "articles-x", "tags"} {
+			_, err := getSitemapUsecase.Execute(context.Background(), &dto.GetSitemapInput{Name: name})
+			assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err), name)
+		}
+		sitemapRepoMock.AssertExpectations(t)
+	})
+}
+
+func TestGetNewsSitemap(t *testing.T) {
+	t.Run("should only ask for articles from the last 48 hours", func(t *testing.T) {
+		sitemapRepoMock := new(MockSitemapRepository)
+		getNewsSitemapUsecase := usecases.NewGetNewsSitemap(sitemapRepoMock, sitemapSettings)
+
+		publishedAt := time.Now().Add(-time.Hour)
+		withinWindow := mock.MatchedBy(func(since time.Time) bool {
+			age := time.Since(since)
+			return age >= usecases.NewsSitemapWindow && age < usecases.NewsSitemapWindow+time.Minute
+		})
+		sitemapRepoMock.On("FindPublishedSince", mock.Anything, withinWindow, usecases.MaxNewsSitemapURLs).Return([]*entities.ArticleEntry{
+			{Slug: "pemilu-2029", Title: "Pemilu 2029", Language: "id", PublishedAt: publishedAt, UpdatedAt: publishedAt},
+		}, nil).Once()
+
+		output, err := getNewsSitemapUsecase.Execute(context.Background())
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Kabar", output.PublicationName)
+		assert.Equal(t, []dto.NewsURLOutput{{Loc: "https://kabar.example/articles/pemilu-2029", Title: "Pemilu 2029", Language: "id", PublishedAt: publishedAt}}, output.URLs)
+		sitemapRepoMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"strings"
+	"time"
+)
+
+// SitemapSettings adalah identitas situs untuk sitemap. SiteURL dipakai
+// untuk membangun URL absolut; PublicationName untuk <news:publication>.
+type SitemapSettings struct {
+	SiteURL         string
+	PublicationName string
+}
+
+func normalizeSettings(settings SitemapSettings) SitemapSettings {
+	settings.SiteURL = strings.TrimSuffix(settings.SiteURL, "/")
+	return settings
+}
+
+func (s SitemapSettings) articleURL(slug string) string {
+	return s.SiteURL + "/articles/" + slug
+}
+
+func (s SitemapSettings) sectionURL(path string) string {
+	return s.SiteURL + "/section" + path
+}
+
+func latest(current, candidate time.Time) time.Time {
+	if candidate.After(current) {
+		return candidate
+	}
+	return current
+}
@@ -0,0 +1,28 @@
+package entities
+
+import "time"
+
+// MaxURLsPerSitemap adalah batas protokol sitemap untuk satu file urlset.
+const MaxURLsPerSitemap = 50000
+
+// ArticleEntry adalah artikel published yang dimuat di sitemap.
+type ArticleEntry struct {
+	Slug        string
+	Title       string
+	Language    string // kode ISO 639-1, mis. "id"
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+// SectionEntry adalah halaman section (kategori) yang dimuat di sitemap.
+type SectionEntry struct {
+	Path      string // materialized path, mis. "/berita/politik/"
+	UpdatedAt time.Time
+}
+
+// ArticlePage adalah satu file sitemap artikel berisi paling banyak
+// MaxURLsPerSitemap URL. Number dimulai dari 1.
+type ArticlePage struct {
+	Number       int
+	LastModified time.Time
+}
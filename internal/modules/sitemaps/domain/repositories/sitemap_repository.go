@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/entities"
+)
+
+// SitemapRepository membaca artikel published langsung dari tabel articles,
+// sehingga publish dan unpublish dari workflow langsung tercermin di sitemap.
+type SitemapRepository interface {
+	// ArticlePages membagi artikel published (urut dari yang terlama, supaya
+	// halaman lama stabil) menjadi halaman berukuran pageSize.
+	ArticlePages(ctx context.Context, pageSize int) ([]*entities.ArticlePage, error)
+	FindArticles(ctx context.Context, limit, offset int) ([]*entities.ArticleEntry, error)
+	// FindPublishedSince mengembalikan artikel terbaru untuk news sitemap.
+	FindPublishedSince(ctx context.Context, since time.Time, limit int) ([]*entities.ArticleEntry, error)
+	FindSections(ctx context.Context) ([]*entities.SectionEntry, error)
+}
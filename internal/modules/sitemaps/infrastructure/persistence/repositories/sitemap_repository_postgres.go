@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	articlevo "github.com/jokosaputro95/cms-news-api/internal/modules/articles/domain/value_objects"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type SitemapRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewSitemapRepositoryPostgres(db *sql.DB) repos.SitemapRepository {
+	return &SitemapRepositoryPostgres{db: db}
+}
+
+const articleEntryColumns = "slug, title, language::text, published_at, updated_at"
+
+func (r *SitemapRepositoryPostgres) ArticlePages(ctx context.Context, pageSize int) ([]*entities.ArticlePage, error) {
+	query := `
+		SELECT (seq - 1) / $1 + 1 AS page, MAX(updated_at)
+		FROM (
+			SELECT updated_at, row_number() OVER (ORDER BY published_at, id) AS seq
+			FROM articles
+			WHERE status = 'published' AND deleted_at IS NULL
+		) numbered
+		GROUP BY page
+		ORDER BY page
+	`
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pages []*entities.ArticlePage
+	for rows.Next() {
+		var page entities.ArticlePage
+		if err := rows.Scan(&page.Number, &page.LastModified); err != nil {
+			return nil, err
+		}
+		pages = append(pages, &page)
+	}
+
+	return pages, rows.Err()
+}
+
+func (r *SitemapRepositoryPostgres) FindArticles(ctx context.Context, limit, offset int) ([]*entities.ArticleEntry, error) {
+	query := "SELECT " + articleEntryColumns + " FROM articles WHERE status = 'published' AND deleted_at IS NULL ORDER BY published_at, id LIMIT $1 OFFSET $2"
+
+	return r.queryArticles(ctx, query, limit, offset)
+}
+
+func (r *SitemapRepositoryPostgres) FindPublishedSince(ctx context.Context, since time.Time, limit int) ([]*entities.ArticleEntry, error) {
+	query := "SELECT " + articleEntryColumns + " FROM articles WHERE status = 'published' AND deleted_at IS NULL AND published_at >= $1 ORDER BY published_at DESC LIMIT $2"
+
+	return r.queryArticles(ctx, query, since, limit)
+}
+
+func (r *SitemapRepositoryPostgres) FindSections(ctx context.Context) ([]*entities.SectionEntry, error) {
+	query := "SELECT path, updated_at FROM categories ORDER BY path"
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sections []*entities.SectionEntry
+	for rows.Next() {
+		var section entities.SectionEntry
+		if err := rows.Scan(&section.Path, &section.UpdatedAt); err != nil {
+			return nil, err
+		}
+		sections = append(sections, &section)
+	}
+
+	return sections, rows.Err()
+}
+
+func (r *SitemapRepositoryPostgres) queryArticles(ctx context.Context, query string, args ...interface{}) ([]*entities.ArticleEntry, error) {
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var articles []*entities.ArticleEntry
+	for rows.Next() {
+		var article entities.ArticleEntry
+		var searchConfig string
+		if err := rows.Scan(&article.Slug, &article.Title, &searchConfig, &article.PublishedAt, &article.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		// ✅ Kolom language menyimpan regconfig, sitemap butuh kode ISO 639-1
+		language, err := articlevo.LanguageFromSearchConfig(searchConfig)
+		if err != nil {
+			return nil, err
+		}
+		article.Language = language.String()
+
+		articles = append(articles, &article)
+	}
+
+	return articles, rows.Err()
+}
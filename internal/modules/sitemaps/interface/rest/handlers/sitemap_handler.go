@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/usecases"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type SitemapHandler struct {
+	getIndexUseCase   *usecases.GetSitemapIndex
+	getSitemapUseCase *usecases.GetSitemap
+	getNewsUseCase    *usecases.GetNewsSitemap
+}
+
+func NewSitemapHandler(
+	getIndexUseCase *usecases.GetSitemapIndex,
+	getSitemapUseCase *usecases.GetSitemap,
+	getNewsUseCase *usecases.GetNewsSitemap) *SitemapHandler {
+	return &SitemapHandler{
+		getIndexUseCase:   getIndexUseCase,
+		getSitemapUseCase: getSitemapUseCase,
+		getNewsUseCase:    getNewsUseCase,
+	}
+}
+
+func (h *SitemapHandler) Index(w http.ResponseWriter, r *http.Request) {
+	result, err := h.getIndexUseCase.Execute(r.Context())
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	body, err := EncodeSitemapIndex(result)
+	h.write(w, r, body, result.LastModified, err)
+}
+
+func (h *SitemapHandler) Sitemap(w http.ResponseWriter, r *http.Request) {
+	name, found := strings.CutSuffix(r.PathValue("file"), ".xml")
+	if !found {
+		shared.WriteErrorResponse(w, "NOT_FOUND", "Sitemap not found", http.StatusNotFound)
+		return
+	}
+
+	result, err := h.getSitemapUseCase.Execute(r.Context(), &dto.GetSitemapInput{Name: name})
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	body, err := EncodeURLSet(result)
+	h.write(w, r, body, result.LastModified, err)
+}
+
+func (h *SitemapHandler) News(w http.ResponseWriter, r *http.Request) {
+	result, err := h.getNewsUseCase.Execute(r.Context())
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	body, err := EncodeNewsSitemap(result)
+	h.write(w, r, body, result.LastModified, err)
+}
+
+// write menyajikan dokumen sitemap dengan ETag dari isinya, sehingga crawler
+// mendapat 304 selama tidak ada artikel yang dipublish atau di-unpublish.
+func (h *SitemapHandler) write(w http.ResponseWriter, r *http.Request, body []byte, lastModified time.Time, err error) {
+	if err != nil {
+		shared.WriteErrorResponse(w, "INTERNAL_ERROR", "Failed to render sitemap", http.StatusInternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if shared.CheckNotModified(w, r, `"`+hex.EncodeToString(sum[:16])+`"`, lastModified) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		w.Write(body)
+	}
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/dto"
+)
+
+const (
+	sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	newsNS    = "http://www.google.com/schemas/sitemap-news/0.9"
+)
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	XMLNS    string       `xml:"xmlns,attr"`
+	Sitemaps []sitemapRef `xml:"sitemap"`
+}
+
+type sitemapRef struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	NewsNS  string       `xml:"xmlns:news,attr,omitempty"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string    `xml:"loc"`
+	LastMod string    `xml:"lastmod,omitempty"`
+	News    *newsNews `xml:"news:news"`
+}
+
+type newsNews struct {
+	Publication     newsPublication `xml:"news:publication"`
+	PublicationDate string          `xml:"news:publication_date"`
+	Title           string          `xml:"news:title"`
+}
+
+type newsPublication struct {
+	Name     string `xml:"news:name"`
+	Language string `xml:"news:language"`
+}
+
+// EncodeSitemapIndex merender sitemap index.
+func EncodeSitemapIndex(index *dto.SitemapIndexOutput) ([]byte, error) {
+	doc := sitemapIndex{XMLNS: sitemapNS, Sitemaps: make([]sitemapRef, 0, len(index.Sitemaps))}
+	for _, ref := range index.Sitemaps {
+		doc.Sitemaps = append(doc.Sitemaps, sitemapRef{Loc: ref.Loc, LastMod: w3cDatetime(ref.LastModified)})
+	}
+	return marshalXML(doc)
+}
+
+// EncodeURLSet merender satu file urlset.
+func EncodeURLSet(set *dto.URLSetOutput) ([]byte, error) {
+	doc := urlSet{XMLNS: sitemapNS, URLs: make([]sitemapURL, 0, len(set.URLs))}
+	for _, url := range set.URLs {
+		doc.URLs = append(doc.URLs, sitemapURL{Loc: url.Loc, LastMod: w3cDatetime(url.LastModified)})
+	}
+	return marshalXML(doc)
+}
+
+// EncodeNewsSitemap merender news sitemap dengan metadata <news:publication>.
+func EncodeNewsSitemap(news *dto.NewsSitemapOutput) ([]byte, error) {
+	doc := urlSet{XMLNS: sitemapNS, NewsNS: newsNS, URLs: make([]sitemapURL, 0, len(news.URLs))}
+	for _, url := range news.URLs {
+		doc.URLs = append(doc.URLs, sitemapURL{
+			Loc: url.Loc,
+			News: &newsNews{
+				Publication:     newsPublication{Name: news.PublicationName, Language: url.Language},
+				PublicationDate: w3cDatetime(url.PublishedAt),
+				Title:           url.Title,
+			},
+		})
+	}
+	return marshalXML(doc)
+}
+
+// w3cDatetime memformat waktu untuk <lastmod>; waktu kosong dihilangkan.
+func w3cDatetime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func marshalXML(doc interface{}) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,76 @@
+package handlers_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/application/dto"
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/interface/rest/handlers"
+)
+
+func TestEncodeSitemapIndex(t *testing.T) {
+	body, err := handlers.EncodeSitemapIndex(&dto.SitemapIndexOutput{Sitemaps: []dto.SitemapRefOutput{
+		{Loc: "https://kabar.example/sitemaps/articles-1.xml", LastModified: time.Date(2029, 2, 14, 8, 0, 0, 0, time.UTC)},
+		{Loc: "https://kabar.example/sitemaps/sections.xml"},
+	}})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	xmlBody := string(body)
+	if !strings.Contains(xmlBody, `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`) {
+		t.Errorf("Expected sitemap index namespace, but got %s", xmlBody)
+	}
+	if !strings.Contains(xmlBody, "<lastmod>2029-02-14T08:00:00Z</lastmod>") {
+		t.Errorf("Expected W3C datetime lastmod, but got %s", xmlBody)
+	}
+	if strings.Count(xmlBody, "<lastmod>") != 1 {
+		t.Errorf("Expected empty lastmod to be omitted, but got %s", xmlBody)
+	}
+}
+
+func TestEncodeNewsSitemap(t *testing.T) {
+	body, err := handlers.EncodeNewsSitemap(&dto.NewsSitemapOutput{
+		PublicationName: "Kabar",
+		URLs: []dto.NewsURLOutput{{
+			Loc:         "https://kabar.example/articles/pemilu-2029",
+			Title:       "Pemilu & Pilkada",
+			Language:    "id",
+			PublishedAt: time.Date(2029, 2, 14, 8, 0, 0, 0, time.FixedZone("WIB", 7*3600)),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	var doc struct {
+		URLs []struct {
+			Loc  string `xml:"loc"`
+			News struct {
+				Name     string `xml:"publication>name"`
+				Language string `xml:"publication>language"`
+				Date     string `xml:"publication_date"`
+				Title    string `xml:"title"`
+			} `xml:"news"`
+		} `xml:"url"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("Expected valid XML, but got %v", err)
+	}
+	if !strings.Contains(string(body), `xmlns:news="http://www.google.com/schemas/sitemap-news/0.9"`) {
+		t.Errorf("Expected news namespace, but got %s", body)
+	}
+	if len(doc.URLs) != 1 {
+		t.Fatalf("Expected 1 url, but got %d", len(doc.URLs))
+	}
+
+	news := doc.URLs[0].News
+	if news.Name != "Kabar" || news.Language != "id" || news.Title != "Pemilu & Pilkada" {
+		t.Errorf("Unexpected news metadata: %+v", news)
+	}
+	if news.Date != "2029-02-14T01:00:00Z" {
+		t.Errorf("Expected publication date in UTC, but got '%s'", news.Date)
+	}
+}
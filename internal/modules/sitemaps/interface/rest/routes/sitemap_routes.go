@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"net/http"
+
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/sitemaps/interface/rest/handlers"
+)
+
+func SetupSitemapRoutes(mux *http.ServeMux, sitemapHandler *handlers.SitemapHandler) {
+	// Public endpoints
+	mux.HandleFunc("GET /sitemap.xml", sitemapHandler.Index)
+	mux.HandleFunc("GET /news-sitemap.xml", sitemapHandler.News)
+	// sections.xml dan articles-{n}.xml, masing-masing paling banyak 50.000 URL
+	mux.HandleFunc("GET /sitemaps/{file}", sitemapHandler.Sitemap)
+}
@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+type AutocompleteTagsInput struct {
+	Query string
+	Limit int
+}
+
+// GetTagInput: Slug boleh berupa alias dari tag yang sudah di-merge.
+type GetTagInput struct {
+	Slug string
+}
+
+// MergeTagsInput menggabungkan tag SourceID ke TargetID.
+type MergeTagsInput struct {
+	SourceID string `json:"-"`
+	TargetID string `json:"target_id" validate:"required"`
+}
+
+type TagOutput struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Slug       string    `json:"slug"`
+	UsageCount int       `json:"usage_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type MergeTagsOutput struct {
+	Target        *TagOutput `json:"target"`
+	MergedSlug    string     `json:"merged_slug"`
+	ArticlesMoved int        `json:"articles_moved"`
+}
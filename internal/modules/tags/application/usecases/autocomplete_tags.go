@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/tags/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+const (
+	defaultAutocompleteLimit = 10
+	maxAutocompleteLimit     = 25
+)
+
+// AutocompleteTags adalah use case untuk saran tag saat reporter mengetik.
+// Tag yang paling banyak dipakai muncul lebih dulu supaya ejaan baku yang
+// dipilih, bukan membuat duplikat baru.
+type AutocompleteTags struct {
+	tagRepository repos.TagRepository
+}
+
+// NewAutocompleteTags adalah konstruktor untuk use case ini.
+func NewAutocompleteTags(tagRepo repos.TagRepository) *AutocompleteTags {
+	return &AutocompleteTags{
+		tagRepository: tagRepo,
+	}
+}
+
+// Execute mencari tag berdasarkan prefix slug.
+func (a *AutocompleteTags) Execute(ctx context.Context, input *dto.AutocompleteTagsInput) ([]*dto.TagOutput, error) {
+	// 1. Normalisasi prefix dengan aturan yang sama seperti TagSlug
+	prefix := vo.NormalizeTagSlug(input.Query)
+	if prefix == "" {
+		return nil, shared.NewValidationError("query is required")
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultAutocompleteLimit
+	}
+	limit = min(limit, maxAutocompleteLimit)
+
+	// 2. Mencari tag
+	tags, err := a.tagRepository.Autocomplete(ctx, prefix, limit)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	outputs := make([]*dto.TagOutput, 0, len(tags))
+	for _, tag := range tags {
+		outputs = append(outputs, toTagOutput(tag))
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/tags/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// GetTag adalah use case untuk mengambil satu tag berdasarkan slug.
+type GetTag struct {
+	tagRepository repos.TagRepository
+}
+
+// NewGetTag adalah konstruktor untuk use case ini.
+func NewGetTag(tagRepo repos.TagRepository) *GetTag {
+	return &GetTag{
+		tagRepository: tagRepo,
+	}
+}
+
+// Execute mengembalikan tag; slug lama hasil merge mengembalikan tag tujuannya.
+func (g *GetTag) Execute(ctx context.Context, input *dto.GetTagInput) (*dto.TagOutput, error) {
+	slug := vo.NormalizeTagSlug(input.Slug)
+
+	tag, err := g.tagRepository.FindBySlug(ctx, slug)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if tag == nil {
+		return nil, shared.NewNotFoundError("Tag not found")
+	}
+
+	return toTagOutput(tag), nil
+}
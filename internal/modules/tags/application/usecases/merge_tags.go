@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/tags/application/dto"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/repositories"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// MergeTags adalah use case admin untuk menggabungkan tag duplikat,
+// mis. "covid19" ke "covid-19".
+type MergeTags struct {
+	tagRepository repos.TagRepository
+}
+
+// NewMergeTags adalah konstruktor untuk use case ini.
+func NewMergeTags(tagRepo repos.TagRepository) *MergeTags {
+	return &MergeTags{
+		tagRepository: tagRepo,
+	}
+}
+
+// Execute memindahkan seluruh artikel dari tag source ke target lalu menghapus source.
+func (m *MergeTags) Execute(ctx context.Context, input *dto.MergeTagsInput) (*dto.MergeTagsOutput, error) {
+	// 1. Mencari kedua tag
+	source, err := m.tagRepository.FindByID(ctx, input.SourceID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if source == nil {
+		return nil, shared.NewNotFoundError("Tag not found")
+	}
+
+	target, err := m.tagRepository.FindByID(ctx, input.TargetID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if target == nil {
+		return nil, shared.NewNotFoundError("Target tag not found")
+	}
+
+	// 2. Validasi merge
+	if err := source.CanMergeInto(target); err != nil {
+		return nil, shared.NewValidationError(err.Error())
+	}
+
+	// 3. Menulis ulang seluruh link dalam satu transaksi
+	moved, err := m.tagRepository.Merge(ctx, source, target)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+
+	// 4. Membaca ulang target untuk usage count terbaru
+	merged, err := m.tagRepository.FindByID(ctx, target.ID)
+	if err != nil {
+		return nil, shared.NewDatabaseError(err)
+	}
+	if merged == nil {
+		return nil, shared.NewNotFoundError("Target tag not found")
+	}
+
+	return &dto.MergeTagsOutput{
+		Target:        toTagOutput(merged),
+		MergedSlug:    source.Slug.String(),
+		ArticlesMoved: moved,
+	}, nil
+}
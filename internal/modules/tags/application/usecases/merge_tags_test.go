@@ -0,0 +1,144 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/tags/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/tags/application/usecases"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/entities"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+// --- Mock Implementations ---
+
+type MockTagRepository struct {
+	mock.Mock
+}
+
+func (m *MockTagRepository) FindByID(ctx context.Context, id string) (*entities.Tag, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) FindBySlug(ctx context.Context, slug string) (*entities.Tag, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) Autocomplete(ctx context.Context, prefix string, limit int) ([]*entities.Tag, error) {
+	args := m.Called(ctx, prefix, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) Merge(ctx context.Context, source, target *entities.Tag) (int, error) {
+	args := m.Called(ctx, source, target)
+	return args.Int(0), args.Error(1)
+}
+
+// --- Helpers ---
+
+func newStoredTag(t *testing.T, id, name string, usage int) *entities.Tag {
+	t.Helper()
+	nameVO, err := vo.NewTagName(name)
+	if err != nil {
+		t.Fatalf("Error creating tag name: %v", err)
+	}
+	slugVO, err := vo.NewTagSlug(name)
+	if err != nil {
+		t.Fatalf("Error creating tag slug: %v", err)
+	}
+	tag := entities.NewTag(id, *nameVO, *slugVO)
+	tag.UsageCount = usage
+	return tag
+}
+
+// --- Test Suite ---
+
+func TestMergeTags(t *testing.T) {
+	t.Run("should merge the source tag into the target", func(t *testing.T) {
+		tagRepoMock := new(MockTagRepository)
+		mergeTagsUsecase := usecases.NewMergeTags(tagRepoMock)
+
+		source := newStoredTag(t, "covid19-uuid", "Covid19", 3)
+		target := newStoredTag(t, "covid-uuid", "COVID-19", 40)
+		merged := newStoredTag(t, "covid-uuid", "COVID-19", 42)
+
+		tagRepoMock.On("FindByID", mock.Anything, "covid19-uuid").Return(source, nil).Once()
+		tagRepoMock.On("FindByID", mock.Anything, "covid-uuid").Return(target, nil).Once()
+		tagRepoMock.On("Merge", mock.Anything, source, target).Return(3, nil).Once()
+		tagRepoMock.On("FindByID", mock.Anything, "covid-uuid").Return(merged, nil).Once()
+
+		output, err := mergeTagsUsecase.Execute(context.Background(), &dto.MergeTagsInput{SourceID: "covid19-uuid", TargetID: "covid-uuid"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "covid19", output.MergedSlug)
+		assert.Equal(t, 3, output.ArticlesMoved)
+		assert.Equal(t, 42, output.Target.UsageCount)
+		tagRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("should refuse to merge a tag into itself", func(t *testing.T) {
+		tagRepoMock := new(MockTagRepository)
+		mergeTagsUsecase := usecases.NewMergeTags(tagRepoMock)
+
+		tag := newStoredTag(t, "covid-uuid", "COVID-19", 40)
+		tagRepoMock.On("FindByID", mock.Anything, "covid-uuid").Return(tag, nil).Twice()
+
+		_, err := mergeTagsUsecase.Execute(context.Background(), &dto.MergeTagsInput{SourceID: "covid-uuid", TargetID: "covid-uuid"})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+		tagRepoMock.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return not found for an unknown target", func(t *testing.T) {
+		tagRepoMock := new(MockTagRepository)
+		mergeTagsUsecase := usecases.NewMergeTags(tagRepoMock)
+
+		tagRepoMock.On("FindByID", mock.Anything, "covid19-uuid").Return(newStoredTag(t, "covid19-uuid", "Covid19", 3), nil).Once()
+		tagRepoMock.On("FindByID", mock.Anything, "missing").Return(nil, nil).Once()
+
+		_, err := mergeTagsUsecase.Execute(context.Background(), &dto.MergeTagsInput{SourceID: "covid19-uuid", TargetID: "missing"})
+
+		assert.Equal(t, "NOT_FOUND", shared.GetErrorCode(err))
+	})
+}
+
+func TestAutocompleteTags(t *testing.T) {
+	t.Run("should normalize the prefix and cap the limit", func(t *testing.T) {
+		tagRepoMock := new(MockTagRepository)
+		autocompleteTagsUsecase := usecases.NewAutocompleteTags(tagRepoMock)
+
+		tags := []*entities.Tag{newStoredTag(t, "covid-uuid", "COVID-19", 42), newStoredTag(t, "cov-uuid", "Covax", 2)}
+		tagRepoMock.On("Autocomplete", mock.Anything, "covid-1", 25).Return(tags, nil).Once()
+
+		output, err := autocompleteTagsUsecase.Execute(context.Background(), &dto.AutocompleteTagsInput{Query: "COVID 1", Limit: 500})
+
+		assert.Nil(t, err)
+		if assert.Len(t, output, 2) {
+			assert.Equal(t, "covid-19", output[0].Slug)
+			assert.Equal(t, 42, output[0].UsageCount)
+		}
+	})
+
+	t.Run("should require a query", func(t *testing.T) {
+		autocompleteTagsUsecase := usecases.NewAutocompleteTags(new(MockTagRepository))
+
+		_, err := autocompleteTagsUsecase.Execute(context.Background(), &dto.AutocompleteTagsInput{Query: " - "})
+
+		assert.Equal(t, "VALIDATION_ERROR", shared.GetErrorCode(err))
+	})
+}
@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/tags/application/dto"
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/entities"
+)
+
+func toTagOutput(tag *entities.Tag) *dto.TagOutput {
+	return &dto.TagOutput{
+		ID:         tag.ID,
+		Name:       tag.Name.String(),
+		Slug:       tag.Slug.String(),
+		UsageCount: tag.UsageCount,
+		CreatedAt:  tag.CreatedAt,
+	}
+}
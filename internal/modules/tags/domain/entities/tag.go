@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+)
+
+var ErrTagMergeIntoItself = errors.New("tag cannot be merged into itself")
+
+// Tag adalah label bebas yang ditempel ke artikel (many-to-many).
+// UsageCount adalah jumlah artikel yang memakai tag ini; hanya diisi oleh
+// query baca dan tidak ikut disimpan.
+type Tag struct {
+	ID         string
+	Name       vo.TagName
+	Slug       vo.TagSlug
+	UsageCount int
+	CreatedAt  time.Time
+}
+
+func NewTag(id string, name vo.TagName, slug vo.TagSlug) *Tag {
+	return &Tag{
+		ID:        id,
+		Name:      name,
+		Slug:      slug,
+		CreatedAt: time.Now(),
+	}
+}
+
+// CanMergeInto memvalidasi penggabungan tag ini ke target. Setelah merge,
+// slug tag ini menjadi alias dari target sehingga ejaan lama tidak membuat
+// duplikat baru.
+func (t *Tag) CanMergeInto(target *Tag) error {
+	if target.ID == t.ID || target.Slug.String() == t.Slug.String() {
+		return ErrTagMergeIntoItself
+	}
+	return nil
+}
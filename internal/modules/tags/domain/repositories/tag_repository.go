@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/entities"
+)
+
+type TagRepository interface {
+	FindByID(ctx context.Context, id string) (*entities.Tag, error)
+	// FindBySlug juga mencari di alias tag hasil merge, sehingga slug lama
+	// mengembalikan tag tujuannya.
+	FindBySlug(ctx context.Context, slug string) (*entities.Tag, error)
+	// Autocomplete mengembalikan maksimal limit tag yang slug-nya diawali
+	// prefix, urut dari yang paling banyak dipakai.
+	Autocomplete(ctx context.Context, prefix string, limit int) ([]*entities.Tag, error)
+
+	// Merge memindahkan seluruh link artikel dari source ke target, mencatat
+	// slug source sebagai alias target, lalu menghapus source dalam satu
+	// transaksi. Mengembalikan jumlah artikel yang dipindahkan.
+	Merge(ctx context.Context, source, target *entities.Tag) (int, error)
+}
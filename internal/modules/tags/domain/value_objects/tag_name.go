@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// TagName adalah nama tampilan tag, mis. "COVID-19". Ejaan pertama yang
+// dipakai reporter disimpan apa adanya; pencocokan selalu lewat TagSlug.
+type TagName struct {
+	value string
+}
+
+const MaxTagNameLength = 50
+
+var (
+	ErrTagNameEmpty   = errors.New("tag name cannot be empty")
+	ErrTagNameTooLong = errors.New("tag name must be at most 50 characters")
+)
+
+func NewTagName(value string) (*TagName, error) {
+	payload := strings.Join(strings.Fields(value), " ")
+
+	if payload == "" {
+		return nil, ErrTagNameEmpty
+	}
+
+	if utf8.RuneCountInString(payload) > MaxTagNameLength {
+		return nil, ErrTagNameTooLong
+	}
+
+	return &TagName{value: payload}, nil
+}
+
+func (n *TagName) String() string {
+	return n.value
+}
+
+func (n *TagName) Value() string {
+	return n.value
+}
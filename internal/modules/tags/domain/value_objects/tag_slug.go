@@ -0,0 +1,66 @@
+package valueobjects
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// TagSlug adalah bentuk baku nama tag. Penulisan yang berbeda seperti
+// "Pemilu 2029" dan "pemilu_2029" menghasilkan slug yang sama.
+type TagSlug struct {
+	value string
+}
+
+const (
+	MinTagSlugLength = 2
+	MaxTagSlugLength = 50
+)
+
+// ^[a-z0-9]+ - Dimulai dengan huruf kecil atau angka.
+// (-[a-z0-9]+)*$ - Dipisah tanda hubung tunggal, tidak boleh diakhiri tanda hubung.
+var tagSlugRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// tagSlugSeparators adalah karakter yang dianggap pemisah kata saat normalisasi.
+var tagSlugSeparators = regexp.MustCompile(`[\s_\-./]+`)
+
+var (
+	ErrTagSlugEmpty             = errors.New("tag cannot be empty")
+	ErrTagSlugInvalidLength     = errors.New("tag must be between 2 and 50 characters")
+	ErrTagSlugInvalidCharacters = errors.New("tag can only contain letters, numbers, spaces, and hyphens")
+)
+
+// NewTagSlug menormalisasi nama tag bebas menjadi slug lalu memvalidasinya.
+func NewTagSlug(value string) (*TagSlug, error) {
+	payload := NormalizeTagSlug(value)
+
+	if payload == "" {
+		return nil, ErrTagSlugEmpty
+	}
+
+	if len(payload) < MinTagSlugLength || len(payload) > MaxTagSlugLength {
+		return nil, ErrTagSlugInvalidLength
+	}
+
+	if !tagSlugRegex.MatchString(payload) {
+		return nil, ErrTagSlugInvalidCharacters
+	}
+
+	return &TagSlug{value: payload}, nil
+}
+
+// NormalizeTagSlug menerapkan aturan normalisasi tanpa validasi panjang,
+// dipakai juga untuk prefix autocomplete yang boleh baru satu huruf.
+func NormalizeTagSlug(value string) string {
+	payload := strings.ToLower(strings.TrimSpace(value))
+	payload = tagSlugSeparators.ReplaceAllString(payload, "-")
+	return strings.Trim(payload, "-")
+}
+
+func (s *TagSlug) String() string {
+	return s.value
+}
+
+func (s *TagSlug) Value() string {
+	return s.value
+}
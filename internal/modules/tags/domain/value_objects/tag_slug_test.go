@@ -0,0 +1,53 @@
+package valueobjects_test
+
+import (
+	"errors"
+	"testing"
+
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+)
+
+func TestNewTagSlug(t *testing.T) {
+	validCases := []struct {
+		input    string
+		expected string
+	}{
+		{"covid", "covid"},
+		{"COVID-19", "covid-19"},
+		{"Covid19", "covid19"},
+		{"  Pemilu   2029 ", "pemilu-2029"},
+		{"pilkada_dki.2029", "pilkada-dki-2029"},
+		{"covid -- 19", "covid-19"},
+	}
+
+	for _, tc := range validCases {
+		t.Run("normalize "+tc.input, func(t *testing.T) {
+			slug, err := vo.NewTagSlug(tc.input)
+			if err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			if slug.String() != tc.expected {
+				t.Errorf("Expected slug '%s', but got '%s'", tc.expected, slug.String())
+			}
+		})
+	}
+
+	invalidCases := []struct {
+		input string
+		err   error
+	}{
+		{"   ", vo.ErrTagSlugEmpty},
+		{"-_-", vo.ErrTagSlugEmpty},
+		{"a", vo.ErrTagSlugInvalidLength},
+		{"c#vid", vo.ErrTagSlugInvalidCharacters},
+	}
+
+	for _, tc := range invalidCases {
+		t.Run("reject "+tc.input, func(t *testing.T) {
+			_, err := vo.NewTagSlug(tc.input)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("Expected error %v, but got %v", tc.err, err)
+			}
+		})
+	}
+}
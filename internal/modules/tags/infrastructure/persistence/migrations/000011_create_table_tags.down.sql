@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_article_tags_tag_id;
+DROP TABLE IF EXISTS article_tags;
+DROP INDEX IF EXISTS idx_tag_aliases_tag_id;
+DROP TABLE IF EXISTS tag_aliases;
+DROP INDEX IF EXISTS idx_tags_slug_prefix;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS tags (
+    id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    slug VARCHAR(50) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- text_pattern_ops supaya prefix search (slug LIKE 'cov%') bisa memakai index
+CREATE INDEX IF NOT EXISTS idx_tags_slug_prefix ON tags (slug text_pattern_ops);
+
+-- Slug lama dari tag yang sudah di-merge, diarahkan ke tag tujuannya
+CREATE TABLE IF NOT EXISTS tag_aliases (
+    slug VARCHAR(50) PRIMARY KEY,
+    tag_id VARCHAR(255) NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_tag_aliases_tag_id ON tag_aliases (tag_id);
+
+CREATE TABLE IF NOT EXISTS article_tags (
+    article_id VARCHAR(255) NOT NULL REFERENCES articles(id) ON DELETE CASCADE,
+    tag_id VARCHAR(255) NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (article_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_tags_tag_id ON article_tags (tag_id);
@@ -0,0 +1,178 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	entities "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/entities"
+	repos "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/repositories"
+	vo "github.com/jokosaputro95/cms-news-api/internal/modules/tags/domain/value_objects"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type TagRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewTagRepositoryPostgres(db *sql.DB) repos.TagRepository {
+	return &TagRepositoryPostgres{db: db}
+}
+
+const tagColumns = "t.id, t.name, t.slug, t.created_at, (SELECT COUNT(*) FROM article_tags at JOIN articles a ON a.id = at.article_id WHERE at.tag_id = t.id AND a.deleted_at IS NULL)"
+
+// likeEscaper meng-escape wildcard LIKE pada input pengguna.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// rowScanner dipenuhi oleh *sql.Row dan *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (r *TagRepositoryPostgres) FindByID(ctx context.Context, id string) (*entities.Tag, error) {
+	query := "SELECT " + tagColumns + " FROM tags t WHERE t.id = $1"
+
+	tag, err := scanTag(shared.Executor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil // Tag tidak ditemukan
+	}
+	return tag, err
+}
+
+func (r *TagRepositoryPostgres) FindBySlug(ctx context.Context, slug string) (*entities.Tag, error) {
+	query := "SELECT " + tagColumns + ` FROM tags t
+		WHERE t.slug = $1
+		   OR t.id = (SELECT tag_id FROM tag_aliases WHERE slug = $1)`
+
+	tag, err := scanTag(shared.Executor(ctx, r.db).QueryRowContext(ctx, query, slug))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return tag, err
+}
+
+func (r *TagRepositoryPostgres) Autocomplete(ctx context.Context, prefix string, limit int) ([]*entities.Tag, error) {
+	// ✅ Alias ikut dicocokkan supaya ejaan lama mengarahkan reporter ke tag baku
+	query := "SELECT " + tagColumns + ` FROM tags t
+		WHERE t.slug LIKE $1 || '%' ESCAPE '\'
+		   OR EXISTS (SELECT 1 FROM tag_aliases a WHERE a.tag_id = t.id AND a.slug LIKE $1 || '%' ESCAPE '\')
+		ORDER BY 5 DESC, t.slug
+		LIMIT $2`
+
+	rows, err := shared.Executor(ctx, r.db).QueryContext(ctx, query, likeEscaper.Replace(prefix), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []*entities.Tag
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (r *TagRepositoryPostgres) Merge(ctx context.Context, source, target *entities.Tag) (int, error) {
+	tx, err := shared.BeginTx(ctx, r.db)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// ✅ Kunci kedua tag supaya tidak ada link baru ke source selama merge
+	var locked int
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM (SELECT id FROM tags WHERE id IN ($1, $2) ORDER BY id FOR UPDATE) locked",
+		source.ID,
+		target.ID,
+	).Scan(&locked)
+	if err != nil {
+		return 0, err
+	}
+	if locked != 2 {
+		return 0, sql.ErrNoRows
+	}
+
+	// Artikel yang sudah punya kedua tag cukup dilepas dari source
+	deleted, err := execAffected(ctx, tx, `
+		DELETE FROM article_tags s
+		USING article_tags t
+		WHERE s.tag_id = $1 AND t.tag_id = $2 AND s.article_id = t.article_id`,
+		source.ID, target.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	moved, err := execAffected(ctx, tx, "UPDATE article_tags SET tag_id = $2 WHERE tag_id = $1", source.ID, target.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Alias milik source ikut pindah, lalu slug source sendiri menjadi alias
+	if _, err := tx.ExecContext(ctx, "UPDATE tag_aliases SET tag_id = $2 WHERE tag_id = $1", source.ID, target.ID); err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO tag_aliases (slug, tag_id) VALUES ($1, $2) ON CONFLICT (slug) DO UPDATE SET tag_id = EXCLUDED.tag_id",
+		source.Slug.String(),
+		target.ID,
+	); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM tags WHERE id = $1", source.ID); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int(deleted + moved), nil
+}
+
+func execAffected(ctx context.Context, tx shared.DBTX, query string, args ...interface{}) (int64, error) {
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// scanTag membaca satu baris tagColumns dan membuat ulang value object-nya.
+func scanTag(row rowScanner) (*entities.Tag, error) {
+	var tag entities.Tag
+	var name, slug string
+
+	err := row.Scan(&tag.ID, &name, &slug, &tag.CreatedAt, &tag.UsageCount)
+	if err != nil {
+		return nil, err
+	}
+
+	// ✅ Recreate value objects dari data yang diambil
+	nameVO, err := vo.NewTagName(name)
+	if err != nil {
+		return nil, err
+	}
+	tag.Name = *nameVO
+
+	slugVO, err := vo.NewTagSlug(slug)
+	if err != nil {
+		return nil, err
+	}
+	tag.Slug = *slugVO
+
+	return &tag, nil
+}
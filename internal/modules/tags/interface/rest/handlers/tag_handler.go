@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	dto "github.com/jokosaputro95/cms-news-api/internal/modules/tags/application/dto"
+	usecases "github.com/jokosaputro95/cms-news-api/internal/modules/tags/application/usecases"
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type TagHandler struct {
+	autocompleteUseCase *usecases.AutocompleteTags
+	getUseCase          *usecases.GetTag
+	mergeUseCase        *usecases.MergeTags
+}
+
+func NewTagHandler(
+	autocompleteUseCase *usecases.AutocompleteTags,
+	getUseCase *usecases.GetTag,
+	mergeUseCase *usecases.MergeTags) *TagHandler {
+	return &TagHandler{
+		autocompleteUseCase: autocompleteUseCase,
+		getUseCase:          getUseCase,
+		mergeUseCase:        mergeUseCase,
+	}
+}
+
+func (h *TagHandler) Autocomplete(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	input := dto.AutocompleteTagsInput{Query: query.Get("q"), Limit: limit}
+
+	result, err := h.autocompleteUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Tags retrieved successfully", http.StatusOK)
+}
+
+func (h *TagHandler) Get(w http.ResponseWriter, r *http.Request) {
+	result, err := h.getUseCase.Execute(r.Context(), &dto.GetTagInput{Slug: r.PathValue("slug")})
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Tag retrieved successfully", http.StatusOK)
+}
+
+func (h *TagHandler) Merge(w http.ResponseWriter, r *http.Request) {
+	var input dto.MergeTagsInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		shared.WriteErrorResponse(w, "INVALID_JSON", "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	input.SourceID = r.PathValue("id")
+
+	// Basic validation
+	if strings.TrimSpace(input.TargetID) == "" {
+		shared.WriteErrorResponse(w, "VALIDATION_ERROR", "Target tag is required", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.mergeUseCase.Execute(r.Context(), &input)
+	if err != nil {
+		shared.WriteError(w, err)
+		return
+	}
+
+	shared.WriteSuccessResponse(w, result, "Tags merged successfully", http.StatusOK)
+}
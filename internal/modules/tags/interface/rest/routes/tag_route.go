@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"net/http"
+
+	authvo "github.com/jokosaputro95/cms-news-api/internal/modules/auth/domain/value_objects"
+	middleware "github.com/jokosaputro95/cms-news-api/internal/modules/auth/interface/rest/middleware"
+	handlers "github.com/jokosaputro95/cms-news-api/internal/modules/tags/interface/rest/handlers"
+)
+
+func SetupTagRoutes(mux *http.ServeMux, tagHandler *handlers.TagHandler, jwtMiddleware *middleware.JWTMiddleware) {
+	// Public endpoints (halaman tag)
+	mux.HandleFunc("GET /api/v1/tags/{slug}", tagHandler.Get)
+
+	// Protected endpoints
+	mux.Handle("GET /api/v1/tags/autocomplete", jwtMiddleware.Protect(string(authvo.PermissionTagManage), tagHandler.Autocomplete))
+	mux.Handle("POST /api/v1/tags/{id}/merge", jwtMiddleware.Protect(string(authvo.PermissionTagMerge), tagHandler.Merge))
+}
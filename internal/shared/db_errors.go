@@ -0,0 +1,30 @@
+package shared
+
+import "errors"
+
+// SQLSTATE Postgres yang perlu dibedakan dari error database lain.
+const (
+	sqlStateForeignKeyViolation = "23503"
+	sqlStateUniqueViolation     = "23505"
+)
+
+// sqlStateError dipenuhi oleh *pq.Error, sehingga package ini tidak perlu
+// bergantung pada driver.
+type sqlStateError interface {
+	SQLState() string
+}
+
+// IsUniqueViolation mengecek apakah err berasal dari pelanggaran unique index.
+func IsUniqueViolation(err error) bool {
+	return hasSQLState(err, sqlStateUniqueViolation)
+}
+
+// IsForeignKeyViolation mengecek apakah err berasal dari pelanggaran foreign key.
+func IsForeignKeyViolation(err error) bool {
+	return hasSQLState(err, sqlStateForeignKeyViolation)
+}
+
+func hasSQLState(err error, code string) bool {
+	var stateErr sqlStateError
+	return errors.As(err, &stateErr) && stateErr.SQLState() == code
+}
@@ -0,0 +1,28 @@
+package shared_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestSQLStateHelpers(t *testing.T) {
+	t.Run("should detect wrapped driver errors by SQLSTATE", func(t *testing.T) {
+		unique := fmt.Errorf("save: %w", &pq.Error{Code: "23505"})
+		foreignKey := &pq.Error{Code: "23503"}
+
+		if !shared.IsUniqueViolation(unique) || shared.IsForeignKeyViolation(unique) {
+			t.Errorf("Expected %v to be only a unique violation", unique)
+		}
+		if !shared.IsForeignKeyViolation(foreignKey) || shared.IsUniqueViolation(foreignKey) {
+			t.Errorf("Expected %v to be only a foreign key violation", foreignKey)
+		}
+		if shared.IsUniqueViolation(errors.New("connection refused")) {
+			t.Error("Expected a plain error not to be a unique violation")
+		}
+	})
+}
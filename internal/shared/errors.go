@@ -25,11 +25,39 @@ func NewConflictError(message string) error {
 	return fmt.Errorf("conflict error: %s", message)
 }
 
+func NewUnauthorizedError(message string) error {
+	return fmt.Errorf("unauthorized error: %s", message)
+}
+
+func NewForbiddenError(message string) error {
+	return fmt.Errorf("forbidden error: %s", message)
+}
+
+func NewInvalidStateTransitionError(message string) error {
+	return fmt.Errorf("invalid state transition: %s", message)
+}
+
+func NewNotFoundError(message string) error {
+	return fmt.Errorf("not found error: %s", message)
+}
+
+func NewRateLimitError(message string) error {
+	return fmt.Errorf("rate limit error: %s", message)
+}
+
 // ✅ Helper untuk get error code dari error message
 func GetErrorCode(err error) string {
 	errMsg := strings.ToLower(err.Error())
 	
 	switch {
+	case strings.Contains(errMsg, "unauthorized"):
+		return "UNAUTHORIZED"
+	case strings.Contains(errMsg, "forbidden"):
+		return "FORBIDDEN"
+	case strings.Contains(errMsg, "rate limit error"):
+		return "RATE_LIMITED"
+	case strings.Contains(errMsg, "invalid state transition"):
+		return "INVALID_STATE_TRANSITION"
 	case strings.Contains(errMsg, "validation"):
 		return "VALIDATION_ERROR"
 	case strings.Contains(errMsg, "conflict"):
@@ -48,6 +76,34 @@ func GetUserMessage(err error) string {
 	errMsg := strings.ToLower(err.Error())
 	
 	switch {
+	case strings.Contains(errMsg, "unauthorized"):
+		// Extract message after "unauthorized error: "
+		parts := strings.Split(err.Error(), "unauthorized error: ")
+		if len(parts) > 1 {
+			return parts[1]
+		}
+		return "Authentication required"
+	case strings.Contains(errMsg, "forbidden"):
+		// Extract message after "forbidden error: "
+		parts := strings.Split(err.Error(), "forbidden error: ")
+		if len(parts) > 1 {
+			return parts[1]
+		}
+		return "You do not have permission to perform this action"
+	case strings.Contains(errMsg, "rate limit error"):
+		// Extract message after "rate limit error: "
+		parts := strings.Split(err.Error(), "rate limit error: ")
+		if len(parts) > 1 {
+			return parts[1]
+		}
+		return "Too many requests, please try again later"
+	case strings.Contains(errMsg, "invalid state transition"):
+		// Extract message after "invalid state transition: "
+		parts := strings.Split(err.Error(), "invalid state transition: ")
+		if len(parts) > 1 {
+			return parts[1]
+		}
+		return "The requested status change is not allowed"
 	case strings.Contains(errMsg, "validation"):
 		// Extract message after "validation error: "
 		parts := strings.Split(err.Error(), "validation error: ")
@@ -64,6 +120,13 @@ func GetUserMessage(err error) string {
 		return "Resource already exists"
 	case strings.Contains(errMsg, "database"):
 		return "System temporarily unavailable"
+	case strings.Contains(errMsg, "not found"):
+		// Extract message after "not found error: "
+		parts := strings.Split(err.Error(), "not found error: ")
+		if len(parts) > 1 {
+			return parts[1]
+		}
+		return "Resource not found"
 	default:
 		return "An unexpected error occurred"
 	}
@@ -0,0 +1,68 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// EventHandler menangani satu event. Handler harus idempotent karena event
+// yang sama bisa dikirim lebih dari sekali.
+type EventHandler func(ctx context.Context, event DomainEvent) error
+
+// InProcessEventBus adalah EventPublisher yang memanggil handler secara
+// sinkron di proses yang sama. Kegagalan handler hanya di-log dan tidak
+// membatalkan perubahan yang sudah disimpan.
+type InProcessEventBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]EventHandler
+}
+
+func NewInProcessEventBus() *InProcessEventBus {
+	return &InProcessEventBus{handlers: make(map[string][]EventHandler)}
+}
+
+// Subscribe mendaftarkan handler untuk event dengan nama eventName.
+func (b *InProcessEventBus) Subscribe(eventName string, handler EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventName] = append(b.handlers[eventName], handler)
+}
+
+func (b *InProcessEventBus) Publish(ctx context.Context, events ...DomainEvent) {
+	for _, event := range events {
+		if err := b.Dispatch(ctx, event); err != nil {
+			log.Printf("❌ Event handler for %s failed: %v", event.EventName(), err)
+		}
+	}
+}
+
+// Dispatch menjalankan semua handler event dan mengembalikan error pertama.
+// Handler berikutnya tetap dijalankan walaupun handler sebelumnya gagal.
+func (b *InProcessEventBus) Dispatch(ctx context.Context, event DomainEvent) error {
+	b.mu.RLock()
+	handlers := b.handlers[event.EventName()]
+	b.mu.RUnlock()
+
+	var firstErr error
+	for _, handler := range handlers {
+		if err := runHandler(ctx, handler, event); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// runHandler mengubah panic di handler menjadi error supaya tidak menjatuhkan request.
+func runHandler(ctx context.Context, handler EventHandler, event DomainEvent) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("handler panicked: %v", recovered)
+		}
+	}()
+
+	return handler(ctx, event)
+}
@@ -0,0 +1,57 @@
+package shared_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+type testEvent struct {
+	name string
+}
+
+func (e testEvent) EventName() string     { return e.name }
+func (e testEvent) OccurredAt() time.Time { return time.Time{} }
+
+func TestInProcessEventBus(t *testing.T) {
+	t.Run("should deliver events only to handlers subscribed to their name", func(t *testing.T) {
+		bus := shared.NewInProcessEventBus()
+		var received []string
+		bus.Subscribe("user.registered", func(ctx context.Context, event shared.DomainEvent) error {
+			received = append(received, event.EventName())
+			return nil
+		})
+
+		bus.Publish(context.Background(), testEvent{name: "user.registered"}, testEvent{name: "article.published"})
+
+		if len(received) != 1 || received[0] != "user.registered" {
+			t.Errorf("Expected [user.registered], but got %v", received)
+		}
+	})
+
+	t.Run("should run every handler and return the first failure", func(t *testing.T) {
+		bus := shared.NewInProcessEventBus()
+		failure := errors.New("webhook down")
+		calls := 0
+		bus.Subscribe("article.published", func(ctx context.Context, event shared.DomainEvent) error {
+			calls++
+			return failure
+		})
+		bus.Subscribe("article.published", func(ctx context.Context, event shared.DomainEvent) error {
+			calls++
+			panic("boom")
+		})
+
+		err := bus.Dispatch(context.Background(), testEvent{name: "article.published"})
+
+		if !errors.Is(err, failure) {
+			t.Errorf("Expected error %v, but got %v", failure, err)
+		}
+		if calls != 2 {
+			t.Errorf("Expected 2 handler calls, but got %d", calls)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package shared
+
+import (
+	"context"
+	"time"
+)
+
+// DomainEvent adalah kejadian bisnis yang sudah terjadi pada sebuah aggregate.
+type DomainEvent interface {
+	// EventName adalah nama stabil event, mis. "user.registered".
+	EventName() string
+	OccurredAt() time.Time
+}
+
+// EventPublisher meneruskan event ke para subscriber. Dipanggil use case
+// setelah perubahan aggregate berhasil disimpan.
+type EventPublisher interface {
+	Publish(ctx context.Context, events ...DomainEvent)
+}
+
+// EventDispatcher mengirim satu event ke handler-nya dan mengembalikan error
+// jika ada handler yang gagal, supaya pengirim bisa mencoba ulang.
+type EventDispatcher interface {
+	Dispatch(ctx context.Context, event DomainEvent) error
+}
+
+// EventOutbox menyimpan event di transaksi yang sama dengan perubahan
+// aggregate, sehingga event ikut ter-commit (atau ikut batal) bersama datanya.
+// Diinject ke repository supaya modul lain tidak bergantung langsung pada
+// implementasi outbox.
+type EventOutbox interface {
+	Append(ctx context.Context, tx DBTX, events []DomainEvent) error
+}
+
+// EventRecorder di-embed oleh entity untuk mencatat event sampai diambil
+// dengan PullEvents, baik oleh repository yang menulis outbox di transaksinya
+// maupun oleh use case setelah save.
+type EventRecorder struct {
+	events []DomainEvent
+}
+
+func (r *EventRecorder) RecordEvent(event DomainEvent) {
+	r.events = append(r.events, event)
+}
+
+// PullEvents mengembalikan event yang tercatat lalu mengosongkannya,
+// supaya event yang sama tidak dikirim dua kali.
+func (r *EventRecorder) PullEvents() []DomainEvent {
+	events := r.events
+	r.events = nil
+	return events
+}
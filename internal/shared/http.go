@@ -0,0 +1,84 @@
+package shared
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+type Response struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+	Error   *ErrorInfo  `json:"error,omitempty"`
+}
+
+type ErrorInfo struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetStatusCode memetakan error code dari GetErrorCode ke HTTP status.
+func GetStatusCode(errorCode string) int {
+	switch errorCode {
+	case "VALIDATION_ERROR":
+		return http.StatusBadRequest
+	case "UNAUTHORIZED":
+		return http.StatusUnauthorized
+	case "FORBIDDEN":
+		return http.StatusForbidden
+	case "CONFLICT_ERROR", "INVALID_STATE_TRANSITION":
+		return http.StatusConflict
+	case "RATE_LIMITED":
+		return http.StatusTooManyRequests
+	case "DATABASE_ERROR":
+		return http.StatusInternalServerError
+	case "NOT_FOUND":
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func WriteSuccessResponse(w http.ResponseWriter, data interface{}, message string, statusCode int) {
+	response := Response{
+		Success: true,
+		Message: message,
+		Data:    data,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(response)
+}
+
+func WriteErrorResponse(w http.ResponseWriter, code, message string, statusCode int) {
+	response := Response{
+		Success: false,
+		Message: "Request failed",
+		Error: &ErrorInfo{
+			Code:    code,
+			Message: message,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(response)
+}
+
+// WriteError menulis error dari use case dengan code, pesan dan status yang sesuai.
+func WriteError(w http.ResponseWriter, err error) {
+	errorCode := GetErrorCode(err)
+	WriteErrorResponse(w, errorCode, GetUserMessage(err), GetStatusCode(errorCode))
+}
+
+// BearerToken mengambil token dari header "Authorization: Bearer <token>".
+func BearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
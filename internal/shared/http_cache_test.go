@@ -0,0 +1,50 @@
+package shared_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestCheckNotModified(t *testing.T) {
+	lastModified := time.Date(2029, 2, 14, 8, 30, 0, 0, time.UTC)
+	etag := `"abc123"`
+
+	testCases := []struct {
+		name     string
+		headers  map[string]string
+		expected bool
+	}{
+		{"no conditional headers", nil, false},
+		{"matching etag", map[string]string{"If-None-Match": `"other", "abc123"`}, true},
+		{"weak matching etag", map[string]string{"If-None-Match": `W/"abc123"`}, true},
+		{"stale etag wins over If-Modified-Since", map[string]string{"If-None-Match": `"old"`, "If-Modified-Since": lastModified.Format(http.TimeFormat)}, false},
+		{"not modified since", map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)}, true},
+		{"modified since", map[string]string{"If-Modified-Since": lastModified.Add(-time.Second).Format(http.TimeFormat)}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/feeds/latest.rss", nil)
+			for key, value := range tc.headers {
+				r.Header.Set(key, value)
+			}
+			w := httptest.NewRecorder()
+
+			notModified := shared.CheckNotModified(w, r, etag, lastModified)
+
+			if notModified != tc.expected {
+				t.Fatalf("Expected %v, but got %v", tc.expected, notModified)
+			}
+			if tc.expected && w.Code != http.StatusNotModified {
+				t.Errorf("Expected status 304, but got %d", w.Code)
+			}
+			if w.Header().Get("ETag") != etag || w.Header().Get("Last-Modified") != "Wed, 14 Feb 2029 08:30:00 GMT" {
+				t.Errorf("Expected validators to be set, but got %v", w.Header())
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package migrate
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// migrationFilePattern mencocokkan nama file seperti 000001_create_table_users.up.sql.
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+var migrationNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Migration adalah satu versi skema beserta SQL untuk menerapkan dan membatalkannya.
+type Migration struct {
+	Version int64
+	Name    string
+	Module  string
+	Up      string
+	Down    string
+}
+
+// Load mencari seluruh file migrasi di fsys (termasuk subdirektori) dan
+// mengurutkannya berdasarkan versi. Versi harus unik di semua modul.
+func Load(fsys fs.FS) ([]*Migration, error) {
+	byVersion := make(map[int64]*Migration)
+
+	err := fs.WalkDir(fsys, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || path.Ext(filePath) != ".sql" {
+			return err
+		}
+
+		match := migrationFilePattern.FindStringSubmatch(path.Base(filePath))
+		if match == nil {
+			return fmt.Errorf("invalid migration file name %s", filePath)
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid migration version in %s: %w", filePath, err)
+		}
+
+		content, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return err
+		}
+
+		module := moduleOf(filePath)
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2], Module: module}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] || migration.Module != module {
+			return fmt.Errorf("duplicate migration version %06d: %s/%s and %s/%s", version, migration.Module, migration.Name, module, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if strings.TrimSpace(migration.Up) == "" {
+			return nil, fmt.Errorf("migration %06d_%s has no up script", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// moduleOf mengambil nama modul dari path seperti auth/infrastructure/persistence/migrations/x.sql.
+func moduleOf(filePath string) string {
+	if dir := path.Dir(filePath); dir != "." {
+		return strings.SplitN(dir, "/", 2)[0]
+	}
+	return ""
+}
+
+// Create menulis pasangan file up/down kosong di dir dengan versi setelah
+// versi tertinggi yang ada, lalu mengembalikan path keduanya.
+func Create(dir, name string, existing []*Migration) (string, string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !migrationNamePattern.MatchString(name) {
+		return "", "", fmt.Errorf("migration name must contain only lowercase letters, digits and underscores")
+	}
+
+	var version int64 = 1
+	if len(existing) > 0 {
+		version = existing[len(existing)-1].Version + 1
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", "", err
+	}
+
+	base := fmt.Sprintf("%06d_%s", version, name)
+	upPath := filepath.Join(dir, base+".up.sql")
+	downPath := filepath.Join(dir, base+".down.sql")
+
+	for _, filePath := range []string{upPath, downPath} {
+		file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return "", "", err
+		}
+		file.Close()
+	}
+
+	return upPath, downPath, nil
+}
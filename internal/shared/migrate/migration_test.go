@@ -0,0 +1,98 @@
+package migrate_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jokosaputro95/cms-news-api/internal/modules"
+	"github.com/jokosaputro95/cms-news-api/internal/shared/migrate"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("should collect migrations from every module in version order", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"tags/infrastructure/persistence/migrations/000002_create_tags.up.sql":   {Data: []byte("CREATE TABLE tags ();")},
+			"tags/infrastructure/persistence/migrations/000002_create_tags.down.sql": {Data: []byte("DROP TABLE tags;")},
+			"auth/infrastructure/persistence/migrations/000001_create_users.up.sql":  {Data: []byte("CREATE TABLE users ();")},
+		}
+
+		migrations, err := migrate.Load(fsys)
+
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if len(migrations) != 2 || migrations[0].Version != 1 || migrations[1].Version != 2 {
+			t.Fatalf("Expected versions [1 2], but got %d migration(s)", len(migrations))
+		}
+		if migrations[0].Module != "auth" || migrations[1].Module != "tags" {
+			t.Errorf("Expected modules [auth tags], but got [%s %s]", migrations[0].Module, migrations[1].Module)
+		}
+		if migrations[1].Down != "DROP TABLE tags;" {
+			t.Errorf("Expected down script to be loaded, but got %q", migrations[1].Down)
+		}
+	})
+
+	t.Run("should reject the same version in two modules", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"auth/infrastructure/persistence/migrations/000001_create_users.up.sql": {Data: []byte("SELECT 1;")},
+			"tags/infrastructure/persistence/migrations/000001_create_tags.up.sql":  {Data: []byte("SELECT 1;")},
+		}
+
+		_, err := migrate.Load(fsys)
+
+		if err == nil || !strings.Contains(err.Error(), "duplicate migration version") {
+			t.Errorf("Expected a duplicate version error, but got %v", err)
+		}
+	})
+
+	t.Run("should reject a migration without an up script", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"auth/infrastructure/persistence/migrations/000001_create_users.down.sql": {Data: []byte("DROP TABLE users;")},
+		}
+
+		if _, err := migrate.Load(fsys); err == nil {
+			t.Error("Expected an error for a missing up script, but got nil")
+		}
+	})
+
+	t.Run("should load the embedded migrations of this repository", func(t *testing.T) {
+		migrations, err := migrate.Load(modules.Migrations)
+
+		if err != nil {
+			t.Fatalf("Expected embedded migrations to be valid, but got %v", err)
+		}
+		for _, migration := range migrations {
+			if strings.TrimSpace(migration.Down) == "" {
+				t.Errorf("Expected migration %06d_%s to have a down script", migration.Version, migration.Name)
+			}
+		}
+	})
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("should create the next version after the latest migration", func(t *testing.T) {
+		dir := t.TempDir()
+		existing := []*migrate.Migration{{Version: 1}, {Version: 19}}
+
+		upPath, downPath, err := migrate.Create(dir, "add_bio_to_users", existing)
+
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if filepath.Base(upPath) != "000020_add_bio_to_users.up.sql" || filepath.Base(downPath) != "000020_add_bio_to_users.down.sql" {
+			t.Errorf("Expected files for version 000020, but got %s and %s", upPath, downPath)
+		}
+		if _, err := os.Stat(upPath); err != nil {
+			t.Errorf("Expected up file to exist, but got %v", err)
+		}
+	})
+
+	t.Run("should reject an invalid name", func(t *testing.T) {
+		if _, _, err := migrate.Create(t.TempDir(), "add bio!", nil); err == nil {
+			t.Error("Expected an error for an invalid name, but got nil")
+		}
+	})
+}
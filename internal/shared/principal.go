@@ -0,0 +1,48 @@
+package shared
+
+import (
+	"context"
+	"time"
+)
+
+// Principal adalah identitas pemanggil yang sudah terautentikasi.
+type Principal struct {
+	UserID      string
+	Roles       []string
+	Permissions []string
+	TokenID     string
+	ExpiresAt   time.Time
+}
+
+type principalContextKey struct{}
+
+// WithPrincipal menyimpan principal ke dalam context request.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext mengambil principal yang disimpan oleh JWT middleware.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// HasRole memeriksa apakah principal memiliki role tertentu.
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission memeriksa apakah salah satu role principal memberi permission tersebut.
+func (p *Principal) HasPermission(permission string) bool {
+	for _, perm := range p.Permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
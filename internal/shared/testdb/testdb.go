@@ -0,0 +1,73 @@
+// Package testdb menyiapkan database Postgres untuk test integrasi repository.
+package testdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+
+	_ "github.com/lib/pq"
+
+	"github.com/jokosaputro95/cms-news-api/internal/modules"
+	"github.com/jokosaputro95/cms-news-api/internal/shared/migrate"
+)
+
+// Open menghubungkan ke database test dari env PG_*_TEST (sama seperti
+// configs.LoadConfig saat isTest) lalu menerapkan seluruh migrasi.
+// Test dilewati jika PG_HOST_TEST tidak diset.
+func Open(t *testing.T) *sql.DB {
+	t.Helper()
+
+	host := os.Getenv("PG_HOST_TEST")
+	if host == "" {
+		t.Skip("PG_HOST_TEST is not set, skipping Postgres integration test")
+	}
+
+	dsn := fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		host,
+		os.Getenv("PG_PORT_TEST"),
+		os.Getenv("PG_USER_TEST"),
+		os.Getenv("PG_PASS_TEST"),
+		os.Getenv("PG_DB_NAME_TEST"),
+		os.Getenv("PG_SSL_MODE_TEST"),
+	)
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("Error opening test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := db.Ping(); err != nil {
+		t.Fatalf("Error connecting to test database: %v", err)
+	}
+
+	migrations, err := migrate.Load(modules.Migrations)
+	if err != nil {
+		t.Fatalf("Error loading migrations: %v", err)
+	}
+	if _, err := migrate.NewMigrator(db, migrations).Up(context.Background()); err != nil {
+		t.Fatalf("Error migrating test database: %v", err)
+	}
+
+	return db
+}
+
+// CreateUser menyimpan user minimal untuk kebutuhan foreign key dan
+// menghapusnya setelah test selesai. Baris yang mereferensikan user harus
+// dihapus lebih dulu oleh cleanup test itu sendiri.
+func CreateUser(t *testing.T, db *sql.DB, id string) {
+	t.Helper()
+
+	_, err := db.Exec(
+		"INSERT INTO users (id, username, email, hashed_password) VALUES ($1, $2, $3, $4)",
+		id, "u"+id[:8], id+"@test.local", "$2a$12$hash",
+	)
+	if err != nil {
+		t.Fatalf("Error creating test user: %v", err)
+	}
+	t.Cleanup(func() { db.Exec("DELETE FROM users WHERE id = $1", id) })
+}
@@ -0,0 +1,186 @@
+package shared
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// TxManager menjalankan beberapa operasi repository sebagai satu unit kerja.
+// Repository mengambil transaksi dari ctx, sehingga use case cukup meneruskan
+// ctx yang diterima fn tanpa mengenal *sql.Tx.
+type TxManager interface {
+	// WithinTx menjalankan fn di dalam transaksi. Transaksi di-commit jika fn
+	// mengembalikan nil dan di-rollback jika fn mengembalikan error atau panic.
+	// Pemanggilan bertingkat ikut memakai transaksi terluar.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// DBTX adalah method yang dimiliki *sql.DB maupun *sql.Tx, dipakai repository
+// supaya query yang sama bisa berjalan di dalam atau di luar transaksi.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type txContextKey struct{}
+
+// ContextWithTx menyimpan tx di ctx.
+func ContextWithTx(ctx context.Context, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// TxFromContext mengambil tx yang disimpan TxManager, jika ada.
+func TxFromContext(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(*sql.Tx)
+	return tx, ok && tx != nil
+}
+
+// Executor mengembalikan tx dari ctx jika ada, atau db jika tidak.
+func Executor(ctx context.Context, db *sql.DB) DBTX {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	return db
+}
+
+type afterCommitKey struct{}
+
+// afterCommitHooks adalah fungsi yang menunggu transaksi terluar di-commit.
+type afterCommitHooks struct {
+	fns []func()
+}
+
+// AfterCommit menjalankan fn setelah transaksi TxManager di ctx di-commit, atau
+// langsung jika ctx tidak membawa transaksi. fn dibuang jika transaksi
+// di-rollback. Dipakai untuk efek samping di luar database (event in-process,
+// email) supaya tidak terjadi untuk data yang batal disimpan.
+func AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks); ok {
+		hooks.fns = append(hooks.fns, fn)
+		return
+	}
+	fn()
+}
+
+func contextWithAfterCommit(ctx context.Context) (context.Context, *afterCommitHooks) {
+	hooks := &afterCommitHooks{}
+	return context.WithValue(ctx, afterCommitKey{}, hooks), hooks
+}
+
+func (h *afterCommitHooks) run() {
+	for _, fn := range h.fns {
+		fn()
+	}
+}
+
+// Tx adalah transaksi lokal repository. Jika ctx sudah membawa transaksi dari
+// TxManager, Commit dan Rollback tidak melakukan apa-apa karena keputusan
+// akhirnya ada di WithinTx.
+type Tx struct {
+	*sql.Tx
+	owned bool
+}
+
+// BeginTx memulai transaksi untuk repository yang butuh beberapa statement
+// atomik, atau ikut transaksi di ctx jika ada.
+func BeginTx(ctx context.Context, db *sql.DB) (*Tx, error) {
+	if tx, ok := TxFromContext(ctx); ok {
+		return &Tx{Tx: tx}, nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, owned: true}, nil
+}
+
+func (t *Tx) Commit() error {
+	if !t.owned {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+func (t *Tx) Rollback() error {
+	if !t.owned {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
+
+// PostgresTxManager adalah TxManager berbasis *sql.DB.
+type PostgresTxManager struct {
+	db *sql.DB
+}
+
+func NewPostgresTxManager(db *sql.DB) TxManager {
+	return &PostgresTxManager{db: db}
+}
+
+func (m *PostgresTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	// ✅ Transaksi bertingkat ikut transaksi terluar
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return NewDatabaseError(err)
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			tx.Rollback()
+			panic(recovered)
+		}
+	}()
+
+	txCtx, hooks := contextWithAfterCommit(ContextWithTx(ctx, tx))
+	if err := fn(txCtx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rollbackErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return NewDatabaseError(err)
+	}
+
+	hooks.run()
+	return nil
+}
+
+// InMemoryTxManager menjalankan fn langsung tanpa database, untuk test dan
+// repository in-memory. Jumlah commit dan rollback dicatat untuk assertion.
+type InMemoryTxManager struct {
+	Commits   int
+	Rollbacks int
+	depth     int
+}
+
+func NewInMemoryTxManager() *InMemoryTxManager {
+	return &InMemoryTxManager{}
+}
+
+func (m *InMemoryTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.depth++
+	defer func() { m.depth-- }()
+
+	// Hanya transaksi terluar yang dihitung, sama seperti PostgresTxManager
+	if m.depth > 1 {
+		return fn(ctx)
+	}
+
+	txCtx, hooks := contextWithAfterCommit(ctx)
+	if err := fn(txCtx); err != nil {
+		m.Rollbacks++
+		return err
+	}
+	m.Commits++
+	hooks.run()
+	return nil
+}
@@ -0,0 +1,109 @@
+package shared_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	shared "github.com/jokosaputro95/cms-news-api/internal/shared"
+)
+
+func TestInMemoryTxManager(t *testing.T) {
+	t.Run("should commit when fn succeeds and roll back when it fails", func(t *testing.T) {
+		txManager := shared.NewInMemoryTxManager()
+		failure := errors.New("save failed")
+
+		if err := txManager.WithinTx(context.Background(), func(ctx context.Context) error { return nil }); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		err := txManager.WithinTx(context.Background(), func(ctx context.Context) error { return failure })
+
+		if !errors.Is(err, failure) {
+			t.Errorf("Expected error %v, but got %v", failure, err)
+		}
+		if txManager.Commits != 1 || txManager.Rollbacks != 1 {
+			t.Errorf("Expected 1 commit and 1 rollback, but got %d and %d", txManager.Commits, txManager.Rollbacks)
+		}
+	})
+
+	t.Run("should only count the outermost transaction", func(t *testing.T) {
+		txManager := shared.NewInMemoryTxManager()
+
+		err := txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			return txManager.WithinTx(ctx, func(ctx context.Context) error { return nil })
+		})
+
+		if err != nil || txManager.Commits != 1 {
+			t.Errorf("Expected a single commit, but got %d (err %v)", txManager.Commits, err)
+		}
+	})
+
+	t.Run("should run after-commit hooks only once the outermost transaction commits", func(t *testing.T) {
+		txManager := shared.NewInMemoryTxManager()
+		var calls []string
+
+		err := txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			shared.AfterCommit(ctx, func() { calls = append(calls, "outer") })
+			return txManager.WithinTx(ctx, func(ctx context.Context) error {
+				shared.AfterCommit(ctx, func() { calls = append(calls, "inner") })
+				if len(calls) != 0 {
+					t.Errorf("Expected hooks to wait for the commit, but got %v", calls)
+				}
+				return nil
+			})
+		})
+
+		if err != nil || len(calls) != 2 || calls[0] != "outer" || calls[1] != "inner" {
+			t.Errorf("Expected outer and inner hooks after commit, but got %v (err %v)", calls, err)
+		}
+	})
+
+	t.Run("should drop after-commit hooks on rollback", func(t *testing.T) {
+		txManager := shared.NewInMemoryTxManager()
+		called := false
+
+		txManager.WithinTx(context.Background(), func(ctx context.Context) error {
+			shared.AfterCommit(ctx, func() { called = true })
+			return errors.New("save failed")
+		})
+
+		if called {
+			t.Error("Expected the hook to be dropped after rollback")
+		}
+	})
+}
+
+func TestAfterCommit(t *testing.T) {
+	t.Run("should run immediately without a transaction", func(t *testing.T) {
+		called := false
+
+		shared.AfterCommit(context.Background(), func() { called = true })
+
+		if !called {
+			t.Error("Expected the hook to run immediately")
+		}
+	})
+}
+
+func TestTxFromContext(t *testing.T) {
+	t.Run("should fall back to the database without a transaction", func(t *testing.T) {
+		db := &sql.DB{}
+
+		if _, ok := shared.TxFromContext(context.Background()); ok {
+			t.Error("Expected no transaction in an empty context")
+		}
+		if executor := shared.Executor(context.Background(), db); executor != db {
+			t.Errorf("Expected the database as executor, but got %v", executor)
+		}
+	})
+
+	t.Run("should use the transaction stored in the context", func(t *testing.T) {
+		tx := &sql.Tx{}
+		ctx := shared.ContextWithTx(context.Background(), tx)
+
+		if executor := shared.Executor(ctx, &sql.DB{}); executor != tx {
+			t.Errorf("Expected the context transaction as executor, but got %v", executor)
+		}
+	})
+}
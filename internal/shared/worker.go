@@ -0,0 +1,8 @@
+package shared
+
+import "context"
+
+// Worker adalah proses background yang berjalan sampai ctx dibatalkan.
+type Worker interface {
+	Run(ctx context.Context)
+}